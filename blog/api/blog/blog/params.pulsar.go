@@ -3,6 +3,7 @@ package blog
 
 import (
 	_ "cosmossdk.io/api/amino"
+	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	fmt "fmt"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -14,13 +15,85 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_Params_9_list)(nil)
+
+type _Params_9_list struct {
+	list *[]string
+}
+
+func (x *_Params_9_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Params_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_9_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field PostQuotaAllowlist as it is not of Message kind"))
+}
+
+func (x *_Params_9_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_9_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Params_9_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Params protoreflect.MessageDescriptor
+	md_Params                         protoreflect.MessageDescriptor
+	fd_Params_max_batch_size          protoreflect.FieldDescriptor
+	fd_Params_max_following           protoreflect.FieldDescriptor
+	fd_Params_change_proposal_ttl     protoreflect.FieldDescriptor
+	fd_Params_trash_retention         protoreflect.FieldDescriptor
+	fd_Params_create_gas_per_byte     protoreflect.FieldDescriptor
+	fd_Params_update_gas_per_byte     protoreflect.FieldDescriptor
+	fd_Params_post_quota              protoreflect.FieldDescriptor
+	fd_Params_post_quota_window       protoreflect.FieldDescriptor
+	fd_Params_post_quota_allowlist    protoreflect.FieldDescriptor
+	fd_Params_max_tx_msgs             protoreflect.FieldDescriptor
+	fd_Params_max_body_bytes          protoreflect.FieldDescriptor
+	fd_Params_min_post_fee            protoreflect.FieldDescriptor
+	fd_Params_purchase_refund_timeout protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_blog_blog_params_proto_init()
 	md_Params = File_blog_blog_params_proto.Messages().ByName("Params")
+	fd_Params_max_batch_size = md_Params.Fields().ByName("max_batch_size")
+	fd_Params_max_following = md_Params.Fields().ByName("max_following")
+	fd_Params_change_proposal_ttl = md_Params.Fields().ByName("change_proposal_ttl")
+	fd_Params_trash_retention = md_Params.Fields().ByName("trash_retention")
+	fd_Params_create_gas_per_byte = md_Params.Fields().ByName("create_gas_per_byte")
+	fd_Params_update_gas_per_byte = md_Params.Fields().ByName("update_gas_per_byte")
+	fd_Params_post_quota = md_Params.Fields().ByName("post_quota")
+	fd_Params_post_quota_window = md_Params.Fields().ByName("post_quota_window")
+	fd_Params_post_quota_allowlist = md_Params.Fields().ByName("post_quota_allowlist")
+	fd_Params_max_tx_msgs = md_Params.Fields().ByName("max_tx_msgs")
+	fd_Params_max_body_bytes = md_Params.Fields().ByName("max_body_bytes")
+	fd_Params_min_post_fee = md_Params.Fields().ByName("min_post_fee")
+	fd_Params_purchase_refund_timeout = md_Params.Fields().ByName("purchase_refund_timeout")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -88,6 +161,84 @@ func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
 func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.MaxBatchSize != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxBatchSize)
+		if !f(fd_Params_max_batch_size, value) {
+			return
+		}
+	}
+	if x.MaxFollowing != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxFollowing)
+		if !f(fd_Params_max_following, value) {
+			return
+		}
+	}
+	if x.ChangeProposalTtl != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ChangeProposalTtl)
+		if !f(fd_Params_change_proposal_ttl, value) {
+			return
+		}
+	}
+	if x.TrashRetention != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TrashRetention)
+		if !f(fd_Params_trash_retention, value) {
+			return
+		}
+	}
+	if x.CreateGasPerByte != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.CreateGasPerByte)
+		if !f(fd_Params_create_gas_per_byte, value) {
+			return
+		}
+	}
+	if x.UpdateGasPerByte != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.UpdateGasPerByte)
+		if !f(fd_Params_update_gas_per_byte, value) {
+			return
+		}
+	}
+	if x.PostQuota != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PostQuota)
+		if !f(fd_Params_post_quota, value) {
+			return
+		}
+	}
+	if x.PostQuotaWindow != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PostQuotaWindow)
+		if !f(fd_Params_post_quota_window, value) {
+			return
+		}
+	}
+	if len(x.PostQuotaAllowlist) != 0 {
+		value := protoreflect.ValueOfList(&_Params_9_list{list: &x.PostQuotaAllowlist})
+		if !f(fd_Params_post_quota_allowlist, value) {
+			return
+		}
+	}
+	if x.MaxTxMsgs != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxTxMsgs)
+		if !f(fd_Params_max_tx_msgs, value) {
+			return
+		}
+	}
+	if x.MaxBodyBytes != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxBodyBytes)
+		if !f(fd_Params_max_body_bytes, value) {
+			return
+		}
+	}
+	if x.MinPostFee != nil {
+		value := protoreflect.ValueOfMessage(x.MinPostFee.ProtoReflect())
+		if !f(fd_Params_min_post_fee, value) {
+			return
+		}
+	}
+	if x.PurchaseRefundTimeout != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PurchaseRefundTimeout)
+		if !f(fd_Params_purchase_refund_timeout, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -103,6 +254,32 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 // a repeated field is populated if it is non-empty.
 func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "blog.blog.Params.max_batch_size":
+		return x.MaxBatchSize != uint64(0)
+	case "blog.blog.Params.max_following":
+		return x.MaxFollowing != uint64(0)
+	case "blog.blog.Params.change_proposal_ttl":
+		return x.ChangeProposalTtl != uint64(0)
+	case "blog.blog.Params.trash_retention":
+		return x.TrashRetention != uint64(0)
+	case "blog.blog.Params.create_gas_per_byte":
+		return x.CreateGasPerByte != uint64(0)
+	case "blog.blog.Params.update_gas_per_byte":
+		return x.UpdateGasPerByte != uint64(0)
+	case "blog.blog.Params.post_quota":
+		return x.PostQuota != uint64(0)
+	case "blog.blog.Params.post_quota_window":
+		return x.PostQuotaWindow != uint64(0)
+	case "blog.blog.Params.post_quota_allowlist":
+		return len(x.PostQuotaAllowlist) != 0
+	case "blog.blog.Params.max_tx_msgs":
+		return x.MaxTxMsgs != uint64(0)
+	case "blog.blog.Params.max_body_bytes":
+		return x.MaxBodyBytes != uint64(0)
+	case "blog.blog.Params.min_post_fee":
+		return x.MinPostFee != nil
+	case "blog.blog.Params.purchase_refund_timeout":
+		return x.PurchaseRefundTimeout != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: blog.blog.Params"))
@@ -119,6 +296,32 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 // Clear is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "blog.blog.Params.max_batch_size":
+		x.MaxBatchSize = uint64(0)
+	case "blog.blog.Params.max_following":
+		x.MaxFollowing = uint64(0)
+	case "blog.blog.Params.change_proposal_ttl":
+		x.ChangeProposalTtl = uint64(0)
+	case "blog.blog.Params.trash_retention":
+		x.TrashRetention = uint64(0)
+	case "blog.blog.Params.create_gas_per_byte":
+		x.CreateGasPerByte = uint64(0)
+	case "blog.blog.Params.update_gas_per_byte":
+		x.UpdateGasPerByte = uint64(0)
+	case "blog.blog.Params.post_quota":
+		x.PostQuota = uint64(0)
+	case "blog.blog.Params.post_quota_window":
+		x.PostQuotaWindow = uint64(0)
+	case "blog.blog.Params.post_quota_allowlist":
+		x.PostQuotaAllowlist = nil
+	case "blog.blog.Params.max_tx_msgs":
+		x.MaxTxMsgs = uint64(0)
+	case "blog.blog.Params.max_body_bytes":
+		x.MaxBodyBytes = uint64(0)
+	case "blog.blog.Params.min_post_fee":
+		x.MinPostFee = nil
+	case "blog.blog.Params.purchase_refund_timeout":
+		x.PurchaseRefundTimeout = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: blog.blog.Params"))
@@ -135,6 +338,48 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 // of the value; to obtain a mutable reference, use Mutable.
 func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "blog.blog.Params.max_batch_size":
+		value := x.MaxBatchSize
+		return protoreflect.ValueOfUint64(value)
+	case "blog.blog.Params.max_following":
+		value := x.MaxFollowing
+		return protoreflect.ValueOfUint64(value)
+	case "blog.blog.Params.change_proposal_ttl":
+		value := x.ChangeProposalTtl
+		return protoreflect.ValueOfUint64(value)
+	case "blog.blog.Params.trash_retention":
+		value := x.TrashRetention
+		return protoreflect.ValueOfUint64(value)
+	case "blog.blog.Params.create_gas_per_byte":
+		value := x.CreateGasPerByte
+		return protoreflect.ValueOfUint64(value)
+	case "blog.blog.Params.update_gas_per_byte":
+		value := x.UpdateGasPerByte
+		return protoreflect.ValueOfUint64(value)
+	case "blog.blog.Params.post_quota":
+		value := x.PostQuota
+		return protoreflect.ValueOfUint64(value)
+	case "blog.blog.Params.post_quota_window":
+		value := x.PostQuotaWindow
+		return protoreflect.ValueOfUint64(value)
+	case "blog.blog.Params.post_quota_allowlist":
+		if len(x.PostQuotaAllowlist) == 0 {
+			return protoreflect.ValueOfList(&_Params_9_list{})
+		}
+		listValue := &_Params_9_list{list: &x.PostQuotaAllowlist}
+		return protoreflect.ValueOfList(listValue)
+	case "blog.blog.Params.max_tx_msgs":
+		value := x.MaxTxMsgs
+		return protoreflect.ValueOfUint64(value)
+	case "blog.blog.Params.max_body_bytes":
+		value := x.MaxBodyBytes
+		return protoreflect.ValueOfUint64(value)
+	case "blog.blog.Params.min_post_fee":
+		value := x.MinPostFee
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "blog.blog.Params.purchase_refund_timeout":
+		value := x.PurchaseRefundTimeout
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: blog.blog.Params"))
@@ -155,6 +400,34 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 // Set is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "blog.blog.Params.max_batch_size":
+		x.MaxBatchSize = value.Uint()
+	case "blog.blog.Params.max_following":
+		x.MaxFollowing = value.Uint()
+	case "blog.blog.Params.change_proposal_ttl":
+		x.ChangeProposalTtl = value.Uint()
+	case "blog.blog.Params.trash_retention":
+		x.TrashRetention = value.Uint()
+	case "blog.blog.Params.create_gas_per_byte":
+		x.CreateGasPerByte = value.Uint()
+	case "blog.blog.Params.update_gas_per_byte":
+		x.UpdateGasPerByte = value.Uint()
+	case "blog.blog.Params.post_quota":
+		x.PostQuota = value.Uint()
+	case "blog.blog.Params.post_quota_window":
+		x.PostQuotaWindow = value.Uint()
+	case "blog.blog.Params.post_quota_allowlist":
+		lv := value.List()
+		clv := lv.(*_Params_9_list)
+		x.PostQuotaAllowlist = *clv.list
+	case "blog.blog.Params.max_tx_msgs":
+		x.MaxTxMsgs = value.Uint()
+	case "blog.blog.Params.max_body_bytes":
+		x.MaxBodyBytes = value.Uint()
+	case "blog.blog.Params.min_post_fee":
+		x.MinPostFee = value.Message().Interface().(*v1beta1.Coin)
+	case "blog.blog.Params.purchase_refund_timeout":
+		x.PurchaseRefundTimeout = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: blog.blog.Params"))
@@ -175,6 +448,39 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "blog.blog.Params.post_quota_allowlist":
+		if x.PostQuotaAllowlist == nil {
+			x.PostQuotaAllowlist = []string{}
+		}
+		value := &_Params_9_list{list: &x.PostQuotaAllowlist}
+		return protoreflect.ValueOfList(value)
+	case "blog.blog.Params.min_post_fee":
+		if x.MinPostFee == nil {
+			x.MinPostFee = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.MinPostFee.ProtoReflect())
+	case "blog.blog.Params.max_batch_size":
+		panic(fmt.Errorf("field max_batch_size of message blog.blog.Params is not mutable"))
+	case "blog.blog.Params.max_following":
+		panic(fmt.Errorf("field max_following of message blog.blog.Params is not mutable"))
+	case "blog.blog.Params.change_proposal_ttl":
+		panic(fmt.Errorf("field change_proposal_ttl of message blog.blog.Params is not mutable"))
+	case "blog.blog.Params.trash_retention":
+		panic(fmt.Errorf("field trash_retention of message blog.blog.Params is not mutable"))
+	case "blog.blog.Params.create_gas_per_byte":
+		panic(fmt.Errorf("field create_gas_per_byte of message blog.blog.Params is not mutable"))
+	case "blog.blog.Params.update_gas_per_byte":
+		panic(fmt.Errorf("field update_gas_per_byte of message blog.blog.Params is not mutable"))
+	case "blog.blog.Params.post_quota":
+		panic(fmt.Errorf("field post_quota of message blog.blog.Params is not mutable"))
+	case "blog.blog.Params.post_quota_window":
+		panic(fmt.Errorf("field post_quota_window of message blog.blog.Params is not mutable"))
+	case "blog.blog.Params.max_tx_msgs":
+		panic(fmt.Errorf("field max_tx_msgs of message blog.blog.Params is not mutable"))
+	case "blog.blog.Params.max_body_bytes":
+		panic(fmt.Errorf("field max_body_bytes of message blog.blog.Params is not mutable"))
+	case "blog.blog.Params.purchase_refund_timeout":
+		panic(fmt.Errorf("field purchase_refund_timeout of message blog.blog.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: blog.blog.Params"))
@@ -188,6 +494,34 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 // For lists, maps, and messages, this returns a new, empty, mutable value.
 func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "blog.blog.Params.max_batch_size":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "blog.blog.Params.max_following":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "blog.blog.Params.change_proposal_ttl":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "blog.blog.Params.trash_retention":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "blog.blog.Params.create_gas_per_byte":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "blog.blog.Params.update_gas_per_byte":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "blog.blog.Params.post_quota":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "blog.blog.Params.post_quota_window":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "blog.blog.Params.post_quota_allowlist":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Params_9_list{list: &list})
+	case "blog.blog.Params.max_tx_msgs":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "blog.blog.Params.max_body_bytes":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "blog.blog.Params.min_post_fee":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "blog.blog.Params.purchase_refund_timeout":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: blog.blog.Params"))
@@ -257,6 +591,49 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
+		if x.MaxBatchSize != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxBatchSize))
+		}
+		if x.MaxFollowing != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxFollowing))
+		}
+		if x.ChangeProposalTtl != 0 {
+			n += 1 + runtime.Sov(uint64(x.ChangeProposalTtl))
+		}
+		if x.TrashRetention != 0 {
+			n += 1 + runtime.Sov(uint64(x.TrashRetention))
+		}
+		if x.CreateGasPerByte != 0 {
+			n += 1 + runtime.Sov(uint64(x.CreateGasPerByte))
+		}
+		if x.UpdateGasPerByte != 0 {
+			n += 1 + runtime.Sov(uint64(x.UpdateGasPerByte))
+		}
+		if x.PostQuota != 0 {
+			n += 1 + runtime.Sov(uint64(x.PostQuota))
+		}
+		if x.PostQuotaWindow != 0 {
+			n += 1 + runtime.Sov(uint64(x.PostQuotaWindow))
+		}
+		if len(x.PostQuotaAllowlist) > 0 {
+			for _, s := range x.PostQuotaAllowlist {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.MaxTxMsgs != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxTxMsgs))
+		}
+		if x.MaxBodyBytes != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxBodyBytes))
+		}
+		if x.MinPostFee != nil {
+			l = options.Size(x.MinPostFee)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.PurchaseRefundTimeout != 0 {
+			n += 1 + runtime.Sov(uint64(x.PurchaseRefundTimeout))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -286,6 +663,84 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.PurchaseRefundTimeout != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PurchaseRefundTimeout))
+			i--
+			dAtA[i] = 0x68
+		}
+		if x.MinPostFee != nil {
+			encoded, err := options.Marshal(x.MinPostFee)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if x.MaxBodyBytes != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxBodyBytes))
+			i--
+			dAtA[i] = 0x58
+		}
+		if x.MaxTxMsgs != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxTxMsgs))
+			i--
+			dAtA[i] = 0x50
+		}
+		if len(x.PostQuotaAllowlist) > 0 {
+			for iNdEx := len(x.PostQuotaAllowlist) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.PostQuotaAllowlist[iNdEx])
+				copy(dAtA[i:], x.PostQuotaAllowlist[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PostQuotaAllowlist[iNdEx])))
+				i--
+				dAtA[i] = 0x4a
+			}
+		}
+		if x.PostQuotaWindow != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PostQuotaWindow))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.PostQuota != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PostQuota))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.UpdateGasPerByte != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.UpdateGasPerByte))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.CreateGasPerByte != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CreateGasPerByte))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.TrashRetention != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TrashRetention))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.ChangeProposalTtl != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ChangeProposalTtl))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.MaxFollowing != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxFollowing))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.MaxBatchSize != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxBatchSize))
+			i--
+			dAtA[i] = 0x8
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -335,6 +790,283 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxBatchSize", wireType)
+				}
+				x.MaxBatchSize = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxBatchSize |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxFollowing", wireType)
+				}
+				x.MaxFollowing = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxFollowing |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChangeProposalTtl", wireType)
+				}
+				x.ChangeProposalTtl = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ChangeProposalTtl |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TrashRetention", wireType)
+				}
+				x.TrashRetention = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TrashRetention |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreateGasPerByte", wireType)
+				}
+				x.CreateGasPerByte = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CreateGasPerByte |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UpdateGasPerByte", wireType)
+				}
+				x.UpdateGasPerByte = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.UpdateGasPerByte |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PostQuota", wireType)
+				}
+				x.PostQuota = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PostQuota |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PostQuotaWindow", wireType)
+				}
+				x.PostQuotaWindow = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PostQuotaWindow |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PostQuotaAllowlist", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PostQuotaAllowlist = append(x.PostQuotaAllowlist, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxTxMsgs", wireType)
+				}
+				x.MaxTxMsgs = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxTxMsgs |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxBodyBytes", wireType)
+				}
+				x.MaxBodyBytes = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxBodyBytes |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinPostFee", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.MinPostFee == nil {
+					x.MinPostFee = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MinPostFee); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PurchaseRefundTimeout", wireType)
+				}
+				x.PurchaseRefundTimeout = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PurchaseRefundTimeout |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -388,6 +1120,45 @@ type Params struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// max_batch_size caps the number of operations a single MsgBatchPostOps may
+	// carry. Zero disables the cap.
+	MaxBatchSize uint64 `protobuf:"varint,1,opt,name=max_batch_size,json=maxBatchSize,proto3" json:"max_batch_size,omitempty"`
+	// max_following caps the number of accounts an account may follow, which
+	// bounds the work of the Feed query. Zero disables the cap.
+	MaxFollowing uint64 `protobuf:"varint,2,opt,name=max_following,json=maxFollowing,proto3" json:"max_following,omitempty"`
+	// change_proposal_ttl is the number of seconds a post change proposal stays
+	// open for approval. Zero keeps proposals open until applied.
+	ChangeProposalTtl uint64 `protobuf:"varint,3,opt,name=change_proposal_ttl,json=changeProposalTtl,proto3" json:"change_proposal_ttl,omitempty"`
+	// trash_retention is the number of seconds a deleted post can be restored
+	// before it is purged. Zero keeps deleted posts forever.
+	TrashRetention uint64 `protobuf:"varint,4,opt,name=trash_retention,json=trashRetention,proto3" json:"trash_retention,omitempty"`
+	// create_gas_per_byte is charged for every byte of the title, summary, body,
+	// body URI and slug of a new post, on top of the gas of the store writes.
+	CreateGasPerByte uint64 `protobuf:"varint,5,opt,name=create_gas_per_byte,json=createGasPerByte,proto3" json:"create_gas_per_byte,omitempty"`
+	// update_gas_per_byte is charged for every byte of the title and body of an
+	// updated post, and for every byte a patch inserts.
+	UpdateGasPerByte uint64 `protobuf:"varint,6,opt,name=update_gas_per_byte,json=updateGasPerByte,proto3" json:"update_gas_per_byte,omitempty"`
+	// post_quota caps the number of posts an account may create within the
+	// last post_quota_window blocks. Zero in either disables the quota.
+	PostQuota       uint64 `protobuf:"varint,7,opt,name=post_quota,json=postQuota,proto3" json:"post_quota,omitempty"`
+	PostQuotaWindow uint64 `protobuf:"varint,8,opt,name=post_quota_window,json=postQuotaWindow,proto3" json:"post_quota_window,omitempty"`
+	// post_quota_allowlist lists the accounts exempt from the post quota.
+	PostQuotaAllowlist []string `protobuf:"bytes,9,rep,name=post_quota_allowlist,json=postQuotaAllowlist,proto3" json:"post_quota_allowlist,omitempty"`
+	// max_tx_msgs caps the number of blog messages a single tx may carry,
+	// including those wrapped in authz MsgExec. Zero disables the cap.
+	MaxTxMsgs uint64 `protobuf:"varint,10,opt,name=max_tx_msgs,json=maxTxMsgs,proto3" json:"max_tx_msgs,omitempty"`
+	// max_body_bytes caps the size of post bodies carried by a tx, checked
+	// before execution. Zero disables the cap.
+	MaxBodyBytes uint64 `protobuf:"varint,11,opt,name=max_body_bytes,json=maxBodyBytes,proto3" json:"max_body_bytes,omitempty"`
+	// min_post_fee is the minimum fee a tx creating posts must pay in the denom
+	// of the coin. A zero amount disables the requirement.
+	MinPostFee *v1beta1.Coin `protobuf:"bytes,12,opt,name=min_post_fee,json=minPostFee,proto3" json:"min_post_fee,omitempty"`
+	// purchase_refund_timeout is the number of seconds the payment of a
+	// purchase is held in escrow waiting for the creator to grant a key
+	// envelope, after which the buyer is refunded. Zero pays the creator at
+	// once.
+	PurchaseRefundTimeout uint64 `protobuf:"varint,13,opt,name=purchase_refund_timeout,json=purchaseRefundTimeout,proto3" json:"purchase_refund_timeout,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -410,25 +1181,154 @@ func (*Params) Descriptor() ([]byte, []int) {
 	return file_blog_blog_params_proto_rawDescGZIP(), []int{0}
 }
 
+func (x *Params) GetMaxBatchSize() uint64 {
+	if x != nil {
+		return x.MaxBatchSize
+	}
+	return 0
+}
+
+func (x *Params) GetMaxFollowing() uint64 {
+	if x != nil {
+		return x.MaxFollowing
+	}
+	return 0
+}
+
+func (x *Params) GetChangeProposalTtl() uint64 {
+	if x != nil {
+		return x.ChangeProposalTtl
+	}
+	return 0
+}
+
+func (x *Params) GetTrashRetention() uint64 {
+	if x != nil {
+		return x.TrashRetention
+	}
+	return 0
+}
+
+func (x *Params) GetCreateGasPerByte() uint64 {
+	if x != nil {
+		return x.CreateGasPerByte
+	}
+	return 0
+}
+
+func (x *Params) GetUpdateGasPerByte() uint64 {
+	if x != nil {
+		return x.UpdateGasPerByte
+	}
+	return 0
+}
+
+func (x *Params) GetPostQuota() uint64 {
+	if x != nil {
+		return x.PostQuota
+	}
+	return 0
+}
+
+func (x *Params) GetPostQuotaWindow() uint64 {
+	if x != nil {
+		return x.PostQuotaWindow
+	}
+	return 0
+}
+
+func (x *Params) GetPostQuotaAllowlist() []string {
+	if x != nil {
+		return x.PostQuotaAllowlist
+	}
+	return nil
+}
+
+func (x *Params) GetMaxTxMsgs() uint64 {
+	if x != nil {
+		return x.MaxTxMsgs
+	}
+	return 0
+}
+
+func (x *Params) GetMaxBodyBytes() uint64 {
+	if x != nil {
+		return x.MaxBodyBytes
+	}
+	return 0
+}
+
+func (x *Params) GetMinPostFee() *v1beta1.Coin {
+	if x != nil {
+		return x.MinPostFee
+	}
+	return nil
+}
+
+func (x *Params) GetPurchaseRefundTimeout() uint64 {
+	if x != nil {
+		return x.PurchaseRefundTimeout
+	}
+	return 0
+}
+
 var File_blog_blog_params_proto protoreflect.FileDescriptor
 
 var file_blog_blog_params_proto_rawDesc = []byte{
 	0x0a, 0x16, 0x62, 0x6c, 0x6f, 0x67, 0x2f, 0x62, 0x6c, 0x6f, 0x67, 0x2f, 0x70, 0x61, 0x72, 0x61,
 	0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x62, 0x6c, 0x6f, 0x67, 0x2e, 0x62,
 	0x6c, 0x6f, 0x67, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62,
+	0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x25, 0x0a, 0x06,
-	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x3a, 0x1b, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a,
-	0x12, 0x62, 0x6c, 0x6f, 0x67, 0x2f, 0x78, 0x2f, 0x62, 0x6c, 0x6f, 0x67, 0x2f, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x42, 0x75, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x6c, 0x6f, 0x67, 0x2e,
-	0x62, 0x6c, 0x6f, 0x67, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x50, 0x01, 0x5a, 0x12, 0x62, 0x6c, 0x6f, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x6c,
-	0x6f, 0x67, 0x2f, 0x62, 0x6c, 0x6f, 0x67, 0xa2, 0x02, 0x03, 0x42, 0x42, 0x58, 0xaa, 0x02, 0x09,
-	0x42, 0x6c, 0x6f, 0x67, 0x2e, 0x42, 0x6c, 0x6f, 0x67, 0xca, 0x02, 0x09, 0x42, 0x6c, 0x6f, 0x67,
-	0x5c, 0x42, 0x6c, 0x6f, 0x67, 0xe2, 0x02, 0x15, 0x42, 0x6c, 0x6f, 0x67, 0x5c, 0x42, 0x6c, 0x6f,
-	0x67, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0a,
-	0x42, 0x6c, 0x6f, 0x67, 0x3a, 0x3a, 0x42, 0x6c, 0x6f, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xea, 0x04, 0x0a,
+	0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x62,
+	0x61, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0c, 0x6d, 0x61, 0x78, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x23, 0x0a,
+	0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69,
+	0x6e, 0x67, 0x12, 0x2e, 0x0a, 0x13, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x70, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x74, 0x74, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x11, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x54,
+	0x74, 0x6c, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x72, 0x61, 0x73, 0x68, 0x5f, 0x72, 0x65, 0x74, 0x65,
+	0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x74, 0x72, 0x61,
+	0x73, 0x68, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x13, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x79,
+	0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x47, 0x61, 0x73, 0x50, 0x65, 0x72, 0x42, 0x79, 0x74, 0x65, 0x12, 0x2d, 0x0a, 0x13, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x74,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47,
+	0x61, 0x73, 0x50, 0x65, 0x72, 0x42, 0x79, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6f, 0x73,
+	0x74, 0x5f, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x70,
+	0x6f, 0x73, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x2a, 0x0a, 0x11, 0x70, 0x6f, 0x73, 0x74,
+	0x5f, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0f, 0x70, 0x6f, 0x73, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x57, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x12, 0x30, 0x0a, 0x14, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x71, 0x75, 0x6f,
+	0x74, 0x61, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x09, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x12, 0x70, 0x6f, 0x73, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x41, 0x6c, 0x6c,
+	0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x78,
+	0x5f, 0x6d, 0x73, 0x67, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6d, 0x61, 0x78,
+	0x54, 0x78, 0x4d, 0x73, 0x67, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x6f,
+	0x64, 0x79, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c,
+	0x6d, 0x61, 0x78, 0x42, 0x6f, 0x64, 0x79, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x46, 0x0a, 0x0c,
+	0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x09, 0xc8,
+	0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x50, 0x6f, 0x73,
+	0x74, 0x46, 0x65, 0x65, 0x12, 0x36, 0x0a, 0x17, 0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65,
+	0x5f, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x52,
+	0x65, 0x66, 0x75, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x3a, 0x1b, 0xe8, 0xa0,
+	0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x12, 0x62, 0x6c, 0x6f, 0x67, 0x2f, 0x78, 0x2f, 0x62, 0x6c,
+	0x6f, 0x67, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x75, 0x0a, 0x0d, 0x63, 0x6f, 0x6d,
+	0x2e, 0x62, 0x6c, 0x6f, 0x67, 0x2e, 0x62, 0x6c, 0x6f, 0x67, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x12, 0x62, 0x6c, 0x6f, 0x67, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x62, 0x6c, 0x6f, 0x67, 0x2f, 0x62, 0x6c, 0x6f, 0x67, 0xa2, 0x02, 0x03,
+	0x42, 0x42, 0x58, 0xaa, 0x02, 0x09, 0x42, 0x6c, 0x6f, 0x67, 0x2e, 0x42, 0x6c, 0x6f, 0x67, 0xca,
+	0x02, 0x09, 0x42, 0x6c, 0x6f, 0x67, 0x5c, 0x42, 0x6c, 0x6f, 0x67, 0xe2, 0x02, 0x15, 0x42, 0x6c,
+	0x6f, 0x67, 0x5c, 0x42, 0x6c, 0x6f, 0x67, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0xea, 0x02, 0x0a, 0x42, 0x6c, 0x6f, 0x67, 0x3a, 0x3a, 0x42, 0x6c, 0x6f, 0x67,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -445,14 +1345,16 @@ func file_blog_blog_params_proto_rawDescGZIP() []byte {
 
 var file_blog_blog_params_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_blog_blog_params_proto_goTypes = []interface{}{
-	(*Params)(nil), // 0: blog.blog.Params
+	(*Params)(nil),       // 0: blog.blog.Params
+	(*v1beta1.Coin)(nil), // 1: cosmos.base.v1beta1.Coin
 }
 var file_blog_blog_params_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	1, // 0: blog.blog.Params.min_post_fee:type_name -> cosmos.base.v1beta1.Coin
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_blog_blog_params_proto_init() }
@@ -3,6 +3,7 @@ package blog
 
 import (
 	_ "cosmossdk.io/api/amino"
+	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	fmt "fmt"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -15,6 +16,624 @@ import (
 	sync "sync"
 )
 
+var (
+	md_KeyEnvelope                   protoreflect.MessageDescriptor
+	fd_KeyEnvelope_reader            protoreflect.FieldDescriptor
+	fd_KeyEnvelope_reader_pub_key    protoreflect.FieldDescriptor
+	fd_KeyEnvelope_ephemeral_pub_key protoreflect.FieldDescriptor
+	fd_KeyEnvelope_sealed_key        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_blog_blog_post_proto_init()
+	md_KeyEnvelope = File_blog_blog_post_proto.Messages().ByName("KeyEnvelope")
+	fd_KeyEnvelope_reader = md_KeyEnvelope.Fields().ByName("reader")
+	fd_KeyEnvelope_reader_pub_key = md_KeyEnvelope.Fields().ByName("reader_pub_key")
+	fd_KeyEnvelope_ephemeral_pub_key = md_KeyEnvelope.Fields().ByName("ephemeral_pub_key")
+	fd_KeyEnvelope_sealed_key = md_KeyEnvelope.Fields().ByName("sealed_key")
+}
+
+var _ protoreflect.Message = (*fastReflection_KeyEnvelope)(nil)
+
+type fastReflection_KeyEnvelope KeyEnvelope
+
+func (x *KeyEnvelope) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_KeyEnvelope)(x)
+}
+
+func (x *KeyEnvelope) slowProtoReflect() protoreflect.Message {
+	mi := &file_blog_blog_post_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_KeyEnvelope_messageType fastReflection_KeyEnvelope_messageType
+var _ protoreflect.MessageType = fastReflection_KeyEnvelope_messageType{}
+
+type fastReflection_KeyEnvelope_messageType struct{}
+
+func (x fastReflection_KeyEnvelope_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_KeyEnvelope)(nil)
+}
+func (x fastReflection_KeyEnvelope_messageType) New() protoreflect.Message {
+	return new(fastReflection_KeyEnvelope)
+}
+func (x fastReflection_KeyEnvelope_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_KeyEnvelope
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_KeyEnvelope) Descriptor() protoreflect.MessageDescriptor {
+	return md_KeyEnvelope
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_KeyEnvelope) Type() protoreflect.MessageType {
+	return _fastReflection_KeyEnvelope_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_KeyEnvelope) New() protoreflect.Message {
+	return new(fastReflection_KeyEnvelope)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_KeyEnvelope) Interface() protoreflect.ProtoMessage {
+	return (*KeyEnvelope)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_KeyEnvelope) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Reader != "" {
+		value := protoreflect.ValueOfString(x.Reader)
+		if !f(fd_KeyEnvelope_reader, value) {
+			return
+		}
+	}
+	if len(x.ReaderPubKey) != 0 {
+		value := protoreflect.ValueOfBytes(x.ReaderPubKey)
+		if !f(fd_KeyEnvelope_reader_pub_key, value) {
+			return
+		}
+	}
+	if len(x.EphemeralPubKey) != 0 {
+		value := protoreflect.ValueOfBytes(x.EphemeralPubKey)
+		if !f(fd_KeyEnvelope_ephemeral_pub_key, value) {
+			return
+		}
+	}
+	if len(x.SealedKey) != 0 {
+		value := protoreflect.ValueOfBytes(x.SealedKey)
+		if !f(fd_KeyEnvelope_sealed_key, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_KeyEnvelope) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "blog.blog.KeyEnvelope.reader":
+		return x.Reader != ""
+	case "blog.blog.KeyEnvelope.reader_pub_key":
+		return len(x.ReaderPubKey) != 0
+	case "blog.blog.KeyEnvelope.ephemeral_pub_key":
+		return len(x.EphemeralPubKey) != 0
+	case "blog.blog.KeyEnvelope.sealed_key":
+		return len(x.SealedKey) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: blog.blog.KeyEnvelope"))
+		}
+		panic(fmt.Errorf("message blog.blog.KeyEnvelope does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_KeyEnvelope) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "blog.blog.KeyEnvelope.reader":
+		x.Reader = ""
+	case "blog.blog.KeyEnvelope.reader_pub_key":
+		x.ReaderPubKey = nil
+	case "blog.blog.KeyEnvelope.ephemeral_pub_key":
+		x.EphemeralPubKey = nil
+	case "blog.blog.KeyEnvelope.sealed_key":
+		x.SealedKey = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: blog.blog.KeyEnvelope"))
+		}
+		panic(fmt.Errorf("message blog.blog.KeyEnvelope does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_KeyEnvelope) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "blog.blog.KeyEnvelope.reader":
+		value := x.Reader
+		return protoreflect.ValueOfString(value)
+	case "blog.blog.KeyEnvelope.reader_pub_key":
+		value := x.ReaderPubKey
+		return protoreflect.ValueOfBytes(value)
+	case "blog.blog.KeyEnvelope.ephemeral_pub_key":
+		value := x.EphemeralPubKey
+		return protoreflect.ValueOfBytes(value)
+	case "blog.blog.KeyEnvelope.sealed_key":
+		value := x.SealedKey
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: blog.blog.KeyEnvelope"))
+		}
+		panic(fmt.Errorf("message blog.blog.KeyEnvelope does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_KeyEnvelope) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "blog.blog.KeyEnvelope.reader":
+		x.Reader = value.Interface().(string)
+	case "blog.blog.KeyEnvelope.reader_pub_key":
+		x.ReaderPubKey = value.Bytes()
+	case "blog.blog.KeyEnvelope.ephemeral_pub_key":
+		x.EphemeralPubKey = value.Bytes()
+	case "blog.blog.KeyEnvelope.sealed_key":
+		x.SealedKey = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: blog.blog.KeyEnvelope"))
+		}
+		panic(fmt.Errorf("message blog.blog.KeyEnvelope does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_KeyEnvelope) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "blog.blog.KeyEnvelope.reader":
+		panic(fmt.Errorf("field reader of message blog.blog.KeyEnvelope is not mutable"))
+	case "blog.blog.KeyEnvelope.reader_pub_key":
+		panic(fmt.Errorf("field reader_pub_key of message blog.blog.KeyEnvelope is not mutable"))
+	case "blog.blog.KeyEnvelope.ephemeral_pub_key":
+		panic(fmt.Errorf("field ephemeral_pub_key of message blog.blog.KeyEnvelope is not mutable"))
+	case "blog.blog.KeyEnvelope.sealed_key":
+		panic(fmt.Errorf("field sealed_key of message blog.blog.KeyEnvelope is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: blog.blog.KeyEnvelope"))
+		}
+		panic(fmt.Errorf("message blog.blog.KeyEnvelope does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_KeyEnvelope) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "blog.blog.KeyEnvelope.reader":
+		return protoreflect.ValueOfString("")
+	case "blog.blog.KeyEnvelope.reader_pub_key":
+		return protoreflect.ValueOfBytes(nil)
+	case "blog.blog.KeyEnvelope.ephemeral_pub_key":
+		return protoreflect.ValueOfBytes(nil)
+	case "blog.blog.KeyEnvelope.sealed_key":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: blog.blog.KeyEnvelope"))
+		}
+		panic(fmt.Errorf("message blog.blog.KeyEnvelope does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_KeyEnvelope) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in blog.blog.KeyEnvelope", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_KeyEnvelope) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_KeyEnvelope) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_KeyEnvelope) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_KeyEnvelope) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*KeyEnvelope)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Reader)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ReaderPubKey)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.EphemeralPubKey)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.SealedKey)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*KeyEnvelope)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.SealedKey) > 0 {
+			i -= len(x.SealedKey)
+			copy(dAtA[i:], x.SealedKey)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SealedKey)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.EphemeralPubKey) > 0 {
+			i -= len(x.EphemeralPubKey)
+			copy(dAtA[i:], x.EphemeralPubKey)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EphemeralPubKey)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.ReaderPubKey) > 0 {
+			i -= len(x.ReaderPubKey)
+			copy(dAtA[i:], x.ReaderPubKey)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ReaderPubKey)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Reader) > 0 {
+			i -= len(x.Reader)
+			copy(dAtA[i:], x.Reader)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reader)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*KeyEnvelope)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: KeyEnvelope: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: KeyEnvelope: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reader", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reader = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReaderPubKey", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ReaderPubKey = append(x.ReaderPubKey[:0], dAtA[iNdEx:postIndex]...)
+				if x.ReaderPubKey == nil {
+					x.ReaderPubKey = []byte{}
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EphemeralPubKey", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EphemeralPubKey = append(x.EphemeralPubKey[:0], dAtA[iNdEx:postIndex]...)
+				if x.EphemeralPubKey == nil {
+					x.EphemeralPubKey = []byte{}
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SealedKey", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SealedKey = append(x.SealedKey[:0], dAtA[iNdEx:postIndex]...)
+				if x.SealedKey == nil {
+					x.SealedKey = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
 var _ protoreflect.List = (*_Post_7_list)(nil)
 
 type _Post_7_list struct {
@@ -61,15 +680,219 @@ func (x *_Post_7_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_Post_9_list)(nil)
+
+type _Post_9_list struct {
+	list *[]string
+}
+
+func (x *_Post_9_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Post_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Post_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Post_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Post_9_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Post at list field CoAuthors as it is not of Message kind"))
+}
+
+func (x *_Post_9_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Post_9_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Post_9_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Post_13_list)(nil)
+
+type _Post_13_list struct {
+	list *[]*KeyEnvelope
+}
+
+func (x *_Post_13_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Post_13_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Post_13_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*KeyEnvelope)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Post_13_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*KeyEnvelope)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Post_13_list) AppendMutable() protoreflect.Value {
+	v := new(KeyEnvelope)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Post_13_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Post_13_list) NewElement() protoreflect.Value {
+	v := new(KeyEnvelope)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Post_13_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Post_14_list)(nil)
+
+type _Post_14_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_Post_14_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Post_14_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Post_14_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Post_14_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Post_14_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Post_14_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Post_14_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Post_14_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Post_16_list)(nil)
+
+type _Post_16_list struct {
+	list *[]string
+}
+
+func (x *_Post_16_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Post_16_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Post_16_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Post_16_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Post_16_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Post at list field Tags as it is not of Message kind"))
+}
+
+func (x *_Post_16_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Post_16_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Post_16_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Post                 protoreflect.MessageDescriptor
-	fd_Post_title           protoreflect.FieldDescriptor
-	fd_Post_body            protoreflect.FieldDescriptor
-	fd_Post_creator         protoreflect.FieldDescriptor
-	fd_Post_id              protoreflect.FieldDescriptor
-	fd_Post_created_at      protoreflect.FieldDescriptor
-	fd_Post_last_updated_at protoreflect.FieldDescriptor
-	fd_Post_editors         protoreflect.FieldDescriptor
+	md_Post                    protoreflect.MessageDescriptor
+	fd_Post_title              protoreflect.FieldDescriptor
+	fd_Post_body               protoreflect.FieldDescriptor
+	fd_Post_creator            protoreflect.FieldDescriptor
+	fd_Post_id                 protoreflect.FieldDescriptor
+	fd_Post_created_at         protoreflect.FieldDescriptor
+	fd_Post_last_updated_at    protoreflect.FieldDescriptor
+	fd_Post_editors            protoreflect.FieldDescriptor
+	fd_Post_slug               protoreflect.FieldDescriptor
+	fd_Post_co_authors         protoreflect.FieldDescriptor
+	fd_Post_approval_threshold protoreflect.FieldDescriptor
+	fd_Post_version            protoreflect.FieldDescriptor
+	fd_Post_body_format        protoreflect.FieldDescriptor
+	fd_Post_key_envelopes      protoreflect.FieldDescriptor
+	fd_Post_price              protoreflect.FieldDescriptor
+	fd_Post_summary            protoreflect.FieldDescriptor
+	fd_Post_tags               protoreflect.FieldDescriptor
+	fd_Post_body_uri           protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -82,6 +905,16 @@ func init() {
 	fd_Post_created_at = md_Post.Fields().ByName("created_at")
 	fd_Post_last_updated_at = md_Post.Fields().ByName("last_updated_at")
 	fd_Post_editors = md_Post.Fields().ByName("editors")
+	fd_Post_slug = md_Post.Fields().ByName("slug")
+	fd_Post_co_authors = md_Post.Fields().ByName("co_authors")
+	fd_Post_approval_threshold = md_Post.Fields().ByName("approval_threshold")
+	fd_Post_version = md_Post.Fields().ByName("version")
+	fd_Post_body_format = md_Post.Fields().ByName("body_format")
+	fd_Post_key_envelopes = md_Post.Fields().ByName("key_envelopes")
+	fd_Post_price = md_Post.Fields().ByName("price")
+	fd_Post_summary = md_Post.Fields().ByName("summary")
+	fd_Post_tags = md_Post.Fields().ByName("tags")
+	fd_Post_body_uri = md_Post.Fields().ByName("body_uri")
 }
 
 var _ protoreflect.Message = (*fastReflection_Post)(nil)
@@ -93,7 +926,7 @@ func (x *Post) ProtoReflect() protoreflect.Message {
 }
 
 func (x *Post) slowProtoReflect() protoreflect.Message {
-	mi := &file_blog_blog_post_proto_msgTypes[0]
+	mi := &file_blog_blog_post_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -191,6 +1024,66 @@ func (x *fastReflection_Post) Range(f func(protoreflect.FieldDescriptor, protore
 			return
 		}
 	}
+	if x.Slug != "" {
+		value := protoreflect.ValueOfString(x.Slug)
+		if !f(fd_Post_slug, value) {
+			return
+		}
+	}
+	if len(x.CoAuthors) != 0 {
+		value := protoreflect.ValueOfList(&_Post_9_list{list: &x.CoAuthors})
+		if !f(fd_Post_co_authors, value) {
+			return
+		}
+	}
+	if x.ApprovalThreshold != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.ApprovalThreshold)
+		if !f(fd_Post_approval_threshold, value) {
+			return
+		}
+	}
+	if x.Version != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Version)
+		if !f(fd_Post_version, value) {
+			return
+		}
+	}
+	if x.BodyFormat != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.BodyFormat))
+		if !f(fd_Post_body_format, value) {
+			return
+		}
+	}
+	if len(x.KeyEnvelopes) != 0 {
+		value := protoreflect.ValueOfList(&_Post_13_list{list: &x.KeyEnvelopes})
+		if !f(fd_Post_key_envelopes, value) {
+			return
+		}
+	}
+	if len(x.Price) != 0 {
+		value := protoreflect.ValueOfList(&_Post_14_list{list: &x.Price})
+		if !f(fd_Post_price, value) {
+			return
+		}
+	}
+	if x.Summary != "" {
+		value := protoreflect.ValueOfString(x.Summary)
+		if !f(fd_Post_summary, value) {
+			return
+		}
+	}
+	if len(x.Tags) != 0 {
+		value := protoreflect.ValueOfList(&_Post_16_list{list: &x.Tags})
+		if !f(fd_Post_tags, value) {
+			return
+		}
+	}
+	if x.BodyUri != "" {
+		value := protoreflect.ValueOfString(x.BodyUri)
+		if !f(fd_Post_body_uri, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -220,6 +1113,26 @@ func (x *fastReflection_Post) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.LastUpdatedAt != nil
 	case "blog.blog.Post.editors":
 		return len(x.Editors) != 0
+	case "blog.blog.Post.slug":
+		return x.Slug != ""
+	case "blog.blog.Post.co_authors":
+		return len(x.CoAuthors) != 0
+	case "blog.blog.Post.approval_threshold":
+		return x.ApprovalThreshold != uint32(0)
+	case "blog.blog.Post.version":
+		return x.Version != uint64(0)
+	case "blog.blog.Post.body_format":
+		return x.BodyFormat != 0
+	case "blog.blog.Post.key_envelopes":
+		return len(x.KeyEnvelopes) != 0
+	case "blog.blog.Post.price":
+		return len(x.Price) != 0
+	case "blog.blog.Post.summary":
+		return x.Summary != ""
+	case "blog.blog.Post.tags":
+		return len(x.Tags) != 0
+	case "blog.blog.Post.body_uri":
+		return x.BodyUri != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: blog.blog.Post"))
@@ -250,6 +1163,26 @@ func (x *fastReflection_Post) Clear(fd protoreflect.FieldDescriptor) {
 		x.LastUpdatedAt = nil
 	case "blog.blog.Post.editors":
 		x.Editors = nil
+	case "blog.blog.Post.slug":
+		x.Slug = ""
+	case "blog.blog.Post.co_authors":
+		x.CoAuthors = nil
+	case "blog.blog.Post.approval_threshold":
+		x.ApprovalThreshold = uint32(0)
+	case "blog.blog.Post.version":
+		x.Version = uint64(0)
+	case "blog.blog.Post.body_format":
+		x.BodyFormat = 0
+	case "blog.blog.Post.key_envelopes":
+		x.KeyEnvelopes = nil
+	case "blog.blog.Post.price":
+		x.Price = nil
+	case "blog.blog.Post.summary":
+		x.Summary = ""
+	case "blog.blog.Post.tags":
+		x.Tags = nil
+	case "blog.blog.Post.body_uri":
+		x.BodyUri = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: blog.blog.Post"))
@@ -290,6 +1223,48 @@ func (x *fastReflection_Post) Get(descriptor protoreflect.FieldDescriptor) proto
 		}
 		listValue := &_Post_7_list{list: &x.Editors}
 		return protoreflect.ValueOfList(listValue)
+	case "blog.blog.Post.slug":
+		value := x.Slug
+		return protoreflect.ValueOfString(value)
+	case "blog.blog.Post.co_authors":
+		if len(x.CoAuthors) == 0 {
+			return protoreflect.ValueOfList(&_Post_9_list{})
+		}
+		listValue := &_Post_9_list{list: &x.CoAuthors}
+		return protoreflect.ValueOfList(listValue)
+	case "blog.blog.Post.approval_threshold":
+		value := x.ApprovalThreshold
+		return protoreflect.ValueOfUint32(value)
+	case "blog.blog.Post.version":
+		value := x.Version
+		return protoreflect.ValueOfUint64(value)
+	case "blog.blog.Post.body_format":
+		value := x.BodyFormat
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "blog.blog.Post.key_envelopes":
+		if len(x.KeyEnvelopes) == 0 {
+			return protoreflect.ValueOfList(&_Post_13_list{})
+		}
+		listValue := &_Post_13_list{list: &x.KeyEnvelopes}
+		return protoreflect.ValueOfList(listValue)
+	case "blog.blog.Post.price":
+		if len(x.Price) == 0 {
+			return protoreflect.ValueOfList(&_Post_14_list{})
+		}
+		listValue := &_Post_14_list{list: &x.Price}
+		return protoreflect.ValueOfList(listValue)
+	case "blog.blog.Post.summary":
+		value := x.Summary
+		return protoreflect.ValueOfString(value)
+	case "blog.blog.Post.tags":
+		if len(x.Tags) == 0 {
+			return protoreflect.ValueOfList(&_Post_16_list{})
+		}
+		listValue := &_Post_16_list{list: &x.Tags}
+		return protoreflect.ValueOfList(listValue)
+	case "blog.blog.Post.body_uri":
+		value := x.BodyUri
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: blog.blog.Post"))
@@ -326,6 +1301,34 @@ func (x *fastReflection_Post) Set(fd protoreflect.FieldDescriptor, value protore
 		lv := value.List()
 		clv := lv.(*_Post_7_list)
 		x.Editors = *clv.list
+	case "blog.blog.Post.slug":
+		x.Slug = value.Interface().(string)
+	case "blog.blog.Post.co_authors":
+		lv := value.List()
+		clv := lv.(*_Post_9_list)
+		x.CoAuthors = *clv.list
+	case "blog.blog.Post.approval_threshold":
+		x.ApprovalThreshold = uint32(value.Uint())
+	case "blog.blog.Post.version":
+		x.Version = value.Uint()
+	case "blog.blog.Post.body_format":
+		x.BodyFormat = (BodyFormat)(value.Enum())
+	case "blog.blog.Post.key_envelopes":
+		lv := value.List()
+		clv := lv.(*_Post_13_list)
+		x.KeyEnvelopes = *clv.list
+	case "blog.blog.Post.price":
+		lv := value.List()
+		clv := lv.(*_Post_14_list)
+		x.Price = *clv.list
+	case "blog.blog.Post.summary":
+		x.Summary = value.Interface().(string)
+	case "blog.blog.Post.tags":
+		lv := value.List()
+		clv := lv.(*_Post_16_list)
+		x.Tags = *clv.list
+	case "blog.blog.Post.body_uri":
+		x.BodyUri = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: blog.blog.Post"))
@@ -360,7 +1363,31 @@ func (x *fastReflection_Post) Mutable(fd protoreflect.FieldDescriptor) protorefl
 		if x.Editors == nil {
 			x.Editors = []string{}
 		}
-		value := &_Post_7_list{list: &x.Editors}
+		value := &_Post_7_list{list: &x.Editors}
+		return protoreflect.ValueOfList(value)
+	case "blog.blog.Post.co_authors":
+		if x.CoAuthors == nil {
+			x.CoAuthors = []string{}
+		}
+		value := &_Post_9_list{list: &x.CoAuthors}
+		return protoreflect.ValueOfList(value)
+	case "blog.blog.Post.key_envelopes":
+		if x.KeyEnvelopes == nil {
+			x.KeyEnvelopes = []*KeyEnvelope{}
+		}
+		value := &_Post_13_list{list: &x.KeyEnvelopes}
+		return protoreflect.ValueOfList(value)
+	case "blog.blog.Post.price":
+		if x.Price == nil {
+			x.Price = []*v1beta1.Coin{}
+		}
+		value := &_Post_14_list{list: &x.Price}
+		return protoreflect.ValueOfList(value)
+	case "blog.blog.Post.tags":
+		if x.Tags == nil {
+			x.Tags = []string{}
+		}
+		value := &_Post_16_list{list: &x.Tags}
 		return protoreflect.ValueOfList(value)
 	case "blog.blog.Post.title":
 		panic(fmt.Errorf("field title of message blog.blog.Post is not mutable"))
@@ -370,6 +1397,18 @@ func (x *fastReflection_Post) Mutable(fd protoreflect.FieldDescriptor) protorefl
 		panic(fmt.Errorf("field creator of message blog.blog.Post is not mutable"))
 	case "blog.blog.Post.id":
 		panic(fmt.Errorf("field id of message blog.blog.Post is not mutable"))
+	case "blog.blog.Post.slug":
+		panic(fmt.Errorf("field slug of message blog.blog.Post is not mutable"))
+	case "blog.blog.Post.approval_threshold":
+		panic(fmt.Errorf("field approval_threshold of message blog.blog.Post is not mutable"))
+	case "blog.blog.Post.version":
+		panic(fmt.Errorf("field version of message blog.blog.Post is not mutable"))
+	case "blog.blog.Post.body_format":
+		panic(fmt.Errorf("field body_format of message blog.blog.Post is not mutable"))
+	case "blog.blog.Post.summary":
+		panic(fmt.Errorf("field summary of message blog.blog.Post is not mutable"))
+	case "blog.blog.Post.body_uri":
+		panic(fmt.Errorf("field body_uri of message blog.blog.Post is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: blog.blog.Post"))
@@ -400,6 +1439,30 @@ func (x *fastReflection_Post) NewField(fd protoreflect.FieldDescriptor) protoref
 	case "blog.blog.Post.editors":
 		list := []string{}
 		return protoreflect.ValueOfList(&_Post_7_list{list: &list})
+	case "blog.blog.Post.slug":
+		return protoreflect.ValueOfString("")
+	case "blog.blog.Post.co_authors":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Post_9_list{list: &list})
+	case "blog.blog.Post.approval_threshold":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "blog.blog.Post.version":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "blog.blog.Post.body_format":
+		return protoreflect.ValueOfEnum(0)
+	case "blog.blog.Post.key_envelopes":
+		list := []*KeyEnvelope{}
+		return protoreflect.ValueOfList(&_Post_13_list{list: &list})
+	case "blog.blog.Post.price":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_Post_14_list{list: &list})
+	case "blog.blog.Post.summary":
+		return protoreflect.ValueOfString("")
+	case "blog.blog.Post.tags":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Post_16_list{list: &list})
+	case "blog.blog.Post.body_uri":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: blog.blog.Post"))
@@ -498,6 +1561,51 @@ func (x *fastReflection_Post) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		l = len(x.Slug)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.CoAuthors) > 0 {
+			for _, s := range x.CoAuthors {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.ApprovalThreshold != 0 {
+			n += 1 + runtime.Sov(uint64(x.ApprovalThreshold))
+		}
+		if x.Version != 0 {
+			n += 1 + runtime.Sov(uint64(x.Version))
+		}
+		if x.BodyFormat != 0 {
+			n += 1 + runtime.Sov(uint64(x.BodyFormat))
+		}
+		if len(x.KeyEnvelopes) > 0 {
+			for _, e := range x.KeyEnvelopes {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Price) > 0 {
+			for _, e := range x.Price {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.Summary)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Tags) > 0 {
+			for _, s := range x.Tags {
+				l = len(s)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.BodyUri)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -527,6 +1635,96 @@ func (x *fastReflection_Post) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.BodyUri) > 0 {
+			i -= len(x.BodyUri)
+			copy(dAtA[i:], x.BodyUri)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BodyUri)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+		if len(x.Tags) > 0 {
+			for iNdEx := len(x.Tags) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Tags[iNdEx])
+				copy(dAtA[i:], x.Tags[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Tags[iNdEx])))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x82
+			}
+		}
+		if len(x.Summary) > 0 {
+			i -= len(x.Summary)
+			copy(dAtA[i:], x.Summary)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Summary)))
+			i--
+			dAtA[i] = 0x7a
+		}
+		if len(x.Price) > 0 {
+			for iNdEx := len(x.Price) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Price[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x72
+			}
+		}
+		if len(x.KeyEnvelopes) > 0 {
+			for iNdEx := len(x.KeyEnvelopes) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.KeyEnvelopes[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x6a
+			}
+		}
+		if x.BodyFormat != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BodyFormat))
+			i--
+			dAtA[i] = 0x60
+		}
+		if x.Version != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Version))
+			i--
+			dAtA[i] = 0x58
+		}
+		if x.ApprovalThreshold != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ApprovalThreshold))
+			i--
+			dAtA[i] = 0x50
+		}
+		if len(x.CoAuthors) > 0 {
+			for iNdEx := len(x.CoAuthors) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.CoAuthors[iNdEx])
+				copy(dAtA[i:], x.CoAuthors[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CoAuthors[iNdEx])))
+				i--
+				dAtA[i] = 0x4a
+			}
+		}
+		if len(x.Slug) > 0 {
+			i -= len(x.Slug)
+			copy(dAtA[i:], x.Slug)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Slug)))
+			i--
+			dAtA[i] = 0x42
+		}
 		if len(x.Editors) > 0 {
 			for iNdEx := len(x.Editors) - 1; iNdEx >= 0; iNdEx-- {
 				i -= len(x.Editors[iNdEx])
@@ -629,19 +1827,206 @@ func (x *fastReflection_Post) ProtoMethods() *protoiface.Methods {
 				if b < 0x80 {
 					break
 				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Post: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Post: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Post: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Post: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Title = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Body = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.CreatedAt == nil {
+					x.CreatedAt = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CreatedAt); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastUpdatedAt", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.LastUpdatedAt == nil {
+					x.LastUpdatedAt = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LastUpdatedAt); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Editors", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -669,11 +2054,11 @@ func (x *fastReflection_Post) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Title = string(dAtA[iNdEx:postIndex])
+				x.Editors = append(x.Editors, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
-			case 2:
+			case 8:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Slug", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -701,11 +2086,11 @@ func (x *fastReflection_Post) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Body = string(dAtA[iNdEx:postIndex])
+				x.Slug = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 3:
+			case 9:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CoAuthors", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -733,13 +2118,13 @@ func (x *fastReflection_Post) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Creator = string(dAtA[iNdEx:postIndex])
+				x.CoAuthors = append(x.CoAuthors, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
-			case 4:
+			case 10:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ApprovalThreshold", wireType)
 				}
-				x.Id = 0
+				x.ApprovalThreshold = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -749,14 +2134,52 @@ func (x *fastReflection_Post) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Id |= uint64(b&0x7F) << shift
+					x.ApprovalThreshold |= uint32(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 5:
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				}
+				x.Version = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Version |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BodyFormat", wireType)
+				}
+				x.BodyFormat = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BodyFormat |= BodyFormat(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 13:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field KeyEnvelopes", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -783,16 +2206,14 @@ func (x *fastReflection_Post) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.CreatedAt == nil {
-					x.CreatedAt = &timestamppb.Timestamp{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CreatedAt); err != nil {
+				x.KeyEnvelopes = append(x.KeyEnvelopes, &KeyEnvelope{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.KeyEnvelopes[len(x.KeyEnvelopes)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 6:
+			case 14:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastUpdatedAt", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -819,16 +2240,14 @@ func (x *fastReflection_Post) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.LastUpdatedAt == nil {
-					x.LastUpdatedAt = &timestamppb.Timestamp{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LastUpdatedAt); err != nil {
+				x.Price = append(x.Price, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Price[len(x.Price)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 7:
+			case 15:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Editors", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -856,7 +2275,71 @@ func (x *fastReflection_Post) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Editors = append(x.Editors, string(dAtA[iNdEx:postIndex]))
+				x.Summary = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 16:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Tags = append(x.Tags, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 17:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BodyUri", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BodyUri = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -906,6 +2389,132 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// BodyFormat tells how the body of a post is written and rendered.
+type BodyFormat int32
+
+const (
+	// BODY_FORMAT_PLAIN bodies are plain text.
+	BodyFormat_BODY_FORMAT_PLAIN BodyFormat = 0
+	// BODY_FORMAT_MARKDOWN bodies are CommonMark with the common extensions.
+	BodyFormat_BODY_FORMAT_MARKDOWN BodyFormat = 1
+	// BODY_FORMAT_HTML_SANITIZED bodies are HTML that the post sanitizer leaves
+	// unchanged.
+	BodyFormat_BODY_FORMAT_HTML_SANITIZED BodyFormat = 2
+	// BODY_FORMAT_ENCRYPTED bodies are the base64 ciphertext of the body,
+	// encrypted with a content key that the key envelopes of the post wrap for
+	// each reader.
+	BodyFormat_BODY_FORMAT_ENCRYPTED BodyFormat = 3
+)
+
+// Enum value maps for BodyFormat.
+var (
+	BodyFormat_name = map[int32]string{
+		0: "BODY_FORMAT_PLAIN",
+		1: "BODY_FORMAT_MARKDOWN",
+		2: "BODY_FORMAT_HTML_SANITIZED",
+		3: "BODY_FORMAT_ENCRYPTED",
+	}
+	BodyFormat_value = map[string]int32{
+		"BODY_FORMAT_PLAIN":          0,
+		"BODY_FORMAT_MARKDOWN":       1,
+		"BODY_FORMAT_HTML_SANITIZED": 2,
+		"BODY_FORMAT_ENCRYPTED":      3,
+	}
+)
+
+func (x BodyFormat) Enum() *BodyFormat {
+	p := new(BodyFormat)
+	*p = x
+	return p
+}
+
+func (x BodyFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BodyFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_blog_blog_post_proto_enumTypes[0].Descriptor()
+}
+
+func (BodyFormat) Type() protoreflect.EnumType {
+	return &file_blog_blog_post_proto_enumTypes[0]
+}
+
+func (x BodyFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BodyFormat.Descriptor instead.
+func (BodyFormat) EnumDescriptor() ([]byte, []int) {
+	return file_blog_blog_post_proto_rawDescGZIP(), []int{0}
+}
+
+// KeyEnvelope is the content key of an encrypted post wrapped for one reader.
+// The key is sealed with AES-256-GCM under a key derived from the ECDH secret
+// of an ephemeral secp256k1 key and the public key of the reader.
+type KeyEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reader string `protobuf:"bytes,1,opt,name=reader,proto3" json:"reader,omitempty"`
+	// reader_pub_key is the compressed secp256k1 public key of the reader, kept
+	// so that the content key can be wrapped again when it is rotated.
+	ReaderPubKey []byte `protobuf:"bytes,2,opt,name=reader_pub_key,json=readerPubKey,proto3" json:"reader_pub_key,omitempty"`
+	// ephemeral_pub_key is the compressed public key of the ephemeral key.
+	EphemeralPubKey []byte `protobuf:"bytes,3,opt,name=ephemeral_pub_key,json=ephemeralPubKey,proto3" json:"ephemeral_pub_key,omitempty"`
+	// sealed_key is the nonce followed by the sealed content key.
+	SealedKey []byte `protobuf:"bytes,4,opt,name=sealed_key,json=sealedKey,proto3" json:"sealed_key,omitempty"`
+}
+
+func (x *KeyEnvelope) Reset() {
+	*x = KeyEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_blog_blog_post_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KeyEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyEnvelope) ProtoMessage() {}
+
+// Deprecated: Use KeyEnvelope.ProtoReflect.Descriptor instead.
+func (*KeyEnvelope) Descriptor() ([]byte, []int) {
+	return file_blog_blog_post_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *KeyEnvelope) GetReader() string {
+	if x != nil {
+		return x.Reader
+	}
+	return ""
+}
+
+func (x *KeyEnvelope) GetReaderPubKey() []byte {
+	if x != nil {
+		return x.ReaderPubKey
+	}
+	return nil
+}
+
+func (x *KeyEnvelope) GetEphemeralPubKey() []byte {
+	if x != nil {
+		return x.EphemeralPubKey
+	}
+	return nil
+}
+
+func (x *KeyEnvelope) GetSealedKey() []byte {
+	if x != nil {
+		return x.SealedKey
+	}
+	return nil
+}
+
 type Post struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -918,12 +2527,37 @@ type Post struct {
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	LastUpdatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_updated_at,json=lastUpdatedAt,proto3" json:"last_updated_at,omitempty"`
 	Editors       []string               `protobuf:"bytes,7,rep,name=editors,proto3" json:"editors,omitempty"`
+	// slug is the unique, human-readable identifier of the post in URLs.
+	Slug string `protobuf:"bytes,8,opt,name=slug,proto3" json:"slug,omitempty"`
+	// co_authors must approve changes to the title and body once
+	// approval_threshold is set. Editors cannot update the post directly then.
+	CoAuthors         []string `protobuf:"bytes,9,rep,name=co_authors,json=coAuthors,proto3" json:"co_authors,omitempty"`
+	ApprovalThreshold uint32   `protobuf:"varint,10,opt,name=approval_threshold,json=approvalThreshold,proto3" json:"approval_threshold,omitempty"`
+	// version starts at 1 and is incremented on every write of the post.
+	Version    uint64     `protobuf:"varint,11,opt,name=version,proto3" json:"version,omitempty"`
+	BodyFormat BodyFormat `protobuf:"varint,12,opt,name=body_format,json=bodyFormat,proto3,enum=blog.blog.BodyFormat" json:"body_format,omitempty"`
+	// key_envelopes hold the content key of encrypted posts for each address
+	// allowed to read them.
+	KeyEnvelopes []*KeyEnvelope `protobuf:"bytes,13,rep,name=key_envelopes,json=keyEnvelopes,proto3" json:"key_envelopes,omitempty"`
+	// price is what MsgPurchaseAccess pays the creator for a key envelope of
+	// an encrypted post. Posts without a price are not for sale.
+	Price []*v1beta1.Coin `protobuf:"bytes,14,rep,name=price,proto3" json:"price,omitempty"`
+	// summary is a public teaser of the post. Unlike the body, it stays
+	// readable and searchable when the body is encrypted.
+	Summary string `protobuf:"bytes,15,opt,name=summary,proto3" json:"summary,omitempty"`
+	// tags are lower-case topics of the post, used to list and subscribe to
+	// the posts of a topic.
+	Tags []string `protobuf:"bytes,16,rep,name=tags,proto3" json:"tags,omitempty"`
+	// body_uri points to the ciphertext of an encrypted body stored off chain,
+	// e.g. on IPFS. The body is then empty; a body set on chain takes
+	// precedence.
+	BodyUri string `protobuf:"bytes,17,opt,name=body_uri,json=bodyUri,proto3" json:"body_uri,omitempty"`
 }
 
 func (x *Post) Reset() {
 	*x = Post{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_blog_blog_post_proto_msgTypes[0]
+		mi := &file_blog_blog_post_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -937,7 +2571,7 @@ func (*Post) ProtoMessage() {}
 
 // Deprecated: Use Post.ProtoReflect.Descriptor instead.
 func (*Post) Descriptor() ([]byte, []int) {
-	return file_blog_blog_post_proto_rawDescGZIP(), []int{0}
+	return file_blog_blog_post_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *Post) GetTitle() string {
@@ -989,6 +2623,76 @@ func (x *Post) GetEditors() []string {
 	return nil
 }
 
+func (x *Post) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *Post) GetCoAuthors() []string {
+	if x != nil {
+		return x.CoAuthors
+	}
+	return nil
+}
+
+func (x *Post) GetApprovalThreshold() uint32 {
+	if x != nil {
+		return x.ApprovalThreshold
+	}
+	return 0
+}
+
+func (x *Post) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Post) GetBodyFormat() BodyFormat {
+	if x != nil {
+		return x.BodyFormat
+	}
+	return BodyFormat_BODY_FORMAT_PLAIN
+}
+
+func (x *Post) GetKeyEnvelopes() []*KeyEnvelope {
+	if x != nil {
+		return x.KeyEnvelopes
+	}
+	return nil
+}
+
+func (x *Post) GetPrice() []*v1beta1.Coin {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *Post) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+func (x *Post) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Post) GetBodyUri() string {
+	if x != nil {
+		return x.BodyUri
+	}
+	return ""
+}
+
 var File_blog_blog_post_proto protoreflect.FileDescriptor
 
 var file_blog_blog_post_proto_rawDesc = []byte{
@@ -998,32 +2702,77 @@ var file_blog_blog_post_proto_rawDesc = []byte{
 	0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f,
 	0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f,
-	0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x87, 0x02, 0x0a, 0x04,
-	0x50, 0x6f, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f,
-	0x64, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x18,
-	0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x43, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf,
-	0x1f, 0x01, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x4c, 0x0a,
-	0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0d, 0x6c, 0x61,
-	0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65,
-	0x64, 0x69, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x65, 0x64,
-	0x69, 0x74, 0x6f, 0x72, 0x73, 0x42, 0x73, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x6c, 0x6f,
-	0x67, 0x2e, 0x62, 0x6c, 0x6f, 0x67, 0x42, 0x09, 0x50, 0x6f, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x50, 0x01, 0x5a, 0x12, 0x62, 0x6c, 0x6f, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x6c,
-	0x6f, 0x67, 0x2f, 0x62, 0x6c, 0x6f, 0x67, 0xa2, 0x02, 0x03, 0x42, 0x42, 0x58, 0xaa, 0x02, 0x09,
-	0x42, 0x6c, 0x6f, 0x67, 0x2e, 0x42, 0x6c, 0x6f, 0x67, 0xca, 0x02, 0x09, 0x42, 0x6c, 0x6f, 0x67,
-	0x5c, 0x42, 0x6c, 0x6f, 0x67, 0xe2, 0x02, 0x15, 0x42, 0x6c, 0x6f, 0x67, 0x5c, 0x42, 0x6c, 0x6f,
-	0x67, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0a,
-	0x42, 0x6c, 0x6f, 0x67, 0x3a, 0x3a, 0x42, 0x6c, 0x6f, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x96, 0x01, 0x0a, 0x0b,
+	0x4b, 0x65, 0x79, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x0e, 0x72, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x70, 0x75,
+	0x62, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x72, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x2a, 0x0a, 0x11, 0x65, 0x70, 0x68,
+	0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x65, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c, 0x50,
+	0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x65, 0x61, 0x6c, 0x65,
+	0x64, 0x4b, 0x65, 0x79, 0x22, 0xaa, 0x05, 0x0a, 0x04, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f,
+	0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x43, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x4c, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f,
+	0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x73, 0x18,
+	0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6c,
+	0x75, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73,
+	0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x73, 0x12, 0x2d, 0x0a, 0x12, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x5f, 0x74, 0x68,
+	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x61,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x0b, 0x62, 0x6f,
+	0x64, 0x79, 0x5f, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x15, 0x2e, 0x62, 0x6c, 0x6f, 0x67, 0x2e, 0x62, 0x6c, 0x6f, 0x67, 0x2e, 0x42, 0x6f, 0x64, 0x79,
+	0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x0a, 0x62, 0x6f, 0x64, 0x79, 0x46, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x12, 0x41, 0x0a, 0x0d, 0x6b, 0x65, 0x79, 0x5f, 0x65, 0x6e, 0x76, 0x65, 0x6c, 0x6f,
+	0x70, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x6c, 0x6f, 0x67,
+	0x2e, 0x62, 0x6c, 0x6f, 0x67, 0x2e, 0x4b, 0x65, 0x79, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70,
+	0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c, 0x6b, 0x65, 0x79, 0x45, 0x6e, 0x76, 0x65,
+	0x6c, 0x6f, 0x70, 0x65, 0x73, 0x12, 0x61, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x0e,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42,
+	0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
+	0x73, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x79, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x6f, 0x64, 0x79, 0x5f, 0x75,
+	0x72, 0x69, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x6f, 0x64, 0x79, 0x55, 0x72,
+	0x69, 0x2a, 0x78, 0x0a, 0x0a, 0x42, 0x6f, 0x64, 0x79, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12,
+	0x15, 0x0a, 0x11, 0x42, 0x4f, 0x44, 0x59, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x50,
+	0x4c, 0x41, 0x49, 0x4e, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x42, 0x4f, 0x44, 0x59, 0x5f, 0x46,
+	0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x4d, 0x41, 0x52, 0x4b, 0x44, 0x4f, 0x57, 0x4e, 0x10, 0x01,
+	0x12, 0x1e, 0x0a, 0x1a, 0x42, 0x4f, 0x44, 0x59, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f,
+	0x48, 0x54, 0x4d, 0x4c, 0x5f, 0x53, 0x41, 0x4e, 0x49, 0x54, 0x49, 0x5a, 0x45, 0x44, 0x10, 0x02,
+	0x12, 0x19, 0x0a, 0x15, 0x42, 0x4f, 0x44, 0x59, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f,
+	0x45, 0x4e, 0x43, 0x52, 0x59, 0x50, 0x54, 0x45, 0x44, 0x10, 0x03, 0x42, 0x73, 0x0a, 0x0d, 0x63,
+	0x6f, 0x6d, 0x2e, 0x62, 0x6c, 0x6f, 0x67, 0x2e, 0x62, 0x6c, 0x6f, 0x67, 0x42, 0x09, 0x50, 0x6f,
+	0x73, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x12, 0x62, 0x6c, 0x6f, 0x67, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x62, 0x6c, 0x6f, 0x67, 0x2f, 0x62, 0x6c, 0x6f, 0x67, 0xa2, 0x02, 0x03,
+	0x42, 0x42, 0x58, 0xaa, 0x02, 0x09, 0x42, 0x6c, 0x6f, 0x67, 0x2e, 0x42, 0x6c, 0x6f, 0x67, 0xca,
+	0x02, 0x09, 0x42, 0x6c, 0x6f, 0x67, 0x5c, 0x42, 0x6c, 0x6f, 0x67, 0xe2, 0x02, 0x15, 0x42, 0x6c,
+	0x6f, 0x67, 0x5c, 0x42, 0x6c, 0x6f, 0x67, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0xea, 0x02, 0x0a, 0x42, 0x6c, 0x6f, 0x67, 0x3a, 0x3a, 0x42, 0x6c, 0x6f, 0x67,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1038,19 +2787,26 @@ func file_blog_blog_post_proto_rawDescGZIP() []byte {
 	return file_blog_blog_post_proto_rawDescData
 }
 
-var file_blog_blog_post_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_blog_blog_post_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_blog_blog_post_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_blog_blog_post_proto_goTypes = []interface{}{
-	(*Post)(nil),                  // 0: blog.blog.Post
-	(*timestamppb.Timestamp)(nil), // 1: google.protobuf.Timestamp
+	(BodyFormat)(0),               // 0: blog.blog.BodyFormat
+	(*KeyEnvelope)(nil),           // 1: blog.blog.KeyEnvelope
+	(*Post)(nil),                  // 2: blog.blog.Post
+	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
+	(*v1beta1.Coin)(nil),          // 4: cosmos.base.v1beta1.Coin
 }
 var file_blog_blog_post_proto_depIdxs = []int32{
-	1, // 0: blog.blog.Post.created_at:type_name -> google.protobuf.Timestamp
-	1, // 1: blog.blog.Post.last_updated_at:type_name -> google.protobuf.Timestamp
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	3, // 0: blog.blog.Post.created_at:type_name -> google.protobuf.Timestamp
+	3, // 1: blog.blog.Post.last_updated_at:type_name -> google.protobuf.Timestamp
+	0, // 2: blog.blog.Post.body_format:type_name -> blog.blog.BodyFormat
+	1, // 3: blog.blog.Post.key_envelopes:type_name -> blog.blog.KeyEnvelope
+	4, // 4: blog.blog.Post.price:type_name -> cosmos.base.v1beta1.Coin
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_blog_blog_post_proto_init() }
@@ -1060,6 +2816,18 @@ func file_blog_blog_post_proto_init() {
 	}
 	if !protoimpl.UnsafeEnabled {
 		file_blog_blog_post_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeyEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_blog_blog_post_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Post); i {
 			case 0:
 				return &v.state
@@ -1077,13 +2845,14 @@ func file_blog_blog_post_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_blog_blog_post_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   1,
+			NumEnums:      1,
+			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_blog_blog_post_proto_goTypes,
 		DependencyIndexes: file_blog_blog_post_proto_depIdxs,
+		EnumInfos:         file_blog_blog_post_proto_enumTypes,
 		MessageInfos:      file_blog_blog_post_proto_msgTypes,
 	}.Build()
 	File_blog_blog_post_proto = out.File
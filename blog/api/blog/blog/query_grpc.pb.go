@@ -19,9 +19,27 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Query_Params_FullMethodName   = "/blog.blog.Query/Params"
-	Query_ShowPost_FullMethodName = "/blog.blog.Query/ShowPost"
-	Query_ListPost_FullMethodName = "/blog.blog.Query/ListPost"
+	Query_Params_FullMethodName              = "/blog.blog.Query/Params"
+	Query_ShowPost_FullMethodName            = "/blog.blog.Query/ShowPost"
+	Query_ListPost_FullMethodName            = "/blog.blog.Query/ListPost"
+	Query_SearchPosts_FullMethodName         = "/blog.blog.Query/SearchPosts"
+	Query_ShowPostBySlug_FullMethodName      = "/blog.blog.Query/ShowPostBySlug"
+	Query_Profile_FullMethodName             = "/blog.blog.Query/Profile"
+	Query_ProfileByName_FullMethodName       = "/blog.blog.Query/ProfileByName"
+	Query_BlogStats_FullMethodName           = "/blog.blog.Query/BlogStats"
+	Query_CreatorStats_FullMethodName        = "/blog.blog.Query/CreatorStats"
+	Query_ShowSeries_FullMethodName          = "/blog.blog.Query/ShowSeries"
+	Query_PostChangeProposals_FullMethodName = "/blog.blog.Query/PostChangeProposals"
+	Query_DeletedPosts_FullMethodName        = "/blog.blog.Query/DeletedPosts"
+	Query_Followers_FullMethodName           = "/blog.blog.Query/Followers"
+	Query_Following_FullMethodName           = "/blog.blog.Query/Following"
+	Query_Feed_FullMethodName                = "/blog.blog.Query/Feed"
+	Query_RenderPost_FullMethodName          = "/blog.blog.Query/RenderPost"
+	Query_PostAtHeight_FullMethodName        = "/blog.blog.Query/PostAtHeight"
+	Query_PostTimeline_FullMethodName        = "/blog.blog.Query/PostTimeline"
+	Query_HasAccess_FullMethodName           = "/blog.blog.Query/HasAccess"
+	Query_PurchasedPosts_FullMethodName      = "/blog.blog.Query/PurchasedPosts"
+	Query_PostPurchasers_FullMethodName      = "/blog.blog.Query/PostPurchasers"
 )
 
 // QueryClient is the client API for Query service.
@@ -36,6 +54,52 @@ type QueryClient interface {
 	ShowPost(ctx context.Context, in *QueryShowPostRequest, opts ...grpc.CallOption) (*QueryShowPostResponse, error)
 	// Queries a list of ListPost items.
 	ListPost(ctx context.Context, in *QueryListPostRequest, opts ...grpc.CallOption) (*QueryListPostResponse, error)
+	// SearchPosts returns the posts whose title or body contain every term of
+	// the query. A term ending in '*' matches any word starting with it.
+	SearchPosts(ctx context.Context, in *QuerySearchPostsRequest, opts ...grpc.CallOption) (*QuerySearchPostsResponse, error)
+	// ShowPostBySlug returns the post a current or previous slug points to.
+	ShowPostBySlug(ctx context.Context, in *QueryShowPostBySlugRequest, opts ...grpc.CallOption) (*QueryShowPostBySlugResponse, error)
+	// Profile returns the profile of an address.
+	Profile(ctx context.Context, in *QueryProfileRequest, opts ...grpc.CallOption) (*QueryProfileResponse, error)
+	// ProfileByName returns the profile using a display name.
+	ProfileByName(ctx context.Context, in *QueryProfileByNameRequest, opts ...grpc.CallOption) (*QueryProfileByNameResponse, error)
+	// BlogStats returns chain-wide post counters.
+	BlogStats(ctx context.Context, in *QueryBlogStatsRequest, opts ...grpc.CallOption) (*QueryBlogStatsResponse, error)
+	// CreatorStats returns the post counters of a single creator.
+	CreatorStats(ctx context.Context, in *QueryCreatorStatsRequest, opts ...grpc.CallOption) (*QueryCreatorStatsResponse, error)
+	// ShowSeries returns a series.
+	ShowSeries(ctx context.Context, in *QueryShowSeriesRequest, opts ...grpc.CallOption) (*QueryShowSeriesResponse, error)
+	// PostChangeProposals returns the pending change proposals of a post.
+	PostChangeProposals(ctx context.Context, in *QueryPostChangeProposalsRequest, opts ...grpc.CallOption) (*QueryPostChangeProposalsResponse, error)
+	// DeletedPosts returns the posts of a creator that are in the trash.
+	DeletedPosts(ctx context.Context, in *QueryDeletedPostsRequest, opts ...grpc.CallOption) (*QueryDeletedPostsResponse, error)
+	// Followers returns the accounts following an address.
+	Followers(ctx context.Context, in *QueryFollowersRequest, opts ...grpc.CallOption) (*QueryFollowersResponse, error)
+	// Following returns the accounts an address follows.
+	Following(ctx context.Context, in *QueryFollowingRequest, opts ...grpc.CallOption) (*QueryFollowingResponse, error)
+	// Feed returns the posts of the accounts an address follows, newest first.
+	Feed(ctx context.Context, in *QueryFeedRequest, opts ...grpc.CallOption) (*QueryFeedResponse, error)
+	// RenderPost returns the body of a post rendered as sanitized HTML.
+	RenderPost(ctx context.Context, in *QueryRenderPostRequest, opts ...grpc.CallOption) (*QueryRenderPostResponse, error)
+	// PostAtHeight returns a post as it was at the end of a block. The query
+	// must be sent with the x-cosmos-block-height header set to the height, so
+	// that it reads the store versioned at that height; the REST route and the
+	// blogd blog post-at-height command set it. Heights pruned by the node
+	// cannot be queried.
+	PostAtHeight(ctx context.Context, in *QueryPostAtHeightRequest, opts ...grpc.CallOption) (*QueryPostAtHeightResponse, error)
+	// PostTimeline lists the changes of a post in the order they were made,
+	// including its deletion, restoration and purge. Posts that never existed
+	// are not found.
+	PostTimeline(ctx context.Context, in *QueryPostTimelineRequest, opts ...grpc.CallOption) (*QueryPostTimelineResponse, error)
+	// HasAccess reports whether an address may read an encrypted post, either
+	// as a holder of a key envelope or as a purchaser.
+	HasAccess(ctx context.Context, in *QueryHasAccessRequest, opts ...grpc.CallOption) (*QueryHasAccessResponse, error)
+	// PurchasedPosts returns the entitlements an address purchased.
+	PurchasedPosts(ctx context.Context, in *QueryPurchasedPostsRequest, opts ...grpc.CallOption) (*QueryPurchasedPostsResponse, error)
+	// PostPurchasers returns the addresses that purchased access to a post, so
+	// that the creator can grant them key envelopes. Purchases still in escrow
+	// are those waiting for an envelope.
+	PostPurchasers(ctx context.Context, in *QueryPostPurchasersRequest, opts ...grpc.CallOption) (*QueryPostPurchasersResponse, error)
 }
 
 type queryClient struct {
@@ -76,6 +140,186 @@ func (c *queryClient) ListPost(ctx context.Context, in *QueryListPostRequest, op
 	return out, nil
 }
 
+func (c *queryClient) SearchPosts(ctx context.Context, in *QuerySearchPostsRequest, opts ...grpc.CallOption) (*QuerySearchPostsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuerySearchPostsResponse)
+	err := c.cc.Invoke(ctx, Query_SearchPosts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ShowPostBySlug(ctx context.Context, in *QueryShowPostBySlugRequest, opts ...grpc.CallOption) (*QueryShowPostBySlugResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryShowPostBySlugResponse)
+	err := c.cc.Invoke(ctx, Query_ShowPostBySlug_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Profile(ctx context.Context, in *QueryProfileRequest, opts ...grpc.CallOption) (*QueryProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryProfileResponse)
+	err := c.cc.Invoke(ctx, Query_Profile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProfileByName(ctx context.Context, in *QueryProfileByNameRequest, opts ...grpc.CallOption) (*QueryProfileByNameResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryProfileByNameResponse)
+	err := c.cc.Invoke(ctx, Query_ProfileByName_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BlogStats(ctx context.Context, in *QueryBlogStatsRequest, opts ...grpc.CallOption) (*QueryBlogStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryBlogStatsResponse)
+	err := c.cc.Invoke(ctx, Query_BlogStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CreatorStats(ctx context.Context, in *QueryCreatorStatsRequest, opts ...grpc.CallOption) (*QueryCreatorStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryCreatorStatsResponse)
+	err := c.cc.Invoke(ctx, Query_CreatorStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ShowSeries(ctx context.Context, in *QueryShowSeriesRequest, opts ...grpc.CallOption) (*QueryShowSeriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryShowSeriesResponse)
+	err := c.cc.Invoke(ctx, Query_ShowSeries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PostChangeProposals(ctx context.Context, in *QueryPostChangeProposalsRequest, opts ...grpc.CallOption) (*QueryPostChangeProposalsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryPostChangeProposalsResponse)
+	err := c.cc.Invoke(ctx, Query_PostChangeProposals_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DeletedPosts(ctx context.Context, in *QueryDeletedPostsRequest, opts ...grpc.CallOption) (*QueryDeletedPostsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryDeletedPostsResponse)
+	err := c.cc.Invoke(ctx, Query_DeletedPosts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Followers(ctx context.Context, in *QueryFollowersRequest, opts ...grpc.CallOption) (*QueryFollowersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryFollowersResponse)
+	err := c.cc.Invoke(ctx, Query_Followers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Following(ctx context.Context, in *QueryFollowingRequest, opts ...grpc.CallOption) (*QueryFollowingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryFollowingResponse)
+	err := c.cc.Invoke(ctx, Query_Following_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Feed(ctx context.Context, in *QueryFeedRequest, opts ...grpc.CallOption) (*QueryFeedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryFeedResponse)
+	err := c.cc.Invoke(ctx, Query_Feed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RenderPost(ctx context.Context, in *QueryRenderPostRequest, opts ...grpc.CallOption) (*QueryRenderPostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryRenderPostResponse)
+	err := c.cc.Invoke(ctx, Query_RenderPost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PostAtHeight(ctx context.Context, in *QueryPostAtHeightRequest, opts ...grpc.CallOption) (*QueryPostAtHeightResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryPostAtHeightResponse)
+	err := c.cc.Invoke(ctx, Query_PostAtHeight_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PostTimeline(ctx context.Context, in *QueryPostTimelineRequest, opts ...grpc.CallOption) (*QueryPostTimelineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryPostTimelineResponse)
+	err := c.cc.Invoke(ctx, Query_PostTimeline_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) HasAccess(ctx context.Context, in *QueryHasAccessRequest, opts ...grpc.CallOption) (*QueryHasAccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryHasAccessResponse)
+	err := c.cc.Invoke(ctx, Query_HasAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PurchasedPosts(ctx context.Context, in *QueryPurchasedPostsRequest, opts ...grpc.CallOption) (*QueryPurchasedPostsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryPurchasedPostsResponse)
+	err := c.cc.Invoke(ctx, Query_PurchasedPosts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PostPurchasers(ctx context.Context, in *QueryPostPurchasersRequest, opts ...grpc.CallOption) (*QueryPostPurchasersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryPostPurchasersResponse)
+	err := c.cc.Invoke(ctx, Query_PostPurchasers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -88,6 +332,52 @@ type QueryServer interface {
 	ShowPost(context.Context, *QueryShowPostRequest) (*QueryShowPostResponse, error)
 	// Queries a list of ListPost items.
 	ListPost(context.Context, *QueryListPostRequest) (*QueryListPostResponse, error)
+	// SearchPosts returns the posts whose title or body contain every term of
+	// the query. A term ending in '*' matches any word starting with it.
+	SearchPosts(context.Context, *QuerySearchPostsRequest) (*QuerySearchPostsResponse, error)
+	// ShowPostBySlug returns the post a current or previous slug points to.
+	ShowPostBySlug(context.Context, *QueryShowPostBySlugRequest) (*QueryShowPostBySlugResponse, error)
+	// Profile returns the profile of an address.
+	Profile(context.Context, *QueryProfileRequest) (*QueryProfileResponse, error)
+	// ProfileByName returns the profile using a display name.
+	ProfileByName(context.Context, *QueryProfileByNameRequest) (*QueryProfileByNameResponse, error)
+	// BlogStats returns chain-wide post counters.
+	BlogStats(context.Context, *QueryBlogStatsRequest) (*QueryBlogStatsResponse, error)
+	// CreatorStats returns the post counters of a single creator.
+	CreatorStats(context.Context, *QueryCreatorStatsRequest) (*QueryCreatorStatsResponse, error)
+	// ShowSeries returns a series.
+	ShowSeries(context.Context, *QueryShowSeriesRequest) (*QueryShowSeriesResponse, error)
+	// PostChangeProposals returns the pending change proposals of a post.
+	PostChangeProposals(context.Context, *QueryPostChangeProposalsRequest) (*QueryPostChangeProposalsResponse, error)
+	// DeletedPosts returns the posts of a creator that are in the trash.
+	DeletedPosts(context.Context, *QueryDeletedPostsRequest) (*QueryDeletedPostsResponse, error)
+	// Followers returns the accounts following an address.
+	Followers(context.Context, *QueryFollowersRequest) (*QueryFollowersResponse, error)
+	// Following returns the accounts an address follows.
+	Following(context.Context, *QueryFollowingRequest) (*QueryFollowingResponse, error)
+	// Feed returns the posts of the accounts an address follows, newest first.
+	Feed(context.Context, *QueryFeedRequest) (*QueryFeedResponse, error)
+	// RenderPost returns the body of a post rendered as sanitized HTML.
+	RenderPost(context.Context, *QueryRenderPostRequest) (*QueryRenderPostResponse, error)
+	// PostAtHeight returns a post as it was at the end of a block. The query
+	// must be sent with the x-cosmos-block-height header set to the height, so
+	// that it reads the store versioned at that height; the REST route and the
+	// blogd blog post-at-height command set it. Heights pruned by the node
+	// cannot be queried.
+	PostAtHeight(context.Context, *QueryPostAtHeightRequest) (*QueryPostAtHeightResponse, error)
+	// PostTimeline lists the changes of a post in the order they were made,
+	// including its deletion, restoration and purge. Posts that never existed
+	// are not found.
+	PostTimeline(context.Context, *QueryPostTimelineRequest) (*QueryPostTimelineResponse, error)
+	// HasAccess reports whether an address may read an encrypted post, either
+	// as a holder of a key envelope or as a purchaser.
+	HasAccess(context.Context, *QueryHasAccessRequest) (*QueryHasAccessResponse, error)
+	// PurchasedPosts returns the entitlements an address purchased.
+	PurchasedPosts(context.Context, *QueryPurchasedPostsRequest) (*QueryPurchasedPostsResponse, error)
+	// PostPurchasers returns the addresses that purchased access to a post, so
+	// that the creator can grant them key envelopes. Purchases still in escrow
+	// are those waiting for an envelope.
+	PostPurchasers(context.Context, *QueryPostPurchasersRequest) (*QueryPostPurchasersResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -107,6 +397,60 @@ func (UnimplementedQueryServer) ShowPost(context.Context, *QueryShowPostRequest)
 func (UnimplementedQueryServer) ListPost(context.Context, *QueryListPostRequest) (*QueryListPostResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListPost not implemented")
 }
+func (UnimplementedQueryServer) SearchPosts(context.Context, *QuerySearchPostsRequest) (*QuerySearchPostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchPosts not implemented")
+}
+func (UnimplementedQueryServer) ShowPostBySlug(context.Context, *QueryShowPostBySlugRequest) (*QueryShowPostBySlugResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShowPostBySlug not implemented")
+}
+func (UnimplementedQueryServer) Profile(context.Context, *QueryProfileRequest) (*QueryProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Profile not implemented")
+}
+func (UnimplementedQueryServer) ProfileByName(context.Context, *QueryProfileByNameRequest) (*QueryProfileByNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProfileByName not implemented")
+}
+func (UnimplementedQueryServer) BlogStats(context.Context, *QueryBlogStatsRequest) (*QueryBlogStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlogStats not implemented")
+}
+func (UnimplementedQueryServer) CreatorStats(context.Context, *QueryCreatorStatsRequest) (*QueryCreatorStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatorStats not implemented")
+}
+func (UnimplementedQueryServer) ShowSeries(context.Context, *QueryShowSeriesRequest) (*QueryShowSeriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShowSeries not implemented")
+}
+func (UnimplementedQueryServer) PostChangeProposals(context.Context, *QueryPostChangeProposalsRequest) (*QueryPostChangeProposalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostChangeProposals not implemented")
+}
+func (UnimplementedQueryServer) DeletedPosts(context.Context, *QueryDeletedPostsRequest) (*QueryDeletedPostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletedPosts not implemented")
+}
+func (UnimplementedQueryServer) Followers(context.Context, *QueryFollowersRequest) (*QueryFollowersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Followers not implemented")
+}
+func (UnimplementedQueryServer) Following(context.Context, *QueryFollowingRequest) (*QueryFollowingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Following not implemented")
+}
+func (UnimplementedQueryServer) Feed(context.Context, *QueryFeedRequest) (*QueryFeedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Feed not implemented")
+}
+func (UnimplementedQueryServer) RenderPost(context.Context, *QueryRenderPostRequest) (*QueryRenderPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenderPost not implemented")
+}
+func (UnimplementedQueryServer) PostAtHeight(context.Context, *QueryPostAtHeightRequest) (*QueryPostAtHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostAtHeight not implemented")
+}
+func (UnimplementedQueryServer) PostTimeline(context.Context, *QueryPostTimelineRequest) (*QueryPostTimelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostTimeline not implemented")
+}
+func (UnimplementedQueryServer) HasAccess(context.Context, *QueryHasAccessRequest) (*QueryHasAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HasAccess not implemented")
+}
+func (UnimplementedQueryServer) PurchasedPosts(context.Context, *QueryPurchasedPostsRequest) (*QueryPurchasedPostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurchasedPosts not implemented")
+}
+func (UnimplementedQueryServer) PostPurchasers(context.Context, *QueryPostPurchasersRequest) (*QueryPostPurchasersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostPurchasers not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -182,6 +526,330 @@ func _Query_ListPost_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SearchPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySearchPostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SearchPosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SearchPosts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SearchPosts(ctx, req.(*QuerySearchPostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ShowPostBySlug_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryShowPostBySlugRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ShowPostBySlug(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ShowPostBySlug_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ShowPostBySlug(ctx, req.(*QueryShowPostBySlugRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Profile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Profile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Profile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Profile(ctx, req.(*QueryProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProfileByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProfileByNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProfileByName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ProfileByName_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProfileByName(ctx, req.(*QueryProfileByNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BlogStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlogStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlogStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_BlogStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlogStats(ctx, req.(*QueryBlogStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CreatorStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCreatorStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CreatorStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_CreatorStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CreatorStats(ctx, req.(*QueryCreatorStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ShowSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryShowSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ShowSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ShowSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ShowSeries(ctx, req.(*QueryShowSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PostChangeProposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPostChangeProposalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PostChangeProposals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PostChangeProposals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PostChangeProposals(ctx, req.(*QueryPostChangeProposalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DeletedPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDeletedPostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DeletedPosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DeletedPosts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DeletedPosts(ctx, req.(*QueryDeletedPostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Followers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFollowersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Followers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Followers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Followers(ctx, req.(*QueryFollowersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Following_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFollowingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Following(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Following_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Following(ctx, req.(*QueryFollowingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Feed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Feed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Feed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Feed(ctx, req.(*QueryFeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RenderPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRenderPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RenderPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RenderPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RenderPost(ctx, req.(*QueryRenderPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PostAtHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPostAtHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PostAtHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PostAtHeight_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PostAtHeight(ctx, req.(*QueryPostAtHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PostTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPostTimelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PostTimeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PostTimeline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PostTimeline(ctx, req.(*QueryPostTimelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HasAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHasAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HasAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_HasAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HasAccess(ctx, req.(*QueryHasAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PurchasedPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPurchasedPostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PurchasedPosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PurchasedPosts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PurchasedPosts(ctx, req.(*QueryPurchasedPostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PostPurchasers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPostPurchasersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PostPurchasers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PostPurchasers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PostPurchasers(ctx, req.(*QueryPostPurchasersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -201,6 +869,78 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListPost",
 			Handler:    _Query_ListPost_Handler,
 		},
+		{
+			MethodName: "SearchPosts",
+			Handler:    _Query_SearchPosts_Handler,
+		},
+		{
+			MethodName: "ShowPostBySlug",
+			Handler:    _Query_ShowPostBySlug_Handler,
+		},
+		{
+			MethodName: "Profile",
+			Handler:    _Query_Profile_Handler,
+		},
+		{
+			MethodName: "ProfileByName",
+			Handler:    _Query_ProfileByName_Handler,
+		},
+		{
+			MethodName: "BlogStats",
+			Handler:    _Query_BlogStats_Handler,
+		},
+		{
+			MethodName: "CreatorStats",
+			Handler:    _Query_CreatorStats_Handler,
+		},
+		{
+			MethodName: "ShowSeries",
+			Handler:    _Query_ShowSeries_Handler,
+		},
+		{
+			MethodName: "PostChangeProposals",
+			Handler:    _Query_PostChangeProposals_Handler,
+		},
+		{
+			MethodName: "DeletedPosts",
+			Handler:    _Query_DeletedPosts_Handler,
+		},
+		{
+			MethodName: "Followers",
+			Handler:    _Query_Followers_Handler,
+		},
+		{
+			MethodName: "Following",
+			Handler:    _Query_Following_Handler,
+		},
+		{
+			MethodName: "Feed",
+			Handler:    _Query_Feed_Handler,
+		},
+		{
+			MethodName: "RenderPost",
+			Handler:    _Query_RenderPost_Handler,
+		},
+		{
+			MethodName: "PostAtHeight",
+			Handler:    _Query_PostAtHeight_Handler,
+		},
+		{
+			MethodName: "PostTimeline",
+			Handler:    _Query_PostTimeline_Handler,
+		},
+		{
+			MethodName: "HasAccess",
+			Handler:    _Query_HasAccess_Handler,
+		},
+		{
+			MethodName: "PurchasedPosts",
+			Handler:    _Query_PurchasedPosts_Handler,
+		},
+		{
+			MethodName: "PostPurchasers",
+			Handler:    _Query_PostPurchasers_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "blog/blog/query.proto",
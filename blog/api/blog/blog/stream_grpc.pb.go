@@ -0,0 +1,138 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: blog/blog/stream.proto
+
+package blog
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Stream_SubscribePostChanges_FullMethodName = "/blog.blog.Stream/SubscribePostChanges"
+)
+
+// StreamClient is the client API for Stream service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Stream streams the writes to the blog store as they are committed. It is
+// served by the gRPC server of the node itself, not through ABCI queries.
+type StreamClient interface {
+	// SubscribePostChanges sends the blog store changes of every committed block
+	// at or after from_height that changed the blog store, then keeps sending
+	// the changes of new blocks. Clients resume after a disconnection by
+	// subscribing from the height following the last block they received.
+	SubscribePostChanges(ctx context.Context, in *SubscribePostChangesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SubscribePostChangesResponse], error)
+}
+
+type streamClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewStreamClient(cc grpc.ClientConnInterface) StreamClient {
+	return &streamClient{cc}
+}
+
+func (c *streamClient) SubscribePostChanges(ctx context.Context, in *SubscribePostChangesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SubscribePostChangesResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Stream_ServiceDesc.Streams[0], Stream_SubscribePostChanges_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SubscribePostChangesRequest, SubscribePostChangesResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Stream_SubscribePostChangesClient = grpc.ServerStreamingClient[SubscribePostChangesResponse]
+
+// StreamServer is the server API for Stream service.
+// All implementations must embed UnimplementedStreamServer
+// for forward compatibility.
+//
+// Stream streams the writes to the blog store as they are committed. It is
+// served by the gRPC server of the node itself, not through ABCI queries.
+type StreamServer interface {
+	// SubscribePostChanges sends the blog store changes of every committed block
+	// at or after from_height that changed the blog store, then keeps sending
+	// the changes of new blocks. Clients resume after a disconnection by
+	// subscribing from the height following the last block they received.
+	SubscribePostChanges(*SubscribePostChangesRequest, grpc.ServerStreamingServer[SubscribePostChangesResponse]) error
+	mustEmbedUnimplementedStreamServer()
+}
+
+// UnimplementedStreamServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedStreamServer struct{}
+
+func (UnimplementedStreamServer) SubscribePostChanges(*SubscribePostChangesRequest, grpc.ServerStreamingServer[SubscribePostChangesResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribePostChanges not implemented")
+}
+func (UnimplementedStreamServer) mustEmbedUnimplementedStreamServer() {}
+func (UnimplementedStreamServer) testEmbeddedByValue()                {}
+
+// UnsafeStreamServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to StreamServer will
+// result in compilation errors.
+type UnsafeStreamServer interface {
+	mustEmbedUnimplementedStreamServer()
+}
+
+func RegisterStreamServer(s grpc.ServiceRegistrar, srv StreamServer) {
+	// If the following call pancis, it indicates UnimplementedStreamServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Stream_ServiceDesc, srv)
+}
+
+func _Stream_SubscribePostChanges_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribePostChangesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StreamServer).SubscribePostChanges(m, &grpc.GenericServerStream[SubscribePostChangesRequest, SubscribePostChangesResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Stream_SubscribePostChangesServer = grpc.ServerStreamingServer[SubscribePostChangesResponse]
+
+// Stream_ServiceDesc is the grpc.ServiceDesc for Stream service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Stream_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "blog.blog.Stream",
+	HandlerType: (*StreamServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribePostChanges",
+			Handler:       _Stream_SubscribePostChanges_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "blog/blog/stream.proto",
+}
@@ -19,12 +19,31 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Msg_UpdateParams_FullMethodName = "/blog.blog.Msg/UpdateParams"
-	Msg_CreatePost_FullMethodName   = "/blog.blog.Msg/CreatePost"
-	Msg_UpdatePost_FullMethodName   = "/blog.blog.Msg/UpdatePost"
-	Msg_DeletePost_FullMethodName   = "/blog.blog.Msg/DeletePost"
-	Msg_AddEditor_FullMethodName    = "/blog.blog.Msg/AddEditor"
-	Msg_DeleteEditor_FullMethodName = "/blog.blog.Msg/DeleteEditor"
+	Msg_UpdateParams_FullMethodName      = "/blog.blog.Msg/UpdateParams"
+	Msg_CreatePost_FullMethodName        = "/blog.blog.Msg/CreatePost"
+	Msg_UpdatePost_FullMethodName        = "/blog.blog.Msg/UpdatePost"
+	Msg_DeletePost_FullMethodName        = "/blog.blog.Msg/DeletePost"
+	Msg_AddEditor_FullMethodName         = "/blog.blog.Msg/AddEditor"
+	Msg_DeleteEditor_FullMethodName      = "/blog.blog.Msg/DeleteEditor"
+	Msg_BatchPostOps_FullMethodName      = "/blog.blog.Msg/BatchPostOps"
+	Msg_SetProfile_FullMethodName        = "/blog.blog.Msg/SetProfile"
+	Msg_Follow_FullMethodName            = "/blog.blog.Msg/Follow"
+	Msg_Unfollow_FullMethodName          = "/blog.blog.Msg/Unfollow"
+	Msg_CreateSeries_FullMethodName      = "/blog.blog.Msg/CreateSeries"
+	Msg_AppendSeriesPost_FullMethodName  = "/blog.blog.Msg/AppendSeriesPost"
+	Msg_ReorderSeries_FullMethodName     = "/blog.blog.Msg/ReorderSeries"
+	Msg_RemoveSeriesPost_FullMethodName  = "/blog.blog.Msg/RemoveSeriesPost"
+	Msg_SetCoAuthors_FullMethodName      = "/blog.blog.Msg/SetCoAuthors"
+	Msg_ProposePostChange_FullMethodName = "/blog.blog.Msg/ProposePostChange"
+	Msg_ApprovePostChange_FullMethodName = "/blog.blog.Msg/ApprovePostChange"
+	Msg_PatchPost_FullMethodName         = "/blog.blog.Msg/PatchPost"
+	Msg_RestorePost_FullMethodName       = "/blog.blog.Msg/RestorePost"
+	Msg_CreatePrivatePost_FullMethodName = "/blog.blog.Msg/CreatePrivatePost"
+	Msg_GrantReadAccess_FullMethodName   = "/blog.blog.Msg/GrantReadAccess"
+	Msg_RevokeReadAccess_FullMethodName  = "/blog.blog.Msg/RevokeReadAccess"
+	Msg_SetPostPrice_FullMethodName      = "/blog.blog.Msg/SetPostPrice"
+	Msg_PurchaseAccess_FullMethodName    = "/blog.blog.Msg/PurchaseAccess"
+	Msg_SetPostTags_FullMethodName       = "/blog.blog.Msg/SetPostTags"
 )
 
 // MsgClient is the client API for Msg service.
@@ -41,6 +60,25 @@ type MsgClient interface {
 	DeletePost(ctx context.Context, in *MsgDeletePost, opts ...grpc.CallOption) (*MsgDeletePostResponse, error)
 	AddEditor(ctx context.Context, in *MsgAddEditor, opts ...grpc.CallOption) (*MsgAddEditorResponse, error)
 	DeleteEditor(ctx context.Context, in *MsgDeleteEditor, opts ...grpc.CallOption) (*MsgDeleteEditorResponse, error)
+	BatchPostOps(ctx context.Context, in *MsgBatchPostOps, opts ...grpc.CallOption) (*MsgBatchPostOpsResponse, error)
+	SetProfile(ctx context.Context, in *MsgSetProfile, opts ...grpc.CallOption) (*MsgSetProfileResponse, error)
+	Follow(ctx context.Context, in *MsgFollow, opts ...grpc.CallOption) (*MsgFollowResponse, error)
+	Unfollow(ctx context.Context, in *MsgUnfollow, opts ...grpc.CallOption) (*MsgUnfollowResponse, error)
+	CreateSeries(ctx context.Context, in *MsgCreateSeries, opts ...grpc.CallOption) (*MsgCreateSeriesResponse, error)
+	AppendSeriesPost(ctx context.Context, in *MsgAppendSeriesPost, opts ...grpc.CallOption) (*MsgAppendSeriesPostResponse, error)
+	ReorderSeries(ctx context.Context, in *MsgReorderSeries, opts ...grpc.CallOption) (*MsgReorderSeriesResponse, error)
+	RemoveSeriesPost(ctx context.Context, in *MsgRemoveSeriesPost, opts ...grpc.CallOption) (*MsgRemoveSeriesPostResponse, error)
+	SetCoAuthors(ctx context.Context, in *MsgSetCoAuthors, opts ...grpc.CallOption) (*MsgSetCoAuthorsResponse, error)
+	ProposePostChange(ctx context.Context, in *MsgProposePostChange, opts ...grpc.CallOption) (*MsgProposePostChangeResponse, error)
+	ApprovePostChange(ctx context.Context, in *MsgApprovePostChange, opts ...grpc.CallOption) (*MsgApprovePostChangeResponse, error)
+	PatchPost(ctx context.Context, in *MsgPatchPost, opts ...grpc.CallOption) (*MsgPatchPostResponse, error)
+	RestorePost(ctx context.Context, in *MsgRestorePost, opts ...grpc.CallOption) (*MsgRestorePostResponse, error)
+	CreatePrivatePost(ctx context.Context, in *MsgCreatePrivatePost, opts ...grpc.CallOption) (*MsgCreatePrivatePostResponse, error)
+	GrantReadAccess(ctx context.Context, in *MsgGrantReadAccess, opts ...grpc.CallOption) (*MsgGrantReadAccessResponse, error)
+	RevokeReadAccess(ctx context.Context, in *MsgRevokeReadAccess, opts ...grpc.CallOption) (*MsgRevokeReadAccessResponse, error)
+	SetPostPrice(ctx context.Context, in *MsgSetPostPrice, opts ...grpc.CallOption) (*MsgSetPostPriceResponse, error)
+	PurchaseAccess(ctx context.Context, in *MsgPurchaseAccess, opts ...grpc.CallOption) (*MsgPurchaseAccessResponse, error)
+	SetPostTags(ctx context.Context, in *MsgSetPostTags, opts ...grpc.CallOption) (*MsgSetPostTagsResponse, error)
 }
 
 type msgClient struct {
@@ -111,6 +149,196 @@ func (c *msgClient) DeleteEditor(ctx context.Context, in *MsgDeleteEditor, opts
 	return out, nil
 }
 
+func (c *msgClient) BatchPostOps(ctx context.Context, in *MsgBatchPostOps, opts ...grpc.CallOption) (*MsgBatchPostOpsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgBatchPostOpsResponse)
+	err := c.cc.Invoke(ctx, Msg_BatchPostOps_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetProfile(ctx context.Context, in *MsgSetProfile, opts ...grpc.CallOption) (*MsgSetProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetProfileResponse)
+	err := c.cc.Invoke(ctx, Msg_SetProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Follow(ctx context.Context, in *MsgFollow, opts ...grpc.CallOption) (*MsgFollowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgFollowResponse)
+	err := c.cc.Invoke(ctx, Msg_Follow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Unfollow(ctx context.Context, in *MsgUnfollow, opts ...grpc.CallOption) (*MsgUnfollowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUnfollowResponse)
+	err := c.cc.Invoke(ctx, Msg_Unfollow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateSeries(ctx context.Context, in *MsgCreateSeries, opts ...grpc.CallOption) (*MsgCreateSeriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgCreateSeriesResponse)
+	err := c.cc.Invoke(ctx, Msg_CreateSeries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AppendSeriesPost(ctx context.Context, in *MsgAppendSeriesPost, opts ...grpc.CallOption) (*MsgAppendSeriesPostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgAppendSeriesPostResponse)
+	err := c.cc.Invoke(ctx, Msg_AppendSeriesPost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReorderSeries(ctx context.Context, in *MsgReorderSeries, opts ...grpc.CallOption) (*MsgReorderSeriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgReorderSeriesResponse)
+	err := c.cc.Invoke(ctx, Msg_ReorderSeries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveSeriesPost(ctx context.Context, in *MsgRemoveSeriesPost, opts ...grpc.CallOption) (*MsgRemoveSeriesPostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRemoveSeriesPostResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveSeriesPost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetCoAuthors(ctx context.Context, in *MsgSetCoAuthors, opts ...grpc.CallOption) (*MsgSetCoAuthorsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetCoAuthorsResponse)
+	err := c.cc.Invoke(ctx, Msg_SetCoAuthors_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ProposePostChange(ctx context.Context, in *MsgProposePostChange, opts ...grpc.CallOption) (*MsgProposePostChangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgProposePostChangeResponse)
+	err := c.cc.Invoke(ctx, Msg_ProposePostChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ApprovePostChange(ctx context.Context, in *MsgApprovePostChange, opts ...grpc.CallOption) (*MsgApprovePostChangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgApprovePostChangeResponse)
+	err := c.cc.Invoke(ctx, Msg_ApprovePostChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PatchPost(ctx context.Context, in *MsgPatchPost, opts ...grpc.CallOption) (*MsgPatchPostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgPatchPostResponse)
+	err := c.cc.Invoke(ctx, Msg_PatchPost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RestorePost(ctx context.Context, in *MsgRestorePost, opts ...grpc.CallOption) (*MsgRestorePostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRestorePostResponse)
+	err := c.cc.Invoke(ctx, Msg_RestorePost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreatePrivatePost(ctx context.Context, in *MsgCreatePrivatePost, opts ...grpc.CallOption) (*MsgCreatePrivatePostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgCreatePrivatePostResponse)
+	err := c.cc.Invoke(ctx, Msg_CreatePrivatePost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) GrantReadAccess(ctx context.Context, in *MsgGrantReadAccess, opts ...grpc.CallOption) (*MsgGrantReadAccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgGrantReadAccessResponse)
+	err := c.cc.Invoke(ctx, Msg_GrantReadAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeReadAccess(ctx context.Context, in *MsgRevokeReadAccess, opts ...grpc.CallOption) (*MsgRevokeReadAccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRevokeReadAccessResponse)
+	err := c.cc.Invoke(ctx, Msg_RevokeReadAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetPostPrice(ctx context.Context, in *MsgSetPostPrice, opts ...grpc.CallOption) (*MsgSetPostPriceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetPostPriceResponse)
+	err := c.cc.Invoke(ctx, Msg_SetPostPrice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PurchaseAccess(ctx context.Context, in *MsgPurchaseAccess, opts ...grpc.CallOption) (*MsgPurchaseAccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgPurchaseAccessResponse)
+	err := c.cc.Invoke(ctx, Msg_PurchaseAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetPostTags(ctx context.Context, in *MsgSetPostTags, opts ...grpc.CallOption) (*MsgSetPostTagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetPostTagsResponse)
+	err := c.cc.Invoke(ctx, Msg_SetPostTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility.
@@ -125,6 +353,25 @@ type MsgServer interface {
 	DeletePost(context.Context, *MsgDeletePost) (*MsgDeletePostResponse, error)
 	AddEditor(context.Context, *MsgAddEditor) (*MsgAddEditorResponse, error)
 	DeleteEditor(context.Context, *MsgDeleteEditor) (*MsgDeleteEditorResponse, error)
+	BatchPostOps(context.Context, *MsgBatchPostOps) (*MsgBatchPostOpsResponse, error)
+	SetProfile(context.Context, *MsgSetProfile) (*MsgSetProfileResponse, error)
+	Follow(context.Context, *MsgFollow) (*MsgFollowResponse, error)
+	Unfollow(context.Context, *MsgUnfollow) (*MsgUnfollowResponse, error)
+	CreateSeries(context.Context, *MsgCreateSeries) (*MsgCreateSeriesResponse, error)
+	AppendSeriesPost(context.Context, *MsgAppendSeriesPost) (*MsgAppendSeriesPostResponse, error)
+	ReorderSeries(context.Context, *MsgReorderSeries) (*MsgReorderSeriesResponse, error)
+	RemoveSeriesPost(context.Context, *MsgRemoveSeriesPost) (*MsgRemoveSeriesPostResponse, error)
+	SetCoAuthors(context.Context, *MsgSetCoAuthors) (*MsgSetCoAuthorsResponse, error)
+	ProposePostChange(context.Context, *MsgProposePostChange) (*MsgProposePostChangeResponse, error)
+	ApprovePostChange(context.Context, *MsgApprovePostChange) (*MsgApprovePostChangeResponse, error)
+	PatchPost(context.Context, *MsgPatchPost) (*MsgPatchPostResponse, error)
+	RestorePost(context.Context, *MsgRestorePost) (*MsgRestorePostResponse, error)
+	CreatePrivatePost(context.Context, *MsgCreatePrivatePost) (*MsgCreatePrivatePostResponse, error)
+	GrantReadAccess(context.Context, *MsgGrantReadAccess) (*MsgGrantReadAccessResponse, error)
+	RevokeReadAccess(context.Context, *MsgRevokeReadAccess) (*MsgRevokeReadAccessResponse, error)
+	SetPostPrice(context.Context, *MsgSetPostPrice) (*MsgSetPostPriceResponse, error)
+	PurchaseAccess(context.Context, *MsgPurchaseAccess) (*MsgPurchaseAccessResponse, error)
+	SetPostTags(context.Context, *MsgSetPostTags) (*MsgSetPostTagsResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -153,6 +400,63 @@ func (UnimplementedMsgServer) AddEditor(context.Context, *MsgAddEditor) (*MsgAdd
 func (UnimplementedMsgServer) DeleteEditor(context.Context, *MsgDeleteEditor) (*MsgDeleteEditorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteEditor not implemented")
 }
+func (UnimplementedMsgServer) BatchPostOps(context.Context, *MsgBatchPostOps) (*MsgBatchPostOpsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchPostOps not implemented")
+}
+func (UnimplementedMsgServer) SetProfile(context.Context, *MsgSetProfile) (*MsgSetProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetProfile not implemented")
+}
+func (UnimplementedMsgServer) Follow(context.Context, *MsgFollow) (*MsgFollowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Follow not implemented")
+}
+func (UnimplementedMsgServer) Unfollow(context.Context, *MsgUnfollow) (*MsgUnfollowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unfollow not implemented")
+}
+func (UnimplementedMsgServer) CreateSeries(context.Context, *MsgCreateSeries) (*MsgCreateSeriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSeries not implemented")
+}
+func (UnimplementedMsgServer) AppendSeriesPost(context.Context, *MsgAppendSeriesPost) (*MsgAppendSeriesPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AppendSeriesPost not implemented")
+}
+func (UnimplementedMsgServer) ReorderSeries(context.Context, *MsgReorderSeries) (*MsgReorderSeriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderSeries not implemented")
+}
+func (UnimplementedMsgServer) RemoveSeriesPost(context.Context, *MsgRemoveSeriesPost) (*MsgRemoveSeriesPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveSeriesPost not implemented")
+}
+func (UnimplementedMsgServer) SetCoAuthors(context.Context, *MsgSetCoAuthors) (*MsgSetCoAuthorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCoAuthors not implemented")
+}
+func (UnimplementedMsgServer) ProposePostChange(context.Context, *MsgProposePostChange) (*MsgProposePostChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposePostChange not implemented")
+}
+func (UnimplementedMsgServer) ApprovePostChange(context.Context, *MsgApprovePostChange) (*MsgApprovePostChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApprovePostChange not implemented")
+}
+func (UnimplementedMsgServer) PatchPost(context.Context, *MsgPatchPost) (*MsgPatchPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchPost not implemented")
+}
+func (UnimplementedMsgServer) RestorePost(context.Context, *MsgRestorePost) (*MsgRestorePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestorePost not implemented")
+}
+func (UnimplementedMsgServer) CreatePrivatePost(context.Context, *MsgCreatePrivatePost) (*MsgCreatePrivatePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePrivatePost not implemented")
+}
+func (UnimplementedMsgServer) GrantReadAccess(context.Context, *MsgGrantReadAccess) (*MsgGrantReadAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantReadAccess not implemented")
+}
+func (UnimplementedMsgServer) RevokeReadAccess(context.Context, *MsgRevokeReadAccess) (*MsgRevokeReadAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeReadAccess not implemented")
+}
+func (UnimplementedMsgServer) SetPostPrice(context.Context, *MsgSetPostPrice) (*MsgSetPostPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPostPrice not implemented")
+}
+func (UnimplementedMsgServer) PurchaseAccess(context.Context, *MsgPurchaseAccess) (*MsgPurchaseAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurchaseAccess not implemented")
+}
+func (UnimplementedMsgServer) SetPostTags(context.Context, *MsgSetPostTags) (*MsgSetPostTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPostTags not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 func (UnimplementedMsgServer) testEmbeddedByValue()             {}
 
@@ -282,6 +586,348 @@ func _Msg_DeleteEditor_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_BatchPostOps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBatchPostOps)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BatchPostOps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_BatchPostOps_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BatchPostOps(ctx, req.(*MsgBatchPostOps))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetProfile)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetProfile(ctx, req.(*MsgSetProfile))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Follow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFollow)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Follow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_Follow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Follow(ctx, req.(*MsgFollow))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Unfollow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnfollow)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Unfollow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_Unfollow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Unfollow(ctx, req.(*MsgUnfollow))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateSeries)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreateSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateSeries(ctx, req.(*MsgCreateSeries))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AppendSeriesPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAppendSeriesPost)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AppendSeriesPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AppendSeriesPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AppendSeriesPost(ctx, req.(*MsgAppendSeriesPost))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReorderSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReorderSeries)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReorderSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ReorderSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReorderSeries(ctx, req.(*MsgReorderSeries))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveSeriesPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveSeriesPost)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveSeriesPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveSeriesPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveSeriesPost(ctx, req.(*MsgRemoveSeriesPost))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetCoAuthors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetCoAuthors)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetCoAuthors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetCoAuthors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetCoAuthors(ctx, req.(*MsgSetCoAuthors))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ProposePostChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProposePostChange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ProposePostChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ProposePostChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProposePostChange(ctx, req.(*MsgProposePostChange))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ApprovePostChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgApprovePostChange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ApprovePostChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ApprovePostChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ApprovePostChange(ctx, req.(*MsgApprovePostChange))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PatchPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPatchPost)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PatchPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_PatchPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PatchPost(ctx, req.(*MsgPatchPost))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RestorePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRestorePost)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RestorePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RestorePost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RestorePost(ctx, req.(*MsgRestorePost))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreatePrivatePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreatePrivatePost)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreatePrivatePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreatePrivatePost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreatePrivatePost(ctx, req.(*MsgCreatePrivatePost))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_GrantReadAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantReadAccess)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GrantReadAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_GrantReadAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantReadAccess(ctx, req.(*MsgGrantReadAccess))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeReadAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeReadAccess)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeReadAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RevokeReadAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeReadAccess(ctx, req.(*MsgRevokeReadAccess))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetPostPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetPostPrice)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetPostPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetPostPrice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetPostPrice(ctx, req.(*MsgSetPostPrice))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PurchaseAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPurchaseAccess)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PurchaseAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_PurchaseAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PurchaseAccess(ctx, req.(*MsgPurchaseAccess))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetPostTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetPostTags)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetPostTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetPostTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetPostTags(ctx, req.(*MsgSetPostTags))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -313,6 +959,82 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteEditor",
 			Handler:    _Msg_DeleteEditor_Handler,
 		},
+		{
+			MethodName: "BatchPostOps",
+			Handler:    _Msg_BatchPostOps_Handler,
+		},
+		{
+			MethodName: "SetProfile",
+			Handler:    _Msg_SetProfile_Handler,
+		},
+		{
+			MethodName: "Follow",
+			Handler:    _Msg_Follow_Handler,
+		},
+		{
+			MethodName: "Unfollow",
+			Handler:    _Msg_Unfollow_Handler,
+		},
+		{
+			MethodName: "CreateSeries",
+			Handler:    _Msg_CreateSeries_Handler,
+		},
+		{
+			MethodName: "AppendSeriesPost",
+			Handler:    _Msg_AppendSeriesPost_Handler,
+		},
+		{
+			MethodName: "ReorderSeries",
+			Handler:    _Msg_ReorderSeries_Handler,
+		},
+		{
+			MethodName: "RemoveSeriesPost",
+			Handler:    _Msg_RemoveSeriesPost_Handler,
+		},
+		{
+			MethodName: "SetCoAuthors",
+			Handler:    _Msg_SetCoAuthors_Handler,
+		},
+		{
+			MethodName: "ProposePostChange",
+			Handler:    _Msg_ProposePostChange_Handler,
+		},
+		{
+			MethodName: "ApprovePostChange",
+			Handler:    _Msg_ApprovePostChange_Handler,
+		},
+		{
+			MethodName: "PatchPost",
+			Handler:    _Msg_PatchPost_Handler,
+		},
+		{
+			MethodName: "RestorePost",
+			Handler:    _Msg_RestorePost_Handler,
+		},
+		{
+			MethodName: "CreatePrivatePost",
+			Handler:    _Msg_CreatePrivatePost_Handler,
+		},
+		{
+			MethodName: "GrantReadAccess",
+			Handler:    _Msg_GrantReadAccess_Handler,
+		},
+		{
+			MethodName: "RevokeReadAccess",
+			Handler:    _Msg_RevokeReadAccess_Handler,
+		},
+		{
+			MethodName: "SetPostPrice",
+			Handler:    _Msg_SetPostPrice_Handler,
+		},
+		{
+			MethodName: "PurchaseAccess",
+			Handler:    _Msg_PurchaseAccess_Handler,
+		},
+		{
+			MethodName: "SetPostTags",
+			Handler:    _Msg_SetPostTags_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "blog/blog/tx.proto",
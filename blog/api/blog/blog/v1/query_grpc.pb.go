@@ -0,0 +1,941 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: blog/blog/v1/query.proto
+
+package blogv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Query_Params_FullMethodName              = "/blog.blog.v1.Query/Params"
+	Query_Posts_FullMethodName               = "/blog.blog.v1.Query/Posts"
+	Query_Post_FullMethodName                = "/blog.blog.v1.Query/Post"
+	Query_RenderedPost_FullMethodName        = "/blog.blog.v1.Query/RenderedPost"
+	Query_PostChangeProposals_FullMethodName = "/blog.blog.v1.Query/PostChangeProposals"
+	Query_PostAtHeight_FullMethodName        = "/blog.blog.v1.Query/PostAtHeight"
+	Query_PostTimeline_FullMethodName        = "/blog.blog.v1.Query/PostTimeline"
+	Query_PostPurchasers_FullMethodName      = "/blog.blog.v1.Query/PostPurchasers"
+	Query_PostBySlug_FullMethodName          = "/blog.blog.v1.Query/PostBySlug"
+	Query_SearchPosts_FullMethodName         = "/blog.blog.v1.Query/SearchPosts"
+	Query_Series_FullMethodName              = "/blog.blog.v1.Query/Series"
+	Query_Stats_FullMethodName               = "/blog.blog.v1.Query/Stats"
+	Query_AccountStats_FullMethodName        = "/blog.blog.v1.Query/AccountStats"
+	Query_AccountProfile_FullMethodName      = "/blog.blog.v1.Query/AccountProfile"
+	Query_AccountFollowers_FullMethodName    = "/blog.blog.v1.Query/AccountFollowers"
+	Query_AccountFollowing_FullMethodName    = "/blog.blog.v1.Query/AccountFollowing"
+	Query_AccountFeed_FullMethodName         = "/blog.blog.v1.Query/AccountFeed"
+	Query_AccountDeletedPosts_FullMethodName = "/blog.blog.v1.Query/AccountDeletedPosts"
+	Query_HasAccess_FullMethodName           = "/blog.blog.v1.Query/HasAccess"
+	Query_PurchasedPosts_FullMethodName      = "/blog.blog.v1.Query/PurchasedPosts"
+	Query_ProfileByName_FullMethodName       = "/blog.blog.v1.Query/ProfileByName"
+)
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Query is the versioned query service of the blog module, serving the same
+// state as blog.blog.Query under resource-oriented REST routes.
+type QueryClient interface {
+	// Params returns the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Posts lists posts, optionally filtered and sorted.
+	Posts(ctx context.Context, in *QueryPostsRequest, opts ...grpc.CallOption) (*QueryPostsResponse, error)
+	// Post returns a post by ID.
+	Post(ctx context.Context, in *QueryPostRequest, opts ...grpc.CallOption) (*QueryPostResponse, error)
+	// RenderedPost returns the body of a post rendered as sanitized HTML.
+	RenderedPost(ctx context.Context, in *QueryRenderedPostRequest, opts ...grpc.CallOption) (*QueryRenderedPostResponse, error)
+	// PostChangeProposals lists the pending change proposals of a post.
+	PostChangeProposals(ctx context.Context, in *QueryPostChangeProposalsRequest, opts ...grpc.CallOption) (*QueryPostChangeProposalsResponse, error)
+	// PostAtHeight returns a post as it was at the end of a block. The query
+	// must be sent with the x-cosmos-block-height header set to the height, so
+	// that it reads the store versioned at that height; the REST route sets it.
+	// Heights pruned by the node cannot be queried.
+	PostAtHeight(ctx context.Context, in *QueryPostAtHeightRequest, opts ...grpc.CallOption) (*QueryPostAtHeightResponse, error)
+	// PostTimeline lists the changes of a post in the order they were made,
+	// including its deletion, restoration and purge. Posts that never existed
+	// are not found.
+	PostTimeline(ctx context.Context, in *QueryPostTimelineRequest, opts ...grpc.CallOption) (*QueryPostTimelineResponse, error)
+	// PostPurchasers lists the addresses that bought access to a post.
+	PostPurchasers(ctx context.Context, in *QueryPostPurchasersRequest, opts ...grpc.CallOption) (*QueryPostPurchasersResponse, error)
+	// PostBySlug returns a post by its current or a previous slug.
+	PostBySlug(ctx context.Context, in *QueryPostBySlugRequest, opts ...grpc.CallOption) (*QueryPostBySlugResponse, error)
+	// SearchPosts lists the posts containing every term of a query.
+	SearchPosts(ctx context.Context, in *QuerySearchPostsRequest, opts ...grpc.CallOption) (*QuerySearchPostsResponse, error)
+	// Series returns a series by ID.
+	Series(ctx context.Context, in *QuerySeriesRequest, opts ...grpc.CallOption) (*QuerySeriesResponse, error)
+	// Stats returns the post, creator and edit counters of the blog.
+	Stats(ctx context.Context, in *QueryStatsRequest, opts ...grpc.CallOption) (*QueryStatsResponse, error)
+	// AccountStats returns the post and edit counters of an address.
+	AccountStats(ctx context.Context, in *QueryAccountStatsRequest, opts ...grpc.CallOption) (*QueryAccountStatsResponse, error)
+	// AccountProfile returns the profile of an address.
+	AccountProfile(ctx context.Context, in *QueryAccountProfileRequest, opts ...grpc.CallOption) (*QueryAccountProfileResponse, error)
+	// AccountFollowers lists the addresses following an address.
+	AccountFollowers(ctx context.Context, in *QueryAccountFollowersRequest, opts ...grpc.CallOption) (*QueryAccountFollowersResponse, error)
+	// AccountFollowing lists the addresses an address follows.
+	AccountFollowing(ctx context.Context, in *QueryAccountFollowingRequest, opts ...grpc.CallOption) (*QueryAccountFollowingResponse, error)
+	// AccountFeed lists the newest posts of the addresses an address follows.
+	AccountFeed(ctx context.Context, in *QueryAccountFeedRequest, opts ...grpc.CallOption) (*QueryAccountFeedResponse, error)
+	// AccountDeletedPosts lists the posts of an address in the trash.
+	AccountDeletedPosts(ctx context.Context, in *QueryAccountDeletedPostsRequest, opts ...grpc.CallOption) (*QueryAccountDeletedPostsResponse, error)
+	// HasAccess returns whether an address may read a post, as a reader or a
+	// purchaser.
+	HasAccess(ctx context.Context, in *QueryHasAccessRequest, opts ...grpc.CallOption) (*QueryHasAccessResponse, error)
+	// PurchasedPosts lists the posts an address bought access to.
+	PurchasedPosts(ctx context.Context, in *QueryPurchasedPostsRequest, opts ...grpc.CallOption) (*QueryPurchasedPostsResponse, error)
+	// ProfileByName returns a profile by display name, ignoring case.
+	ProfileByName(ctx context.Context, in *QueryProfileByNameRequest, opts ...grpc.CallOption) (*QueryProfileByNameResponse, error)
+}
+
+type queryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQueryClient(cc grpc.ClientConnInterface) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, Query_Params_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Posts(ctx context.Context, in *QueryPostsRequest, opts ...grpc.CallOption) (*QueryPostsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryPostsResponse)
+	err := c.cc.Invoke(ctx, Query_Posts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Post(ctx context.Context, in *QueryPostRequest, opts ...grpc.CallOption) (*QueryPostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryPostResponse)
+	err := c.cc.Invoke(ctx, Query_Post_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RenderedPost(ctx context.Context, in *QueryRenderedPostRequest, opts ...grpc.CallOption) (*QueryRenderedPostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryRenderedPostResponse)
+	err := c.cc.Invoke(ctx, Query_RenderedPost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PostChangeProposals(ctx context.Context, in *QueryPostChangeProposalsRequest, opts ...grpc.CallOption) (*QueryPostChangeProposalsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryPostChangeProposalsResponse)
+	err := c.cc.Invoke(ctx, Query_PostChangeProposals_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PostAtHeight(ctx context.Context, in *QueryPostAtHeightRequest, opts ...grpc.CallOption) (*QueryPostAtHeightResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryPostAtHeightResponse)
+	err := c.cc.Invoke(ctx, Query_PostAtHeight_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PostTimeline(ctx context.Context, in *QueryPostTimelineRequest, opts ...grpc.CallOption) (*QueryPostTimelineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryPostTimelineResponse)
+	err := c.cc.Invoke(ctx, Query_PostTimeline_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PostPurchasers(ctx context.Context, in *QueryPostPurchasersRequest, opts ...grpc.CallOption) (*QueryPostPurchasersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryPostPurchasersResponse)
+	err := c.cc.Invoke(ctx, Query_PostPurchasers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PostBySlug(ctx context.Context, in *QueryPostBySlugRequest, opts ...grpc.CallOption) (*QueryPostBySlugResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryPostBySlugResponse)
+	err := c.cc.Invoke(ctx, Query_PostBySlug_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SearchPosts(ctx context.Context, in *QuerySearchPostsRequest, opts ...grpc.CallOption) (*QuerySearchPostsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuerySearchPostsResponse)
+	err := c.cc.Invoke(ctx, Query_SearchPosts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Series(ctx context.Context, in *QuerySeriesRequest, opts ...grpc.CallOption) (*QuerySeriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuerySeriesResponse)
+	err := c.cc.Invoke(ctx, Query_Series_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Stats(ctx context.Context, in *QueryStatsRequest, opts ...grpc.CallOption) (*QueryStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryStatsResponse)
+	err := c.cc.Invoke(ctx, Query_Stats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccountStats(ctx context.Context, in *QueryAccountStatsRequest, opts ...grpc.CallOption) (*QueryAccountStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAccountStatsResponse)
+	err := c.cc.Invoke(ctx, Query_AccountStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccountProfile(ctx context.Context, in *QueryAccountProfileRequest, opts ...grpc.CallOption) (*QueryAccountProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAccountProfileResponse)
+	err := c.cc.Invoke(ctx, Query_AccountProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccountFollowers(ctx context.Context, in *QueryAccountFollowersRequest, opts ...grpc.CallOption) (*QueryAccountFollowersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAccountFollowersResponse)
+	err := c.cc.Invoke(ctx, Query_AccountFollowers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccountFollowing(ctx context.Context, in *QueryAccountFollowingRequest, opts ...grpc.CallOption) (*QueryAccountFollowingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAccountFollowingResponse)
+	err := c.cc.Invoke(ctx, Query_AccountFollowing_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccountFeed(ctx context.Context, in *QueryAccountFeedRequest, opts ...grpc.CallOption) (*QueryAccountFeedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAccountFeedResponse)
+	err := c.cc.Invoke(ctx, Query_AccountFeed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccountDeletedPosts(ctx context.Context, in *QueryAccountDeletedPostsRequest, opts ...grpc.CallOption) (*QueryAccountDeletedPostsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAccountDeletedPostsResponse)
+	err := c.cc.Invoke(ctx, Query_AccountDeletedPosts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) HasAccess(ctx context.Context, in *QueryHasAccessRequest, opts ...grpc.CallOption) (*QueryHasAccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryHasAccessResponse)
+	err := c.cc.Invoke(ctx, Query_HasAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PurchasedPosts(ctx context.Context, in *QueryPurchasedPostsRequest, opts ...grpc.CallOption) (*QueryPurchasedPostsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryPurchasedPostsResponse)
+	err := c.cc.Invoke(ctx, Query_PurchasedPosts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProfileByName(ctx context.Context, in *QueryProfileByNameRequest, opts ...grpc.CallOption) (*QueryProfileByNameResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryProfileByNameResponse)
+	err := c.cc.Invoke(ctx, Query_ProfileByName_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+// All implementations must embed UnimplementedQueryServer
+// for forward compatibility.
+//
+// Query is the versioned query service of the blog module, serving the same
+// state as blog.blog.Query under resource-oriented REST routes.
+type QueryServer interface {
+	// Params returns the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Posts lists posts, optionally filtered and sorted.
+	Posts(context.Context, *QueryPostsRequest) (*QueryPostsResponse, error)
+	// Post returns a post by ID.
+	Post(context.Context, *QueryPostRequest) (*QueryPostResponse, error)
+	// RenderedPost returns the body of a post rendered as sanitized HTML.
+	RenderedPost(context.Context, *QueryRenderedPostRequest) (*QueryRenderedPostResponse, error)
+	// PostChangeProposals lists the pending change proposals of a post.
+	PostChangeProposals(context.Context, *QueryPostChangeProposalsRequest) (*QueryPostChangeProposalsResponse, error)
+	// PostAtHeight returns a post as it was at the end of a block. The query
+	// must be sent with the x-cosmos-block-height header set to the height, so
+	// that it reads the store versioned at that height; the REST route sets it.
+	// Heights pruned by the node cannot be queried.
+	PostAtHeight(context.Context, *QueryPostAtHeightRequest) (*QueryPostAtHeightResponse, error)
+	// PostTimeline lists the changes of a post in the order they were made,
+	// including its deletion, restoration and purge. Posts that never existed
+	// are not found.
+	PostTimeline(context.Context, *QueryPostTimelineRequest) (*QueryPostTimelineResponse, error)
+	// PostPurchasers lists the addresses that bought access to a post.
+	PostPurchasers(context.Context, *QueryPostPurchasersRequest) (*QueryPostPurchasersResponse, error)
+	// PostBySlug returns a post by its current or a previous slug.
+	PostBySlug(context.Context, *QueryPostBySlugRequest) (*QueryPostBySlugResponse, error)
+	// SearchPosts lists the posts containing every term of a query.
+	SearchPosts(context.Context, *QuerySearchPostsRequest) (*QuerySearchPostsResponse, error)
+	// Series returns a series by ID.
+	Series(context.Context, *QuerySeriesRequest) (*QuerySeriesResponse, error)
+	// Stats returns the post, creator and edit counters of the blog.
+	Stats(context.Context, *QueryStatsRequest) (*QueryStatsResponse, error)
+	// AccountStats returns the post and edit counters of an address.
+	AccountStats(context.Context, *QueryAccountStatsRequest) (*QueryAccountStatsResponse, error)
+	// AccountProfile returns the profile of an address.
+	AccountProfile(context.Context, *QueryAccountProfileRequest) (*QueryAccountProfileResponse, error)
+	// AccountFollowers lists the addresses following an address.
+	AccountFollowers(context.Context, *QueryAccountFollowersRequest) (*QueryAccountFollowersResponse, error)
+	// AccountFollowing lists the addresses an address follows.
+	AccountFollowing(context.Context, *QueryAccountFollowingRequest) (*QueryAccountFollowingResponse, error)
+	// AccountFeed lists the newest posts of the addresses an address follows.
+	AccountFeed(context.Context, *QueryAccountFeedRequest) (*QueryAccountFeedResponse, error)
+	// AccountDeletedPosts lists the posts of an address in the trash.
+	AccountDeletedPosts(context.Context, *QueryAccountDeletedPostsRequest) (*QueryAccountDeletedPostsResponse, error)
+	// HasAccess returns whether an address may read a post, as a reader or a
+	// purchaser.
+	HasAccess(context.Context, *QueryHasAccessRequest) (*QueryHasAccessResponse, error)
+	// PurchasedPosts lists the posts an address bought access to.
+	PurchasedPosts(context.Context, *QueryPurchasedPostsRequest) (*QueryPurchasedPostsResponse, error)
+	// ProfileByName returns a profile by display name, ignoring case.
+	ProfileByName(context.Context, *QueryProfileByNameRequest) (*QueryProfileByNameResponse, error)
+	mustEmbedUnimplementedQueryServer()
+}
+
+// UnimplementedQueryServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedQueryServer struct{}
+
+func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (UnimplementedQueryServer) Posts(context.Context, *QueryPostsRequest) (*QueryPostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Posts not implemented")
+}
+func (UnimplementedQueryServer) Post(context.Context, *QueryPostRequest) (*QueryPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Post not implemented")
+}
+func (UnimplementedQueryServer) RenderedPost(context.Context, *QueryRenderedPostRequest) (*QueryRenderedPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenderedPost not implemented")
+}
+func (UnimplementedQueryServer) PostChangeProposals(context.Context, *QueryPostChangeProposalsRequest) (*QueryPostChangeProposalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostChangeProposals not implemented")
+}
+func (UnimplementedQueryServer) PostAtHeight(context.Context, *QueryPostAtHeightRequest) (*QueryPostAtHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostAtHeight not implemented")
+}
+func (UnimplementedQueryServer) PostTimeline(context.Context, *QueryPostTimelineRequest) (*QueryPostTimelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostTimeline not implemented")
+}
+func (UnimplementedQueryServer) PostPurchasers(context.Context, *QueryPostPurchasersRequest) (*QueryPostPurchasersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostPurchasers not implemented")
+}
+func (UnimplementedQueryServer) PostBySlug(context.Context, *QueryPostBySlugRequest) (*QueryPostBySlugResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostBySlug not implemented")
+}
+func (UnimplementedQueryServer) SearchPosts(context.Context, *QuerySearchPostsRequest) (*QuerySearchPostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchPosts not implemented")
+}
+func (UnimplementedQueryServer) Series(context.Context, *QuerySeriesRequest) (*QuerySeriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Series not implemented")
+}
+func (UnimplementedQueryServer) Stats(context.Context, *QueryStatsRequest) (*QueryStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Stats not implemented")
+}
+func (UnimplementedQueryServer) AccountStats(context.Context, *QueryAccountStatsRequest) (*QueryAccountStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountStats not implemented")
+}
+func (UnimplementedQueryServer) AccountProfile(context.Context, *QueryAccountProfileRequest) (*QueryAccountProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountProfile not implemented")
+}
+func (UnimplementedQueryServer) AccountFollowers(context.Context, *QueryAccountFollowersRequest) (*QueryAccountFollowersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountFollowers not implemented")
+}
+func (UnimplementedQueryServer) AccountFollowing(context.Context, *QueryAccountFollowingRequest) (*QueryAccountFollowingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountFollowing not implemented")
+}
+func (UnimplementedQueryServer) AccountFeed(context.Context, *QueryAccountFeedRequest) (*QueryAccountFeedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountFeed not implemented")
+}
+func (UnimplementedQueryServer) AccountDeletedPosts(context.Context, *QueryAccountDeletedPostsRequest) (*QueryAccountDeletedPostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountDeletedPosts not implemented")
+}
+func (UnimplementedQueryServer) HasAccess(context.Context, *QueryHasAccessRequest) (*QueryHasAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HasAccess not implemented")
+}
+func (UnimplementedQueryServer) PurchasedPosts(context.Context, *QueryPurchasedPostsRequest) (*QueryPurchasedPostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurchasedPosts not implemented")
+}
+func (UnimplementedQueryServer) ProfileByName(context.Context, *QueryProfileByNameRequest) (*QueryProfileByNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProfileByName not implemented")
+}
+func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
+func (UnimplementedQueryServer) testEmbeddedByValue()               {}
+
+// UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QueryServer will
+// result in compilation errors.
+type UnsafeQueryServer interface {
+	mustEmbedUnimplementedQueryServer()
+}
+
+func RegisterQueryServer(s grpc.ServiceRegistrar, srv QueryServer) {
+	// If the following call pancis, it indicates UnimplementedQueryServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Query_ServiceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Params_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Posts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Posts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Posts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Posts(ctx, req.(*QueryPostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Post_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Post(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Post_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Post(ctx, req.(*QueryPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RenderedPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRenderedPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RenderedPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RenderedPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RenderedPost(ctx, req.(*QueryRenderedPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PostChangeProposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPostChangeProposalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PostChangeProposals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PostChangeProposals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PostChangeProposals(ctx, req.(*QueryPostChangeProposalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PostAtHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPostAtHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PostAtHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PostAtHeight_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PostAtHeight(ctx, req.(*QueryPostAtHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PostTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPostTimelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PostTimeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PostTimeline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PostTimeline(ctx, req.(*QueryPostTimelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PostPurchasers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPostPurchasersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PostPurchasers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PostPurchasers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PostPurchasers(ctx, req.(*QueryPostPurchasersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PostBySlug_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPostBySlugRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PostBySlug(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PostBySlug_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PostBySlug(ctx, req.(*QueryPostBySlugRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SearchPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySearchPostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SearchPosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SearchPosts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SearchPosts(ctx, req.(*QuerySearchPostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Series_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Series(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Series_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Series(ctx, req.(*QuerySeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Stats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Stats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Stats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Stats(ctx, req.(*QueryStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AccountStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountStats(ctx, req.(*QueryAccountStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AccountProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountProfile(ctx, req.(*QueryAccountProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountFollowers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountFollowersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountFollowers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AccountFollowers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountFollowers(ctx, req.(*QueryAccountFollowersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountFollowing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountFollowingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountFollowing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AccountFollowing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountFollowing(ctx, req.(*QueryAccountFollowingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountFeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AccountFeed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountFeed(ctx, req.(*QueryAccountFeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountDeletedPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountDeletedPostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountDeletedPosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AccountDeletedPosts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountDeletedPosts(ctx, req.(*QueryAccountDeletedPostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HasAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHasAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HasAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_HasAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HasAccess(ctx, req.(*QueryHasAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PurchasedPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPurchasedPostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PurchasedPosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PurchasedPosts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PurchasedPosts(ctx, req.(*QueryPurchasedPostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProfileByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProfileByNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProfileByName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ProfileByName_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProfileByName(ctx, req.(*QueryProfileByNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Query_ServiceDesc is the grpc.ServiceDesc for Query service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Query_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "blog.blog.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Posts",
+			Handler:    _Query_Posts_Handler,
+		},
+		{
+			MethodName: "Post",
+			Handler:    _Query_Post_Handler,
+		},
+		{
+			MethodName: "RenderedPost",
+			Handler:    _Query_RenderedPost_Handler,
+		},
+		{
+			MethodName: "PostChangeProposals",
+			Handler:    _Query_PostChangeProposals_Handler,
+		},
+		{
+			MethodName: "PostAtHeight",
+			Handler:    _Query_PostAtHeight_Handler,
+		},
+		{
+			MethodName: "PostTimeline",
+			Handler:    _Query_PostTimeline_Handler,
+		},
+		{
+			MethodName: "PostPurchasers",
+			Handler:    _Query_PostPurchasers_Handler,
+		},
+		{
+			MethodName: "PostBySlug",
+			Handler:    _Query_PostBySlug_Handler,
+		},
+		{
+			MethodName: "SearchPosts",
+			Handler:    _Query_SearchPosts_Handler,
+		},
+		{
+			MethodName: "Series",
+			Handler:    _Query_Series_Handler,
+		},
+		{
+			MethodName: "Stats",
+			Handler:    _Query_Stats_Handler,
+		},
+		{
+			MethodName: "AccountStats",
+			Handler:    _Query_AccountStats_Handler,
+		},
+		{
+			MethodName: "AccountProfile",
+			Handler:    _Query_AccountProfile_Handler,
+		},
+		{
+			MethodName: "AccountFollowers",
+			Handler:    _Query_AccountFollowers_Handler,
+		},
+		{
+			MethodName: "AccountFollowing",
+			Handler:    _Query_AccountFollowing_Handler,
+		},
+		{
+			MethodName: "AccountFeed",
+			Handler:    _Query_AccountFeed_Handler,
+		},
+		{
+			MethodName: "AccountDeletedPosts",
+			Handler:    _Query_AccountDeletedPosts_Handler,
+		},
+		{
+			MethodName: "HasAccess",
+			Handler:    _Query_HasAccess_Handler,
+		},
+		{
+			MethodName: "PurchasedPosts",
+			Handler:    _Query_PurchasedPosts_Handler,
+		},
+		{
+			MethodName: "ProfileByName",
+			Handler:    _Query_ProfileByName_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "blog/blog/v1/query.proto",
+}
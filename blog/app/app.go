@@ -76,6 +76,8 @@ import (
 	ibckeeper "github.com/cosmos/ibc-go/v8/modules/core/keeper"
 
 	blogmodulekeeper "blog/x/blog/keeper"
+	blogmodule "blog/x/blog/module"
+	blogstreaming "blog/x/blog/streaming"
 	// this line is used by starport scaffolding # stargate/app/moduleImport
 
 	"blog/docs"
@@ -140,6 +142,8 @@ type App struct {
 	ScopedKeepers             map[string]capabilitykeeper.ScopedKeeper
 
 	BlogKeeper blogmodulekeeper.Keeper
+	// BlogListener streams the writes to the blog store to SubscribePostChanges clients
+	BlogListener *blogstreaming.Listener
 	// this line is used by starport scaffolding # stargate/app/keeperDeclaration
 
 	// simulation manager
@@ -262,7 +266,11 @@ func New(
 	}
 
 	// register streaming services
-	if err := app.RegisterStreamingServices(appOpts, app.kvStoreKeys()); err != nil {
+	if err := app.registerStreamingServices(appOpts); err != nil {
+		return nil, err
+	}
+
+	if err := app.setAnteHandler(); err != nil {
 		return nil, err
 	}
 
@@ -382,6 +390,8 @@ func (app *App) SimulationManager() *module.SimulationManager {
 // API server.
 func (app *App) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APIConfig) {
 	app.App.RegisterAPIRoutes(apiSvr, apiConfig)
+	// register the blog feeds, which are served next to the gRPC gateway routes
+	blogmodule.AppModuleBasic{}.RegisterFeedRoutes(apiSvr.ClientCtx, apiSvr.Router)
 	// register swagger API in app.go so that other applications can override easily
 	if err := server.RegisterSwaggerAPI(apiSvr.ClientCtx, apiSvr.Router, apiConfig.Swagger); err != nil {
 		panic(err)
@@ -0,0 +1,85 @@
+package app
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"cosmossdk.io/store/streaming"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"github.com/spf13/cast"
+
+	blogstreaming "blog/x/blog/streaming"
+	blogtypes "blog/x/blog/types"
+)
+
+// registerStreamingServices registers the ABCI listener plugins configured in
+// the streaming section of app.toml, as BaseApp.RegisterStreamingServices
+// does, along with the blog listener serving SubscribePostChanges. The writes
+// to the blog store are always listened to, so plugins receive them too.
+func (app *App) registerStreamingServices(appOpts servertypes.AppOptions) error {
+	keys := app.kvStoreKeys()
+	app.BlogListener = blogstreaming.NewListener(app.appCodec, blogstreaming.DefaultRetainedBlocks)
+	listeners := []storetypes.ABCIListener{app.BlogListener}
+	exposed := []string{blogtypes.StoreKey}
+
+	streamingCfg := cast.ToStringMap(appOpts.Get(baseapp.StreamingTomlKey))
+	services := make([]string, 0, len(streamingCfg))
+	for service := range streamingCfg {
+		services = append(services, service)
+	}
+	sort.Strings(services)
+	for _, service := range services {
+		pluginKey := fmt.Sprintf("%s.%s.%s", baseapp.StreamingTomlKey, service, baseapp.StreamingABCIPluginTomlKey)
+		pluginName := strings.TrimSpace(cast.ToString(appOpts.Get(pluginKey)))
+		if pluginName == "" {
+			continue
+		}
+		plugin, err := streaming.NewStreamingPlugin(pluginName, cast.ToString(appOpts.Get(flags.FlagLogLevel)))
+		if err != nil {
+			return fmt.Errorf("failed to load streaming plugin: %w", err)
+		}
+		listener, ok := plugin.(storetypes.ABCIListener)
+		if !ok {
+			return fmt.Errorf("failed to register streaming plugin: unexpected plugin type %T", plugin)
+		}
+		listeners = append(listeners, listener)
+		keysKey := fmt.Sprintf("%s.%s.%s", baseapp.StreamingTomlKey, baseapp.StreamingABCITomlKey, baseapp.StreamingABCIKeysTomlKey)
+		exposed = append(exposed, cast.ToStringSlice(appOpts.Get(keysKey))...)
+	}
+
+	var storeKeys []storetypes.StoreKey
+	if slices.Contains(exposed, "*") {
+		exposed = exposed[:0]
+		for name := range keys {
+			exposed = append(exposed, name)
+		}
+	}
+	sort.Strings(exposed)
+	for _, name := range slices.Compact(exposed) {
+		if key, ok := keys[name]; ok {
+			storeKeys = append(storeKeys, key)
+		}
+	}
+	app.CommitMultiStore().AddListeners(storeKeys)
+
+	stopNodeOnErrKey := fmt.Sprintf("%s.%s.%s", baseapp.StreamingTomlKey, baseapp.StreamingABCITomlKey, baseapp.StreamingABCIStopNodeOnErrTomlKey)
+	app.SetStreamingManager(storetypes.StreamingManager{
+		ABCIListeners: listeners,
+		StopNodeOnErr: cast.ToBool(appOpts.Get(stopNodeOnErrKey)),
+	})
+	return nil
+}
+
+// RegisterGRPCServer registers the gRPC services of the app, along with the
+// blog Stream service which is served by the node rather than through ABCI
+// queries.
+func (app *App) RegisterGRPCServer(server gogogrpc.Server) {
+	app.App.RegisterGRPCServer(server)
+	blogtypes.RegisterStreamServer(server, app.BlogListener)
+}
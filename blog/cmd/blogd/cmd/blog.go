@@ -0,0 +1,430 @@
+package cmd
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/spf13/cobra"
+
+	blogmodule "blog/x/blog/module"
+	"blog/x/blog/types"
+)
+
+const (
+	flagFormat       = "format"
+	flagOutput       = "out"
+	flagPageLimit    = "page-limit"
+	flagBatchSize    = "batch-size"
+	flagCheckpoint   = "checkpoint"
+	flagDeriveSlugs  = "derive-slugs"
+	flagInclusionTTL = "inclusion-timeout"
+)
+
+// blogCommand returns the `blogd blog` tooling commands, which work on top of
+// the generated blog query and tx commands
+func blogCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Blog tooling subcommands",
+		DisableFlagParsing:         false,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		exportPostsCmd(),
+		importPostsCmd(),
+		postAtHeightCmd(),
+	)
+
+	return cmd
+}
+
+func exportPostsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-posts",
+		Short: "Export every post over gRPC as JSONL or Markdown files",
+		Long: `Export every post over gRPC, in ID order.
+
+The jsonl format writes one post per line to the output file, or to stdout when
+no output is given. The markdown format writes one file per post with YAML front
+matter to the output directory.`,
+		Example: `blogd blog export-posts --out posts.jsonl
+blogd blog export-posts --format markdown --out posts/`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			format, _ := cmd.Flags().GetString(flagFormat)
+			output, _ := cmd.Flags().GetString(flagOutput)
+			pageLimit, _ := cmd.Flags().GetUint64(flagPageLimit)
+
+			var (
+				write func(post *types.Post) error
+				w     *bufio.Writer
+			)
+			switch format {
+			case postFormatJSONL:
+				w = bufio.NewWriter(cmd.OutOrStdout())
+				if output != "" {
+					f, err := os.Create(output)
+					if err != nil {
+						return err
+					}
+					defer f.Close()
+					w = bufio.NewWriter(f)
+				}
+				write = func(post *types.Post) error {
+					return writeJSONLPost(w, clientCtx.Codec, post)
+				}
+			case postFormatMarkdown:
+				if output == "" {
+					return fmt.Errorf("the %s format requires --%s", postFormatMarkdown, flagOutput)
+				}
+				if err := os.MkdirAll(output, 0o755); err != nil {
+					return err
+				}
+				write = func(post *types.Post) error {
+					bz, err := formatMarkdownPost(*post)
+					if err != nil {
+						return err
+					}
+					return os.WriteFile(filepath.Join(output, markdownPostFileName(*post)), bz, 0o644)
+				}
+			default:
+				return fmt.Errorf("unknown format %q, expected %s or %s", format, postFormatJSONL, postFormatMarkdown)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			exported := 0
+			var nextKey []byte
+			for {
+				res, err := queryClient.ListPost(cmd.Context(), &types.QueryListPostRequest{
+					Pagination: &query.PageRequest{Key: nextKey, Limit: pageLimit},
+				})
+				if err != nil {
+					return err
+				}
+				for i := range res.Post {
+					if err := write(&res.Post[i]); err != nil {
+						return err
+					}
+				}
+				exported += len(res.Post)
+				if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+					break
+				}
+				nextKey = res.Pagination.NextKey
+			}
+			if w != nil {
+				if err := w.Flush(); err != nil {
+					return err
+				}
+			}
+
+			if output != "" {
+				fmt.Fprintf(cmd.ErrOrStderr(), "exported %d posts to %s\n", exported, output)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagFormat, postFormatJSONL, fmt.Sprintf("Export format, %s or %s", postFormatJSONL, postFormatMarkdown))
+	cmd.Flags().String(flagOutput, "", "Output file for jsonl, output directory for markdown")
+	cmd.Flags().Uint64(flagPageLimit, 100, "Number of posts requested per query")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func importPostsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import-posts [file-or-dir]",
+		Short: "Create the posts of a JSONL file or a Markdown directory in batched txs",
+		Long: `Create the posts of a file written by export-posts: a JSONL file, a Markdown
+file or a directory of Markdown files. The posts are created by the --from
+account in txs of --batch-size posts, keeping their title, body, body format,
+slug and tags. The plain posts of a tx are created by one MsgBatchPostOps and the
+encrypted ones by MsgCreatePrivatePost, keeping their summary, price, body URI
+and key envelopes, which must include one for the --from account.
+
+Each tx is waited for until it is included in a block, and the progress is
+recorded in the checkpoint file. Running the command again after a failure
+resumes after the last imported post. Note that the post quota and the message
+and body limits of the blog params apply to imported posts.`,
+		Example: `blogd blog import-posts posts.jsonl --from alice --chain-id blog
+blogd blog import-posts posts/ --batch-size 5 --derive-slugs --from alice --chain-id blog`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			if clientCtx.GenerateOnly || clientCtx.Simulate {
+				return errors.New("import-posts broadcasts txs and supports neither --generate-only nor --dry-run")
+			}
+			clientCtx = clientCtx.WithBroadcastMode(flags.BroadcastSync)
+
+			batchSize, _ := cmd.Flags().GetInt(flagBatchSize)
+			if batchSize <= 0 {
+				return fmt.Errorf("--%s must be positive", flagBatchSize)
+			}
+			deriveSlugs, _ := cmd.Flags().GetBool(flagDeriveSlugs)
+			timeout, _ := cmd.Flags().GetDuration(flagInclusionTTL)
+			checkpointPath, _ := cmd.Flags().GetString(flagCheckpoint)
+			if checkpointPath == "" {
+				checkpointPath = strings.TrimRight(args[0], string(filepath.Separator)) + ".checkpoint"
+			}
+
+			posts, err := readPostFiles(args[0], clientCtx.Codec)
+			if err != nil {
+				return err
+			}
+			msgs := make([]sdk.Msg, len(posts))
+			for i, post := range posts {
+				if msgs[i], err = importPostMsg(clientCtx.GetFromAddress().String(), post, deriveSlugs); err != nil {
+					return fmt.Errorf("post %d (%q): %w", i+1, post.Title, err)
+				}
+			}
+
+			checkpoint, err := readImportCheckpoint(checkpointPath)
+			if err != nil {
+				return err
+			}
+			out := cmd.ErrOrStderr()
+
+			if checkpoint.PendingTxHash != "" {
+				res, err := waitForTx(clientCtx, checkpoint.PendingTxHash, timeout)
+				if err != nil {
+					return fmt.Errorf("tx %s of the previous run is not included: %w; if it was dropped, remove pending_tx_hash from %s to send its posts again", checkpoint.PendingTxHash, err, checkpointPath)
+				}
+				if res.Code == 0 {
+					checkpoint.Imported += checkpoint.PendingCount
+				}
+				checkpoint.PendingTxHash, checkpoint.PendingCount = "", 0
+				if err := writeImportCheckpoint(checkpointPath, checkpoint); err != nil {
+					return err
+				}
+			}
+			if checkpoint.Imported > 0 {
+				fmt.Fprintf(out, "resuming after %d imported posts\n", checkpoint.Imported)
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf, err = txf.Prepare(clientCtx)
+			if err != nil {
+				return err
+			}
+
+			for checkpoint.Imported < len(msgs) {
+				batch := msgs[checkpoint.Imported:min(checkpoint.Imported+batchSize, len(msgs))]
+
+				txHash, err := broadcastBatch(cmd, clientCtx, txf, mergePostOps(batch))
+				if err != nil {
+					return fmt.Errorf("broadcasting posts %d to %d: %w", checkpoint.Imported+1, checkpoint.Imported+len(batch), err)
+				}
+				txf = txf.WithSequence(txf.Sequence() + 1)
+
+				checkpoint.PendingTxHash, checkpoint.PendingCount = txHash, len(batch)
+				if err := writeImportCheckpoint(checkpointPath, checkpoint); err != nil {
+					return err
+				}
+
+				res, err := waitForTx(clientCtx, txHash, timeout)
+				if err != nil {
+					return fmt.Errorf("waiting for tx %s: %w", txHash, err)
+				}
+				checkpoint.PendingTxHash, checkpoint.PendingCount = "", 0
+				if res.Code != 0 {
+					if err := writeImportCheckpoint(checkpointPath, checkpoint); err != nil {
+						return err
+					}
+					return fmt.Errorf("tx %s failed with code %d: %s", txHash, res.Code, res.RawLog)
+				}
+				checkpoint.Imported += len(batch)
+				if err := writeImportCheckpoint(checkpointPath, checkpoint); err != nil {
+					return err
+				}
+				fmt.Fprintf(out, "imported %d/%d posts (tx %s)\n", checkpoint.Imported, len(msgs), txHash)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().Int(flagBatchSize, int(types.DefaultMaxTxMsgs), "Number of posts created per tx")
+	cmd.Flags().String(flagCheckpoint, "", "Checkpoint file recording the progress (default: <file-or-dir>.checkpoint)")
+	cmd.Flags().Bool(flagDeriveSlugs, false, "Ignore the slugs of the file and derive them from the titles")
+	cmd.Flags().Duration(flagInclusionTTL, time.Minute, "How long to wait for each tx to be included in a block")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// importPostMsg returns the message creating post on behalf of creator: a
+// MsgCreatePrivatePost for an encrypted post, otherwise a MsgBatchPostOps with
+// a single create op
+func importPostMsg(creator string, post types.Post, deriveSlug bool) (sdk.Msg, error) {
+	slug := post.Slug
+	if deriveSlug {
+		slug = ""
+	}
+
+	var msg sdk.HasValidateBasic
+	if post.BodyFormat == types.BodyFormat_BODY_FORMAT_ENCRYPTED {
+		msg = &types.MsgCreatePrivatePost{
+			Creator:      creator,
+			Title:        post.Title,
+			Body:         post.Body,
+			Slug:         slug,
+			KeyEnvelopes: post.KeyEnvelopes,
+			Summary:      post.Summary,
+			Price:        post.Price,
+			BodyUri:      post.BodyUri,
+			Tags:         post.Tags,
+		}
+	} else {
+		msg = types.NewMsgBatchPostOps(creator, []types.PostOp{{
+			OpType:     types.PostOpType_POST_OP_TYPE_CREATE,
+			Title:      post.Title,
+			Body:       post.Body,
+			BodyFormat: post.BodyFormat,
+			Slug:       slug,
+			Tags:       post.Tags,
+		}})
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	return msg.(sdk.Msg), nil
+}
+
+// mergePostOps merges the consecutive MsgBatchPostOps of msgs, keeping the
+// order in which the posts are created
+func mergePostOps(msgs []sdk.Msg) []sdk.Msg {
+	var merged []sdk.Msg
+	for _, msg := range msgs {
+		batch, ok := msg.(*types.MsgBatchPostOps)
+		if !ok {
+			merged = append(merged, msg)
+			continue
+		}
+		if last, ok := lastPostOps(merged); ok && last.Creator == batch.Creator {
+			last.Ops = append(last.Ops, batch.Ops...)
+			continue
+		}
+		merged = append(merged, types.NewMsgBatchPostOps(batch.Creator, slices.Clone(batch.Ops)))
+	}
+	return merged
+}
+
+// lastPostOps returns the last message of msgs if it is a MsgBatchPostOps
+func lastPostOps(msgs []sdk.Msg) (*types.MsgBatchPostOps, bool) {
+	if len(msgs) == 0 {
+		return nil, false
+	}
+	batch, ok := msgs[len(msgs)-1].(*types.MsgBatchPostOps)
+	return batch, ok
+}
+
+// broadcastBatch signs and broadcasts a tx carrying msgs, returning its hash
+// once it passed CheckTx
+func broadcastBatch(cmd *cobra.Command, clientCtx client.Context, txf tx.Factory, msgs []sdk.Msg) (string, error) {
+	if txf.SimulateAndExecute() {
+		_, adjusted, err := tx.CalculateGas(clientCtx, txf, msgs...)
+		if err != nil {
+			return "", err
+		}
+		txf = txf.WithGas(adjusted)
+	}
+
+	builder, err := txf.BuildUnsignedTx(msgs...)
+	if err != nil {
+		return "", err
+	}
+	if err := tx.Sign(cmd.Context(), txf, clientCtx.FromName, builder, true); err != nil {
+		return "", err
+	}
+	bz, err := clientCtx.TxConfig.TxEncoder()(builder.GetTx())
+	if err != nil {
+		return "", err
+	}
+
+	res, err := clientCtx.BroadcastTx(bz)
+	if err != nil {
+		return "", err
+	}
+	if res.Code != 0 {
+		return "", fmt.Errorf("tx rejected with code %d: %s", res.Code, res.RawLog)
+	}
+	return res.TxHash, nil
+}
+
+// waitForTx polls for a tx until it is included in a block or timeout elapses
+func waitForTx(clientCtx client.Context, hash string, timeout time.Duration) (*sdk.TxResponse, error) {
+	deadline := time.Now().Add(timeout)
+	for {
+		res, err := authtx.QueryTx(clientCtx, hash)
+		if err == nil {
+			return res, nil
+		}
+		if time.Now().After(deadline) {
+			return nil, err
+		}
+		time.Sleep(time.Second)
+	}
+}
+
+func postAtHeightCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "post-at-height [id] [height]",
+		Short: "Show a post as it was at the end of a block",
+		Long: `Show a post as it was at the end of a block, reading the store of the node
+versioned at that height. Heights pruned by the node cannot be queried.`,
+		Example: `blogd blog post-at-height 1 1200`,
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid post ID %q: %w", args[0], err)
+			}
+			height, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil || height <= 0 {
+				return fmt.Errorf("invalid height %q", args[1])
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.PostAtHeight(blogmodule.WithQueryHeight(cmd.Context(), height), &types.QueryPostAtHeightRequest{Id: id, Height: height})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,254 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/spf13/cobra"
+
+	"blog/x/blog/types"
+)
+
+const flagCreator = "creator"
+
+// addGenesisPostsCmd returns the `blogd genesis add-posts` command
+func addGenesisPostsCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-posts [file-or-dir]",
+		Short: "Add posts to the blog section of genesis.json",
+		Long: `Add the posts of a file written by "blogd blog export-posts" to genesis.json: a
+JSONL file, a Markdown file or a directory of Markdown files.
+
+The posts get the IDs following post_count, which is raised accordingly. Missing
+slugs are derived from the titles, missing timestamps default to the genesis
+time and the creator is always made an editor. Creation times must not be after
+the genesis time nor decrease along the posts, which keep their order. The
+resulting blog genesis is validated before genesis.json is written.`,
+		Example: `blogd genesis add-posts posts.jsonl
+blogd genesis add-posts posts/ --creator $(blogd keys show alice -a)`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+			config.SetRoot(clientCtx.HomeDir)
+
+			creator, _ := cmd.Flags().GetString(flagCreator)
+			if creator != "" {
+				if _, err := sdk.AccAddressFromBech32(creator); err != nil {
+					return fmt.Errorf("invalid --%s: %w", flagCreator, err)
+				}
+			}
+
+			posts, err := readPostFiles(args[0], clientCtx.Codec)
+			if err != nil {
+				return err
+			}
+			if deriveSlugs, _ := cmd.Flags().GetBool(flagDeriveSlugs); deriveSlugs {
+				for i := range posts {
+					posts[i].Slug = ""
+				}
+			}
+
+			genFile := config.GenesisFile()
+			appState, appGenesis, err := genutiltypes.GenesisStateFromGenFile(genFile)
+			if err != nil {
+				return fmt.Errorf("failed to unmarshal genesis state: %w", err)
+			}
+			blogGenState, err := blogGenesisFromAppState(clientCtx.Codec, appState)
+			if err != nil {
+				return err
+			}
+
+			first := blogGenState.PostCount + 1
+			if err := appendGenesisPosts(blogGenState, posts, creator, appGenesis.GenesisTime); err != nil {
+				return err
+			}
+			if err := blogGenState.Validate(); err != nil {
+				return fmt.Errorf("invalid blog genesis state:\n%s", formatGenesisProblems(err))
+			}
+
+			blogGenStateBz, err := clientCtx.Codec.MarshalJSON(blogGenState)
+			if err != nil {
+				return fmt.Errorf("failed to marshal blog genesis state: %w", err)
+			}
+			appState[types.ModuleName] = blogGenStateBz
+
+			appStateJSON, err := json.Marshal(appState)
+			if err != nil {
+				return fmt.Errorf("failed to marshal application genesis state: %w", err)
+			}
+			appGenesis.AppState = appStateJSON
+			if err := genutil.ExportGenesisFile(appGenesis, genFile); err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.ErrOrStderr(), "added %d posts with IDs %d to %d\n", len(posts), first, blogGenState.PostCount)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(flagCreator, "", "Creator address of the posts that have none")
+	cmd.Flags().Bool(flagDeriveSlugs, false, "Ignore the slugs of the file and derive them from the titles")
+
+	return cmd
+}
+
+// validateBlogGenesisCmd returns the `blogd genesis validate-blog` command
+func validateBlogGenesisCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validate-blog [genesis-file]",
+		Short: "Validate the blog section of a genesis file, listing every problem found",
+		Long: `Validate the blog section of a genesis file, by default the genesis.json of the
+home directory. Unlike "blogd genesis validate", every problem found is listed
+with the index and ID of the offending post.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+			config.SetRoot(clientCtx.HomeDir)
+
+			genFile := config.GenesisFile()
+			if len(args) == 1 {
+				genFile = args[0]
+			}
+			appState, _, err := genutiltypes.GenesisStateFromGenFile(genFile)
+			if err != nil {
+				return fmt.Errorf("failed to unmarshal genesis state: %w", err)
+			}
+			blogGenState, err := blogGenesisFromAppState(clientCtx.Codec, appState)
+			if err != nil {
+				return err
+			}
+
+			if err := blogGenState.Validate(); err != nil {
+				fmt.Fprintf(cmd.OutOrStdout(), "%s\n", formatGenesisProblems(err))
+				return fmt.Errorf("blog genesis state of %s is invalid", genFile)
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "blog genesis state of %s is valid: %d posts, post_count %d\n", genFile, len(blogGenState.PostList), blogGenState.PostCount)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+
+	return cmd
+}
+
+// blogGenesisFromAppState returns the blog genesis state of appState, or the
+// default one if appState has no blog section
+func blogGenesisFromAppState(cdc codec.JSONCodec, appState map[string]json.RawMessage) (*types.GenesisState, error) {
+	genState := types.DefaultGenesis()
+	if bz, ok := appState[types.ModuleName]; ok {
+		if err := cdc.UnmarshalJSON(bz, genState); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal blog genesis state: %w", err)
+		}
+	}
+	return genState, nil
+}
+
+// appendGenesisPosts appends posts to genState as new posts, with the IDs
+// following its post count. Missing creators are set to creator, missing
+// timestamps to genesisTime and missing slugs derived from the titles. Creation
+// times must follow the ones of the posts already in genState and not be after
+// genesisTime, as posts created on chain are later.
+func appendGenesisPosts(genState *types.GenesisState, posts []types.Post, creator string, genesisTime time.Time) error {
+	slugs := make(map[string]bool, len(genState.PostList)+len(posts))
+	var lastCreatedAt time.Time
+	for _, post := range genState.PostList {
+		slugs[post.Slug] = true
+		if post.CreatedAt.After(lastCreatedAt) {
+			lastCreatedAt = post.CreatedAt
+		}
+	}
+	for _, deleted := range genState.DeletedPosts {
+		if deleted.Post.CreatedAt.After(lastCreatedAt) {
+			lastCreatedAt = deleted.Post.CreatedAt
+		}
+	}
+	taken := func(slug string) bool { return slugs[slug] }
+
+	for i, post := range posts {
+		if post.Creator == "" {
+			post.Creator = creator
+		}
+		if post.Creator == "" {
+			return fmt.Errorf("post %d (%q) has no creator, set one with --%s", i+1, post.Title, flagCreator)
+		}
+
+		slug := post.Slug
+		switch {
+		case slug == "":
+			slug = types.AvailableSlug(types.SlugFromTitle(post.Title), taken)
+		case slugs[slug]:
+			return fmt.Errorf("post %d (%q): slug %q is already used", i+1, post.Title, slug)
+		}
+		slugs[slug] = true
+
+		editors := post.Editors
+		if !slices.Contains(editors, post.Creator) {
+			editors = append([]string{post.Creator}, editors...)
+		}
+
+		createdAt := post.CreatedAt
+		if createdAt.IsZero() {
+			createdAt = genesisTime
+		}
+		switch {
+		case createdAt.After(genesisTime):
+			return fmt.Errorf("post %d (%q) was created at %s, after the genesis time %s", i+1, post.Title, createdAt.Format(time.RFC3339), genesisTime.Format(time.RFC3339))
+		case createdAt.Before(lastCreatedAt):
+			return fmt.Errorf("post %d (%q) was created at %s, before the previous post created at %s", i+1, post.Title, createdAt.Format(time.RFC3339), lastCreatedAt.Format(time.RFC3339))
+		}
+		lastCreatedAt = createdAt
+		lastUpdatedAt := post.LastUpdatedAt
+		if lastUpdatedAt.IsZero() {
+			lastUpdatedAt = createdAt
+		}
+
+		genState.PostCount++
+		genState.PostList = append(genState.PostList, types.Post{
+			Id:                genState.PostCount,
+			Creator:           post.Creator,
+			Title:             post.Title,
+			Body:              post.Body,
+			BodyFormat:        post.BodyFormat,
+			Slug:              slug,
+			Editors:           editors,
+			CoAuthors:         post.CoAuthors,
+			ApprovalThreshold: post.ApprovalThreshold,
+			KeyEnvelopes:      post.KeyEnvelopes,
+			Price:             post.Price,
+			Summary:           post.Summary,
+			BodyUri:           post.BodyUri,
+			CreatedAt:         createdAt.UTC(),
+			LastUpdatedAt:     lastUpdatedAt.UTC(),
+			Version:           1,
+		})
+	}
+	return nil
+}
+
+// formatGenesisProblems lists the problems joined in err, one per line
+func formatGenesisProblems(err error) string {
+	problems := []error{err}
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		problems = joined.Unwrap()
+	}
+	s := fmt.Sprintf("found %d problem(s):", len(problems))
+	for _, problem := range problems {
+		s += "\n  - " + problem.Error()
+	}
+	return s
+}
@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"blog/testutil/sample"
+	"blog/x/blog/types"
+)
+
+func TestAppendGenesisPosts(t *testing.T) {
+	alice, bob := sample.AccAddress(), sample.AccAddress()
+	genesisTime := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	createdAt := genesisTime.Add(-time.Hour)
+
+	genState := types.DefaultGenesis()
+	genState.PostList = []types.Post{{Id: 4, Creator: alice, Title: "Hello", Slug: "hello", Editors: []string{alice}, Version: 2}}
+	genState.PostCount = 4
+
+	err := appendGenesisPosts(genState, []types.Post{
+		{Id: 1, Creator: bob, Title: "Hello", Body: "again", CreatedAt: createdAt, Version: 7},
+		{Title: "Custom", Body: "slug", Slug: "custom", Editors: []string{bob}},
+	}, alice, genesisTime)
+	require.NoError(t, err)
+	require.NoError(t, genState.Validate())
+
+	require.Equal(t, uint64(6), genState.PostCount)
+	require.Equal(t, []types.Post{
+		genState.PostList[0],
+		{
+			Id: 5, Creator: bob, Title: "Hello", Body: "again", Slug: "hello-2", Editors: []string{bob},
+			CreatedAt: createdAt, LastUpdatedAt: createdAt, Version: 1,
+		},
+		{
+			Id: 6, Creator: alice, Title: "Custom", Body: "slug", Slug: "custom", Editors: []string{alice, bob},
+			CreatedAt: genesisTime, LastUpdatedAt: genesisTime, Version: 1,
+		},
+	}, genState.PostList)
+
+	// explicit slugs must be free
+	err = appendGenesisPosts(genState, []types.Post{{Creator: alice, Title: "Again", Slug: "custom"}}, "", genesisTime)
+	require.ErrorContains(t, err, `slug "custom" is already used`)
+
+	// creation times must not be after the genesis time
+	err = appendGenesisPosts(genState, []types.Post{{Creator: alice, Title: "Future", CreatedAt: genesisTime.Add(time.Second)}}, "", genesisTime)
+	require.ErrorContains(t, err, "after the genesis time")
+
+	// nor decrease along the posts
+	err = appendGenesisPosts(genState, []types.Post{{Creator: alice, Title: "Older", CreatedAt: createdAt.Add(-time.Hour)}}, "", genesisTime)
+	require.ErrorContains(t, err, "before the previous post")
+
+	// a creator is required
+	err = appendGenesisPosts(genState, []types.Post{{Title: "Anonymous"}}, "", genesisTime)
+	require.ErrorContains(t, err, "has no creator")
+}
@@ -0,0 +1,458 @@
+package cmd
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/bytes"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/spf13/cobra"
+
+	"blog/x/blog/types"
+)
+
+const (
+	flagIndexDB      = "db"
+	flagResync       = "resync"
+	flagPollInterval = "poll-interval"
+	flagOnce         = "once"
+)
+
+// blogEventTypes are the event types indexed, those emitted by the blog module
+var blogEventTypes = map[string]bool{
+	types.EventTypeCreatePost:        true,
+	types.EventTypeDeletePost:        true,
+	types.EventTypeAddEditor:         true,
+	types.EventTypeDeleteEditor:      true,
+	types.EventTypeUpdatePost:        true,
+	types.EventTypeUpdateParams:      true,
+	types.EventTypeBatchPostOps:      true,
+	types.EventTypeSetProfile:        true,
+	types.EventTypeFollow:            true,
+	types.EventTypeUnfollow:          true,
+	types.EventTypeCreateSeries:      true,
+	types.EventTypeUpdateSeries:      true,
+	types.EventTypeSetCoAuthors:      true,
+	types.EventTypeProposePostChange: true,
+	types.EventTypeApprovePostChange: true,
+	types.EventTypeExpirePostChange:  true,
+	types.EventTypeRestorePost:       true,
+	types.EventTypePurgePost:         true,
+	types.EventTypeGrantReadAccess:   true,
+	types.EventTypeRevokeReadAccess:  true,
+	types.EventTypeSetPostPrice:      true,
+	types.EventTypeSetPostTags:       true,
+	types.EventTypePurchaseAccess:    true,
+	types.EventTypeReleasePurchase:   true,
+	types.EventTypeRefundPurchase:    true,
+}
+
+// blockSource is the subset of the CometBFT RPC the indexer reads from. Any
+// CometBFT RPC endpoint serving the blog app, or a stand-in implementing
+// these methods, can be indexed.
+type blockSource interface {
+	Status(ctx context.Context) (*coretypes.ResultStatus, error)
+	Genesis(ctx context.Context) (*coretypes.ResultGenesis, error)
+	Block(ctx context.Context, height *int64) (*coretypes.ResultBlock, error)
+	BlockResults(ctx context.Context, height *int64) (*coretypes.ResultBlockResults, error)
+	ABCIQueryWithOptions(ctx context.Context, path string, data bytes.HexBytes, opts rpcclient.ABCIQueryOptions) (*coretypes.ResultABCIQuery, error)
+}
+
+// errReorg is returned by indexBlock when the block does not follow the last
+// indexed block
+var errReorg = errors.New("block does not follow the indexed chain")
+
+// indexerCmd returns the `blogd indexer` command
+func indexerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "indexer",
+		Short: "Index the blog state, events and messages of a node into SQLite",
+		Long: `Index the blocks of a node into a SQLite database for analytics: the blog
+events and messages of every block, and every revision of every post along with
+its editors. The posts and editors views hold the current state.
+
+The node is read over the CometBFT RPC of --node: blocks and block results, and
+the state of the posts changed by each block through ABCI queries at that
+height, so the node must keep the blocks and state of the heights to index.
+
+An empty database is indexed from genesis. The hash of every block is checked
+against the previous indexed block: when the chain of the node diverged from
+the index, the index is rolled back to the last common block and indexed again.
+--resync empties the database first and indexes from genesis again.`,
+		Example: `blogd indexer --db blog-index.db --node tcp://localhost:26657
+blogd indexer --db blog-index.db --resync --once`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			source, ok := clientCtx.Client.(blockSource)
+			if !ok {
+				return fmt.Errorf("the RPC client %T cannot be indexed", clientCtx.Client)
+			}
+
+			path, _ := cmd.Flags().GetString(flagIndexDB)
+			resync, _ := cmd.Flags().GetBool(flagResync)
+			pollInterval, _ := cmd.Flags().GetDuration(flagPollInterval)
+			once, _ := cmd.Flags().GetBool(flagOnce)
+
+			db, err := openIndexDB(path)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			ctx := cmd.Context()
+			if resync {
+				if err := deleteIndexAbove(ctx, db, -1); err != nil {
+					return err
+				}
+			}
+
+			ix := &indexer{
+				db:        db,
+				source:    source,
+				cdc:       clientCtx.Codec,
+				txDecoder: clientCtx.TxConfig.TxDecoder(),
+				logf: func(format string, args ...any) {
+					fmt.Fprintf(cmd.ErrOrStderr(), format+"\n", args...)
+				},
+			}
+			for {
+				if err := ix.catchUp(ctx); err != nil {
+					return err
+				}
+				if once {
+					return nil
+				}
+				select {
+				case <-ctx.Done():
+					return nil
+				case <-time.After(pollInterval):
+				}
+			}
+		},
+	}
+
+	cmd.Flags().String(flagIndexDB, "blog-index.db", "Path of the SQLite database")
+	cmd.Flags().Bool(flagResync, false, "Empty the database and index again from genesis")
+	cmd.Flags().Duration(flagPollInterval, time.Second, "How often to poll the node for new blocks")
+	cmd.Flags().Bool(flagOnce, false, "Exit once the latest block is indexed")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// indexer indexes the blocks of a source into a database
+type indexer struct {
+	db        *sql.DB
+	source    blockSource
+	cdc       codec.Codec
+	txDecoder sdk.TxDecoder
+	logf      func(format string, args ...any)
+}
+
+// catchUp indexes the blocks following the last indexed one up to the latest
+// block of the source, rolling back the blocks of a diverged chain
+func (ix *indexer) catchUp(ctx context.Context) error {
+	status, err := ix.source.Status(ctx)
+	if err != nil {
+		return err
+	}
+	latest := status.SyncInfo.LatestBlockHeight
+
+	last, _, err := lastIndexedBlock(ctx, ix.db)
+	if err != nil {
+		return err
+	}
+	if last > latest {
+		// the node is behind the index, as after being reset: only the
+		// blocks it has can be checked
+		if last, err = ix.rollback(ctx, latest); err != nil {
+			return err
+		}
+	}
+	if last == 0 {
+		if last, err = ix.indexGenesis(ctx); err != nil {
+			return err
+		}
+	}
+
+	for height := last + 1; height <= latest; height++ {
+		err := ix.indexBlock(ctx, height)
+		if errors.Is(err, errReorg) {
+			fork, err := ix.rollback(ctx, height-1)
+			if err != nil {
+				return err
+			}
+			if fork == height-1 {
+				return fmt.Errorf("block %d does not follow block %d of the node", height, fork)
+			}
+			return ix.catchUp(ctx)
+		}
+		if err != nil {
+			return fmt.Errorf("indexing block %d: %w", height, err)
+		}
+		if height%100 == 0 || height == latest {
+			ix.logf("indexed block %d/%d", height, latest)
+		}
+	}
+	return nil
+}
+
+// indexGenesis records the posts of the genesis state, returning the height
+// preceding the first block
+func (ix *indexer) indexGenesis(ctx context.Context) (int64, error) {
+	res, err := ix.source.Genesis(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("fetching the genesis: %w", err)
+	}
+	genesisHeight := res.Genesis.InitialHeight - 1
+
+	status, err := ix.source.Status(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if earliest := status.SyncInfo.EarliestBlockHeight; earliest > genesisHeight+1 {
+		return 0, fmt.Errorf("the node only has the blocks from height %d, index from a node keeping every block", earliest)
+	}
+
+	var appState map[string]json.RawMessage
+	if err := json.Unmarshal(res.Genesis.AppState, &appState); err != nil {
+		return 0, fmt.Errorf("invalid genesis app state: %w", err)
+	}
+	genState, err := blogGenesisFromAppState(ix.cdc, appState)
+	if err != nil {
+		return 0, err
+	}
+
+	tx, err := ix.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+	for i := range genState.PostList {
+		post := genState.PostList[i]
+		if err := insertRevision(ctx, tx, genesisHeight, post.Id, &post); err != nil {
+			return 0, err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	ix.logf("indexed %d genesis posts", len(genState.PostList))
+	return genesisHeight, nil
+}
+
+// rollback deletes the indexed blocks that are not in the chain of the source,
+// starting from height and going down, and returns the height of the last
+// block in common
+func (ix *indexer) rollback(ctx context.Context, height int64) (int64, error) {
+	fork := height
+	for ; fork > 0; fork-- {
+		indexed, err := indexedBlockHash(ctx, ix.db, fork)
+		if err != nil {
+			return 0, err
+		}
+		if indexed == "" {
+			break
+		}
+		block, err := ix.source.Block(ctx, &fork)
+		if err != nil {
+			return 0, err
+		}
+		if block.BlockID.Hash.String() == indexed {
+			break
+		}
+	}
+	ix.logf("rolling back the index to height %d", fork)
+	if fork == 0 {
+		// not even the first block is in common: the genesis may differ too
+		return 0, deleteIndexAbove(ctx, ix.db, -1)
+	}
+	return fork, deleteIndexAbove(ctx, ix.db, fork)
+}
+
+// indexBlock indexes the block at height, which must follow the last indexed
+// block
+func (ix *indexer) indexBlock(ctx context.Context, height int64) error {
+	block, err := ix.source.Block(ctx, &height)
+	if err != nil {
+		return err
+	}
+	parent, err := indexedBlockHash(ctx, ix.db, height-1)
+	if err != nil {
+		return err
+	}
+	if parent != "" && parent != block.Block.LastBlockID.Hash.String() {
+		return errReorg
+	}
+	results, err := ix.source.BlockResults(ctx, &height)
+	if err != nil {
+		return err
+	}
+
+	tx, err := ix.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := insertBlock(ctx, tx, height, block.BlockID.Hash.String(), block.Block.Time); err != nil {
+		return err
+	}
+
+	touched := map[uint64]bool{}
+	var postIDs []uint64
+	recordEvents := func(txIndex *int, events []abci.Event) error {
+		for i, event := range events {
+			if !blogEventTypes[event.Type] {
+				continue
+			}
+			attributes := make(map[string]string, len(event.Attributes))
+			for _, attr := range event.Attributes {
+				attributes[attr.Key] = attr.Value
+			}
+			var postID *uint64
+			if s, ok := attributes[types.AttributeKeyPostID]; ok {
+				id, err := strconv.ParseUint(s, 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid %s event: %w", event.Type, err)
+				}
+				postID = &id
+				if !touched[id] {
+					touched[id] = true
+					postIDs = append(postIDs, id)
+				}
+			}
+			if err := insertEvent(ctx, tx, height, txIndex, i, event.Type, postID, attributes); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	for i, txBytes := range block.Block.Txs {
+		var result *abci.ExecTxResult
+		if i < len(results.TxsResults) {
+			result = results.TxsResults[i]
+		}
+		if err := ix.indexTx(ctx, tx, height, i, txBytes, result); err != nil {
+			return err
+		}
+		if result != nil {
+			txIndex := i
+			if err := recordEvents(&txIndex, result.Events); err != nil {
+				return err
+			}
+		}
+	}
+	if err := recordEvents(nil, results.FinalizeBlockEvents); err != nil {
+		return err
+	}
+
+	for _, id := range postIDs {
+		post, err := ix.queryPost(ctx, id, height)
+		if err != nil {
+			return err
+		}
+		if err := insertRevision(ctx, tx, height, id, post); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// indexTx records the blog messages of a tx, including those wrapped in other
+// messages. Txs the app cannot decode are skipped.
+func (ix *indexer) indexTx(ctx context.Context, tx *sql.Tx, height int64, txIndex int, txBytes cmttypes.Tx, result *abci.ExecTxResult) error {
+	decoded, err := ix.txDecoder(txBytes)
+	if err != nil {
+		return nil
+	}
+	msgs, err := indexedMsgs(decoded.GetMsgs())
+	if err != nil {
+		return err
+	}
+	var code uint32
+	if result != nil {
+		code = result.Code
+	}
+	for i, msg := range msgs {
+		bz, err := ix.cdc.MarshalJSON(msg)
+		if err != nil {
+			return err
+		}
+		if err := insertMsg(ctx, tx, height, txIndex, i, fmt.Sprintf("%X", txBytes.Hash()), code, sdk.MsgTypeURL(msg), bz); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// indexedMsgs returns the blog messages of msgs, including those wrapped in
+// other messages such as authz MsgExec
+func indexedMsgs(msgs []sdk.Msg) ([]sdk.Msg, error) {
+	var blog []sdk.Msg
+	for _, msg := range msgs {
+		if strings.HasPrefix(sdk.MsgTypeURL(msg), "/"+types.ModuleName+"."+types.ModuleName+".") {
+			blog = append(blog, msg)
+			continue
+		}
+		if nested, ok := msg.(interface{ GetMessages() ([]sdk.Msg, error) }); ok {
+			inner, err := nested.GetMessages()
+			if err != nil {
+				return nil, err
+			}
+			innerBlog, err := indexedMsgs(inner)
+			if err != nil {
+				return nil, err
+			}
+			blog = append(blog, innerBlog...)
+		}
+	}
+	return blog, nil
+}
+
+// queryPost returns the state of a post at height, or nil if it did not exist
+func (ix *indexer) queryPost(ctx context.Context, id uint64, height int64) (*types.Post, error) {
+	req, err := (&types.QueryShowPostRequest{Id: id}).Marshal()
+	if err != nil {
+		return nil, err
+	}
+	res, err := ix.source.ABCIQueryWithOptions(ctx, "/blog.blog.Query/ShowPost", req, rpcclient.ABCIQueryOptions{Height: height})
+	if err != nil {
+		return nil, err
+	}
+	if !res.Response.IsOK() {
+		// the query router reports the errors of query handlers as unknown
+		// requests logging the original error
+		err := errorsmod.ABCIError(res.Response.Codespace, res.Response.Code, res.Response.Log)
+		if errors.Is(err, sdkerrors.ErrKeyNotFound) || strings.HasPrefix(res.Response.Log, sdkerrors.ErrKeyNotFound.Error()) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("querying post %d at height %d: %w", id, height, err)
+	}
+	var showPost types.QueryShowPostResponse
+	if err := showPost.Unmarshal(res.Response.Value); err != nil {
+		return nil, err
+	}
+	return &showPost.Post, nil
+}
@@ -0,0 +1,188 @@
+package cmd
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3" // registers the sqlite3 database/sql driver
+
+	"blog/x/blog/types"
+)
+
+// indexSchema is the schema of the index database. Every row is keyed by the
+// height it was indexed at, so that rolling back to a height only deletes
+// rows. Posts and editors are views of the latest revision of each post.
+const indexSchema = `
+CREATE TABLE IF NOT EXISTS blocks (
+	height INTEGER PRIMARY KEY,
+	hash   TEXT NOT NULL,
+	time   TEXT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS revisions (
+	post_id         INTEGER NOT NULL,
+	height          INTEGER NOT NULL,
+	deleted         INTEGER NOT NULL,
+	version         INTEGER NOT NULL,
+	creator         TEXT NOT NULL,
+	title           TEXT NOT NULL,
+	body            TEXT NOT NULL,
+	body_format     TEXT NOT NULL,
+	slug            TEXT NOT NULL,
+	created_at      TEXT NOT NULL,
+	last_updated_at TEXT NOT NULL,
+	PRIMARY KEY (post_id, height)
+);
+
+CREATE TABLE IF NOT EXISTS revision_editors (
+	post_id INTEGER NOT NULL,
+	height  INTEGER NOT NULL,
+	address TEXT NOT NULL,
+	PRIMARY KEY (post_id, height, address)
+);
+
+CREATE TABLE IF NOT EXISTS events (
+	height      INTEGER NOT NULL,
+	tx_index    INTEGER,
+	event_index INTEGER NOT NULL,
+	type        TEXT NOT NULL,
+	post_id     INTEGER,
+	attributes  TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS events_height ON events (height);
+CREATE INDEX IF NOT EXISTS events_post_id ON events (post_id);
+
+CREATE TABLE IF NOT EXISTS msgs (
+	height    INTEGER NOT NULL,
+	tx_index  INTEGER NOT NULL,
+	msg_index INTEGER NOT NULL,
+	tx_hash   TEXT NOT NULL,
+	code      INTEGER NOT NULL,
+	type_url  TEXT NOT NULL,
+	msg       TEXT NOT NULL,
+	PRIMARY KEY (height, tx_index, msg_index)
+);
+
+CREATE VIEW IF NOT EXISTS posts AS
+SELECT r.post_id AS id, r.height, r.version, r.creator, r.title, r.body, r.body_format, r.slug, r.created_at, r.last_updated_at
+FROM revisions r
+WHERE r.height = (SELECT MAX(height) FROM revisions WHERE post_id = r.post_id) AND r.deleted = 0;
+
+CREATE VIEW IF NOT EXISTS editors AS
+SELECT e.post_id, e.address
+FROM revision_editors e JOIN posts p ON p.id = e.post_id AND p.height = e.height;
+`
+
+// indexTables are the tables holding indexed rows, all with a height column
+var indexTables = []string{"blocks", "revisions", "revision_editors", "events", "msgs"}
+
+// openIndexDB opens the SQLite index database at path, creating its schema
+func openIndexDB(path string) (*sql.DB, error) {
+	db, err := sql.Open("sqlite3", fmt.Sprintf("file:%s?_journal_mode=WAL&_busy_timeout=5000", path))
+	if err != nil {
+		return nil, err
+	}
+	if _, err := db.Exec(indexSchema); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("creating the index schema: %w", err)
+	}
+	return db, nil
+}
+
+// lastIndexedBlock returns the height and hash of the last indexed block,
+// with a zero height if no block is indexed
+func lastIndexedBlock(ctx context.Context, db *sql.DB) (int64, string, error) {
+	var (
+		height int64
+		hash   string
+	)
+	err := db.QueryRowContext(ctx, `SELECT height, hash FROM blocks ORDER BY height DESC LIMIT 1`).Scan(&height, &hash)
+	if err == sql.ErrNoRows {
+		return 0, "", nil
+	}
+	return height, hash, err
+}
+
+// indexedBlockHash returns the hash of the block indexed at height, or an
+// empty string if none is
+func indexedBlockHash(ctx context.Context, db *sql.DB, height int64) (string, error) {
+	var hash string
+	err := db.QueryRowContext(ctx, `SELECT hash FROM blocks WHERE height = ?`, height).Scan(&hash)
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+	return hash, err
+}
+
+// deleteIndexAbove deletes the rows indexed above height. A negative height
+// empties the index.
+func deleteIndexAbove(ctx context.Context, db *sql.DB, height int64) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	for _, table := range indexTables {
+		if _, err := tx.ExecContext(ctx, fmt.Sprintf(`DELETE FROM %s WHERE height > ?`, table), height); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func insertBlock(ctx context.Context, tx *sql.Tx, height int64, hash string, blockTime time.Time) error {
+	_, err := tx.ExecContext(ctx, `INSERT INTO blocks (height, hash, time) VALUES (?, ?, ?)`,
+		height, hash, blockTime.UTC().Format(time.RFC3339Nano))
+	return err
+}
+
+// insertRevision records the state of a post at height, or its removal when
+// post is nil
+func insertRevision(ctx context.Context, tx *sql.Tx, height int64, id uint64, post *types.Post) error {
+	if _, err := tx.ExecContext(ctx, `DELETE FROM revision_editors WHERE post_id = ? AND height = ?`, id, height); err != nil {
+		return err
+	}
+	if post == nil {
+		_, err := tx.ExecContext(ctx, `INSERT OR REPLACE INTO revisions
+			(post_id, height, deleted, version, creator, title, body, body_format, slug, created_at, last_updated_at)
+			VALUES (?, ?, 1, 0, '', '', '', '', '', '', '')`, id, height)
+		return err
+	}
+
+	_, err := tx.ExecContext(ctx, `INSERT OR REPLACE INTO revisions
+		(post_id, height, deleted, version, creator, title, body, body_format, slug, created_at, last_updated_at)
+		VALUES (?, ?, 0, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		id, height, post.Version, post.Creator, post.Title, post.Body, post.BodyFormat.String(), post.Slug,
+		post.CreatedAt.UTC().Format(time.RFC3339Nano), post.LastUpdatedAt.UTC().Format(time.RFC3339Nano))
+	if err != nil {
+		return err
+	}
+	for _, editor := range post.Editors {
+		if _, err := tx.ExecContext(ctx, `INSERT OR IGNORE INTO revision_editors (post_id, height, address) VALUES (?, ?, ?)`,
+			id, height, editor); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// insertEvent records a blog event. txIndex is nil for the events emitted
+// outside of txs, postID nil for events about no post.
+func insertEvent(ctx context.Context, tx *sql.Tx, height int64, txIndex *int, eventIndex int, eventType string, postID *uint64, attributes map[string]string) error {
+	bz, err := json.Marshal(attributes)
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, `INSERT INTO events (height, tx_index, event_index, type, post_id, attributes) VALUES (?, ?, ?, ?, ?, ?)`,
+		height, txIndex, eventIndex, eventType, postID, string(bz))
+	return err
+}
+
+func insertMsg(ctx context.Context, tx *sql.Tx, height int64, txIndex, msgIndex int, txHash string, code uint32, typeURL string, msgJSON []byte) error {
+	_, err := tx.ExecContext(ctx, `INSERT INTO msgs (height, tx_index, msg_index, tx_hash, code, type_url, msg) VALUES (?, ?, ?, ?, ?, ?, ?)`,
+		height, txIndex, msgIndex, txHash, code, typeURL, string(msgJSON))
+	return err
+}
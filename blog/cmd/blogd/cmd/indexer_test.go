@@ -0,0 +1,202 @@
+package cmd
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/bytes"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/stretchr/testify/require"
+
+	"blog/testutil/sample"
+	"blog/x/blog/types"
+)
+
+// fakeChain is a blockSource serving blocks built by the test. Each block
+// carries the state of the posts after it.
+type fakeChain struct {
+	genesis *cmttypes.GenesisDoc
+	blocks  []fakeBlock
+}
+
+type fakeBlock struct {
+	block   *coretypes.ResultBlock
+	results *coretypes.ResultBlockResults
+	posts   map[uint64]types.Post
+}
+
+func (c *fakeChain) Status(context.Context) (*coretypes.ResultStatus, error) {
+	return &coretypes.ResultStatus{SyncInfo: coretypes.SyncInfo{
+		EarliestBlockHeight: 1,
+		LatestBlockHeight:   int64(len(c.blocks)),
+	}}, nil
+}
+
+func (c *fakeChain) Genesis(context.Context) (*coretypes.ResultGenesis, error) {
+	return &coretypes.ResultGenesis{Genesis: c.genesis}, nil
+}
+
+func (c *fakeChain) Block(_ context.Context, height *int64) (*coretypes.ResultBlock, error) {
+	return c.blocks[*height-1].block, nil
+}
+
+func (c *fakeChain) BlockResults(_ context.Context, height *int64) (*coretypes.ResultBlockResults, error) {
+	return c.blocks[*height-1].results, nil
+}
+
+func (c *fakeChain) ABCIQueryWithOptions(_ context.Context, path string, data bytes.HexBytes, opts rpcclient.ABCIQueryOptions) (*coretypes.ResultABCIQuery, error) {
+	if path != "/blog.blog.Query/ShowPost" {
+		return nil, fmt.Errorf("unexpected query %s", path)
+	}
+	var req types.QueryShowPostRequest
+	if err := req.Unmarshal(data); err != nil {
+		return nil, err
+	}
+	post, found := c.blocks[opts.Height-1].posts[req.Id]
+	if !found {
+		// as reported by the query router of the app
+		codespace, code, log := errorsmod.ABCIInfo(sdkerrors.ErrUnknownRequest.Wrap(sdkerrors.ErrKeyNotFound.Error()), false)
+		return &coretypes.ResultABCIQuery{Response: abci.ResponseQuery{Codespace: codespace, Code: code, Log: log}}, nil
+	}
+	bz, err := (&types.QueryShowPostResponse{Post: post}).Marshal()
+	if err != nil {
+		return nil, err
+	}
+	return &coretypes.ResultABCIQuery{Response: abci.ResponseQuery{Value: bz}}, nil
+}
+
+// addBlock appends a block to the chain. The fork name changes the hashes of
+// the block, as on another chain.
+func (c *fakeChain) addBlock(fork string, txs []cmttypes.Tx, txEvents [][]abci.Event, blockEvents []abci.Event, posts map[uint64]types.Post) {
+	height := int64(len(c.blocks)) + 1
+	hash := sha256.Sum256([]byte(fmt.Sprintf("%s/%d", fork, height)))
+	block := &coretypes.ResultBlock{
+		BlockID: cmttypes.BlockID{Hash: hash[:]},
+		Block: &cmttypes.Block{
+			Header: cmttypes.Header{Height: height, Time: time.Unix(height, 0).UTC()},
+			Data:   cmttypes.Data{Txs: txs},
+		},
+	}
+	if height > 1 {
+		block.Block.LastBlockID = c.blocks[height-2].block.BlockID
+	}
+	results := &coretypes.ResultBlockResults{Height: height, FinalizeBlockEvents: blockEvents}
+	for _, events := range txEvents {
+		results.TxsResults = append(results.TxsResults, &abci.ExecTxResult{Events: events})
+	}
+	c.blocks = append(c.blocks, fakeBlock{block: block, results: results, posts: posts})
+}
+
+func postEvent(eventType string, id uint64) abci.Event {
+	return abci.Event{Type: eventType, Attributes: []abci.EventAttribute{
+		{Key: types.AttributeKeyPostID, Value: strconv.FormatUint(id, 10)},
+	}}
+}
+
+func queryStrings(t *testing.T, db *sql.DB, query string, args ...any) []string {
+	t.Helper()
+	rows, err := db.Query(query, args...)
+	require.NoError(t, err)
+	defer rows.Close()
+	var values []string
+	for rows.Next() {
+		var value string
+		require.NoError(t, rows.Scan(&value))
+		values = append(values, value)
+	}
+	require.NoError(t, rows.Err())
+	return values
+}
+
+func TestIndexer(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	types.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+	txConfig := authtx.NewTxConfig(cdc, authtx.DefaultSignModes)
+	alice, bob := sample.AccAddress(), sample.AccAddress()
+	at := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	genesisPost := types.Post{Id: 1, Creator: alice, Title: "Genesis", Slug: "genesis", Editors: []string{alice}, CreatedAt: at, LastUpdatedAt: at, Version: 1}
+	genState := types.DefaultGenesis()
+	genState.PostList = []types.Post{genesisPost}
+	genState.PostCount = 1
+	appState, err := json.Marshal(map[string]json.RawMessage{types.ModuleName: cdc.MustMarshalJSON(genState)})
+	require.NoError(t, err)
+
+	builder := txConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(&types.MsgCreatePost{Creator: bob, Title: "Second", Body: "body"}))
+	createTx, err := txConfig.TxEncoder()(builder.GetTx())
+	require.NoError(t, err)
+
+	second := types.Post{Id: 2, Creator: bob, Title: "Second", Body: "body", Slug: "second", Editors: []string{bob}, CreatedAt: at, LastUpdatedAt: at, Version: 1}
+	secondEdited := second
+	secondEdited.Body, secondEdited.Version, secondEdited.Editors = "edited", 2, []string{bob, alice}
+
+	chain := &fakeChain{genesis: &cmttypes.GenesisDoc{InitialHeight: 1, AppState: appState}}
+	chain.addBlock("a", []cmttypes.Tx{createTx}, [][]abci.Event{{postEvent(types.EventTypeCreatePost, 2), {Type: "transfer"}}}, nil,
+		map[uint64]types.Post{1: genesisPost, 2: second})
+	chain.addBlock("a", nil, nil, []abci.Event{postEvent(types.EventTypePurgePost, 1)},
+		map[uint64]types.Post{2: second})
+
+	db, err := openIndexDB(filepath.Join(t.TempDir(), "index.db"))
+	require.NoError(t, err)
+	defer db.Close()
+	ix := &indexer{db: db, source: chain, cdc: cdc, txDecoder: txConfig.TxDecoder(), logf: t.Logf}
+	ctx := context.Background()
+
+	// Test: Genesis posts, messages, events and post revisions are indexed
+	require.NoError(t, ix.catchUp(ctx))
+	require.Equal(t, []string{"2"}, queryStrings(t, db, `SELECT id FROM posts`))
+	require.Equal(t, []string{"0", "2"}, queryStrings(t, db, `SELECT height FROM revisions WHERE post_id = 1 ORDER BY height`))
+	require.Equal(t, []string{"create_post", "purge_post"}, queryStrings(t, db, `SELECT type FROM events ORDER BY height`))
+	require.Equal(t, []string{"/blog.blog.MsgCreatePost"}, queryStrings(t, db, `SELECT type_url FROM msgs`))
+	require.Equal(t, []string{bob}, queryStrings(t, db, `SELECT address FROM editors WHERE post_id = 2`))
+
+	// Test: Catching up again is a no-op
+	require.NoError(t, ix.catchUp(ctx))
+	require.Equal(t, []string{"1", "2"}, queryStrings(t, db, `SELECT height FROM blocks ORDER BY height`))
+
+	// Test: A diverged chain is rolled back to the last common block
+	chain.blocks = chain.blocks[:1]
+	chain.addBlock("b", nil, nil, []abci.Event{postEvent(types.EventTypeUpdatePost, 2)},
+		map[uint64]types.Post{1: genesisPost, 2: secondEdited})
+	chain.addBlock("b", nil, nil, nil, map[uint64]types.Post{1: genesisPost, 2: secondEdited})
+	require.NoError(t, ix.catchUp(ctx))
+	require.Equal(t, []string{"1", "2"}, queryStrings(t, db, `SELECT id FROM posts ORDER BY id`))
+	require.Equal(t, []string{"1", "2"}, queryStrings(t, db, `SELECT version FROM posts ORDER BY id`))
+	require.Equal(t, []string{"create_post", "update_post"}, queryStrings(t, db, `SELECT type FROM events ORDER BY height`))
+	require.ElementsMatch(t, []string{alice, bob}, queryStrings(t, db, `SELECT address FROM editors WHERE post_id = 2`))
+	require.Equal(t, []string{"1", "2", "3"}, queryStrings(t, db, `SELECT height FROM blocks ORDER BY height`))
+	hash, err := indexedBlockHash(ctx, db, 2)
+	require.NoError(t, err)
+	require.Equal(t, chain.blocks[1].block.BlockID.Hash.String(), hash)
+
+	// Test: A node reset to another chain is indexed from genesis again
+	chain.blocks = nil
+	chain.addBlock("c", nil, nil, nil, map[uint64]types.Post{1: genesisPost})
+	require.NoError(t, ix.catchUp(ctx))
+	require.Equal(t, []string{"1"}, queryStrings(t, db, `SELECT height FROM blocks`))
+	require.Equal(t, []string{"1"}, queryStrings(t, db, `SELECT id FROM posts`))
+	require.Empty(t, queryStrings(t, db, `SELECT type FROM events`))
+
+	// Test: Resyncing indexes everything again
+	require.NoError(t, deleteIndexAbove(ctx, db, -1))
+	require.NoError(t, ix.catchUp(ctx))
+	require.Equal(t, []string{"1"}, queryStrings(t, db, `SELECT height FROM blocks`))
+	require.Equal(t, []string{"0"}, queryStrings(t, db, `SELECT height FROM revisions`))
+}
@@ -0,0 +1,316 @@
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"gopkg.in/yaml.v3"
+
+	"blog/x/blog/types"
+)
+
+const (
+	postFormatJSONL    = "jsonl"
+	postFormatMarkdown = "markdown"
+
+	frontMatterDelimiter = "---\n"
+)
+
+// postFrontMatter is the YAML front matter of an exported Markdown post. The
+// body of the post follows the front matter verbatim.
+type postFrontMatter struct {
+	ID            uint64                `yaml:"id"`
+	Title         string                `yaml:"title"`
+	Slug          string                `yaml:"slug,omitempty"`
+	BodyFormat    string                `yaml:"body_format,omitempty"`
+	Summary       string                `yaml:"summary,omitempty"`
+	Tags          []string              `yaml:"tags,omitempty"`
+	Price         string                `yaml:"price,omitempty"`
+	BodyURI       string                `yaml:"body_uri,omitempty"`
+	KeyEnvelopes  []frontMatterEnvelope `yaml:"key_envelopes,omitempty"`
+	Creator       string                `yaml:"creator"`
+	Editors       []string              `yaml:"editors,omitempty"`
+	CreatedAt     time.Time             `yaml:"created_at"`
+	LastUpdatedAt time.Time             `yaml:"last_updated_at"`
+	Version       uint64                `yaml:"version"`
+}
+
+// frontMatterEnvelope is a key envelope in front matter, its keys in base64
+type frontMatterEnvelope struct {
+	Reader          string `yaml:"reader"`
+	ReaderPubKey    string `yaml:"reader_pub_key"`
+	EphemeralPubKey string `yaml:"ephemeral_pub_key"`
+	SealedKey       string `yaml:"sealed_key"`
+}
+
+// writeJSONLPost writes post as one line of proto JSON
+func writeJSONLPost(w io.Writer, cdc codec.JSONCodec, post *types.Post) error {
+	bz, err := cdc.MarshalJSON(post)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(bz, '\n'))
+	return err
+}
+
+// readJSONLPosts reads the posts of a JSONL file, skipping blank lines
+func readJSONLPosts(r io.Reader, cdc codec.JSONCodec) ([]types.Post, error) {
+	var posts []types.Post
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 64<<20)
+	for line := 1; scanner.Scan(); line++ {
+		bz := bytes.TrimSpace(scanner.Bytes())
+		if len(bz) == 0 {
+			continue
+		}
+		var post types.Post
+		if err := cdc.UnmarshalJSON(bz, &post); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		posts = append(posts, post)
+	}
+	return posts, scanner.Err()
+}
+
+// markdownPostFileName returns the file name of an exported Markdown post,
+// which sorts in ID order
+func markdownPostFileName(post types.Post) string {
+	name := post.Slug
+	if name == "" {
+		name = types.SlugFromTitle(post.Title)
+	}
+	return fmt.Sprintf("%08d-%s.md", post.Id, name)
+}
+
+// formatMarkdownPost renders post as Markdown with front matter
+func formatMarkdownPost(post types.Post) ([]byte, error) {
+	var envelopes []frontMatterEnvelope
+	for _, env := range post.KeyEnvelopes {
+		envelopes = append(envelopes, frontMatterEnvelope{
+			Reader:          env.Reader,
+			ReaderPubKey:    base64.StdEncoding.EncodeToString(env.ReaderPubKey),
+			EphemeralPubKey: base64.StdEncoding.EncodeToString(env.EphemeralPubKey),
+			SealedKey:       base64.StdEncoding.EncodeToString(env.SealedKey),
+		})
+	}
+	frontMatter, err := yaml.Marshal(postFrontMatter{
+		ID:            post.Id,
+		Title:         post.Title,
+		Slug:          post.Slug,
+		BodyFormat:    bodyFormatName(post.BodyFormat),
+		Summary:       post.Summary,
+		Tags:          post.Tags,
+		Price:         post.Price.String(),
+		BodyURI:       post.BodyUri,
+		KeyEnvelopes:  envelopes,
+		Creator:       post.Creator,
+		Editors:       post.Editors,
+		CreatedAt:     post.CreatedAt.UTC(),
+		LastUpdatedAt: post.LastUpdatedAt.UTC(),
+		Version:       post.Version,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	buf.WriteString(frontMatterDelimiter)
+	buf.Write(frontMatter)
+	buf.WriteString(frontMatterDelimiter)
+	buf.WriteString(post.Body)
+	return buf.Bytes(), nil
+}
+
+// parseMarkdownPost parses a post rendered by formatMarkdownPost
+func parseMarkdownPost(bz []byte) (types.Post, error) {
+	s := strings.ReplaceAll(string(bz), "\r\n", "\n")
+	if !strings.HasPrefix(s, frontMatterDelimiter) {
+		return types.Post{}, fmt.Errorf("missing front matter")
+	}
+	s = s[len(frontMatterDelimiter):]
+	end := strings.Index(s, "\n"+frontMatterDelimiter)
+	if end < 0 {
+		return types.Post{}, fmt.Errorf("unterminated front matter")
+	}
+
+	var frontMatter postFrontMatter
+	if err := yaml.Unmarshal([]byte(s[:end+1]), &frontMatter); err != nil {
+		return types.Post{}, fmt.Errorf("invalid front matter: %w", err)
+	}
+	bodyFormat, err := parseBodyFormat(frontMatter.BodyFormat)
+	if err != nil {
+		return types.Post{}, fmt.Errorf("invalid front matter: %w", err)
+	}
+	var price sdk.Coins
+	if frontMatter.Price != "" {
+		if price, err = sdk.ParseCoinsNormalized(frontMatter.Price); err != nil {
+			return types.Post{}, fmt.Errorf("invalid front matter: price: %w", err)
+		}
+	}
+	var envelopes []types.KeyEnvelope
+	for i, env := range frontMatter.KeyEnvelopes {
+		envelope := types.KeyEnvelope{Reader: env.Reader}
+		for _, field := range []struct {
+			dst *[]byte
+			src string
+		}{
+			{&envelope.ReaderPubKey, env.ReaderPubKey},
+			{&envelope.EphemeralPubKey, env.EphemeralPubKey},
+			{&envelope.SealedKey, env.SealedKey},
+		} {
+			if *field.dst, err = base64.StdEncoding.DecodeString(field.src); err != nil {
+				return types.Post{}, fmt.Errorf("invalid front matter: key_envelopes[%d]: %w", i, err)
+			}
+		}
+		envelopes = append(envelopes, envelope)
+	}
+	return types.Post{
+		Id:            frontMatter.ID,
+		Title:         frontMatter.Title,
+		Slug:          frontMatter.Slug,
+		BodyFormat:    bodyFormat,
+		Summary:       frontMatter.Summary,
+		Tags:          frontMatter.Tags,
+		Price:         price,
+		BodyUri:       frontMatter.BodyURI,
+		KeyEnvelopes:  envelopes,
+		Creator:       frontMatter.Creator,
+		Editors:       frontMatter.Editors,
+		CreatedAt:     frontMatter.CreatedAt,
+		LastUpdatedAt: frontMatter.LastUpdatedAt,
+		Version:       frontMatter.Version,
+		Body:          s[end+1+len(frontMatterDelimiter):],
+	}, nil
+}
+
+// bodyFormatName returns the front matter name of a body format, empty for
+// plain bodies: "markdown" for BODY_FORMAT_MARKDOWN
+func bodyFormatName(format types.BodyFormat) string {
+	if format == types.BodyFormat_BODY_FORMAT_PLAIN {
+		return ""
+	}
+	return strings.ToLower(strings.ReplaceAll(strings.TrimPrefix(format.String(), "BODY_FORMAT_"), "_", "-"))
+}
+
+// parseBodyFormat parses a body format name written by bodyFormatName
+func parseBodyFormat(name string) (types.BodyFormat, error) {
+	if name == "" {
+		return types.BodyFormat_BODY_FORMAT_PLAIN, nil
+	}
+	value, ok := types.BodyFormat_value["BODY_FORMAT_"+strings.ToUpper(strings.ReplaceAll(name, "-", "_"))]
+	if !ok {
+		return 0, fmt.Errorf("unknown body_format %q", name)
+	}
+	return types.BodyFormat(value), nil
+}
+
+// readPostFiles reads the posts to import from path, which is either a JSONL
+// file, a Markdown file or a directory of Markdown files. The posts of a
+// directory are returned in ID order.
+func readPostFiles(path string, cdc codec.JSONCodec) ([]types.Post, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if !info.IsDir() {
+		if isMarkdownFile(path) {
+			bz, err := os.ReadFile(path)
+			if err != nil {
+				return nil, err
+			}
+			post, err := parseMarkdownPost(bz)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", path, err)
+			}
+			return []types.Post{post}, nil
+		}
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		posts, err := readJSONLPosts(f, cdc)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		return posts, nil
+	}
+
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+	var posts []types.Post
+	for _, entry := range entries {
+		if entry.IsDir() || !isMarkdownFile(entry.Name()) {
+			continue
+		}
+		file := filepath.Join(path, entry.Name())
+		bz, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		post, err := parseMarkdownPost(bz)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", file, err)
+		}
+		posts = append(posts, post)
+	}
+	sort.SliceStable(posts, func(i, j int) bool { return posts[i].Id < posts[j].Id })
+	return posts, nil
+}
+
+func isMarkdownFile(path string) bool {
+	ext := strings.ToLower(filepath.Ext(path))
+	return ext == ".md" || ext == ".markdown"
+}
+
+// importCheckpoint records the progress of an import. PendingTxHash is the
+// hash of a broadcast tx whose inclusion was not confirmed, carrying the next
+// PendingCount posts.
+type importCheckpoint struct {
+	Imported      int    `json:"imported"`
+	PendingTxHash string `json:"pending_tx_hash,omitempty"`
+	PendingCount  int    `json:"pending_count,omitempty"`
+}
+
+// readImportCheckpoint reads a checkpoint, returning an empty one if the file
+// does not exist
+func readImportCheckpoint(path string) (importCheckpoint, error) {
+	var checkpoint importCheckpoint
+	bz, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return checkpoint, nil
+	}
+	if err != nil {
+		return checkpoint, err
+	}
+	if err := json.Unmarshal(bz, &checkpoint); err != nil {
+		return checkpoint, fmt.Errorf("invalid checkpoint %s: %w", path, err)
+	}
+	return checkpoint, nil
+}
+
+// writeImportCheckpoint replaces the checkpoint file atomically
+func writeImportCheckpoint(path string, checkpoint importCheckpoint) error {
+	bz, err := json.Marshal(checkpoint)
+	if err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, bz, 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
@@ -0,0 +1,187 @@
+package cmd
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"blog/testutil/nullify"
+	"blog/testutil/sample"
+	"blog/x/blog/types"
+)
+
+func samplePosts(t *testing.T) []types.Post {
+	creator := sample.AccAddress()
+	at := time.Date(2024, 5, 1, 12, 30, 0, 0, time.UTC)
+
+	owner := secp256k1.GenPrivKey()
+	ownerAddr := sdk.AccAddress(owner.PubKey().Address()).String()
+	key, err := types.NewContentKey()
+	require.NoError(t, err)
+	body, err := types.EncryptBody(key, "the secret sauce")
+	require.NoError(t, err)
+	env, err := types.SealContentKey(ownerAddr, owner.PubKey().Bytes(), key)
+	require.NoError(t, err)
+
+	return []types.Post{
+		{
+			Id:            1,
+			Creator:       creator,
+			Title:         "Hello: world",
+			Body:          "# Hello\n\n---\nbody with a delimiter\n",
+			BodyFormat:    types.BodyFormat_BODY_FORMAT_MARKDOWN,
+			Slug:          "hello-world",
+			Editors:       []string{creator},
+			CreatedAt:     at,
+			LastUpdatedAt: at.Add(time.Hour),
+			Version:       3,
+		},
+		{
+			Id:            2,
+			Creator:       creator,
+			Title:         "Second",
+			Body:          "no trailing newline",
+			Slug:          "second",
+			Tags:          []string{"cosmos", "news"},
+			Editors:       []string{creator},
+			CreatedAt:     at,
+			LastUpdatedAt: at,
+			Version:       1,
+		},
+		{
+			Id:            3,
+			Creator:       ownerAddr,
+			Title:         "Recipes",
+			Body:          body,
+			BodyFormat:    types.BodyFormat_BODY_FORMAT_ENCRYPTED,
+			Slug:          "recipes",
+			Summary:       "grandma cooking",
+			Tags:          []string{"food"},
+			Price:         sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+			BodyUri:       "ipfs://bafyrecipes",
+			KeyEnvelopes:  []types.KeyEnvelope{env},
+			Editors:       []string{ownerAddr},
+			CreatedAt:     at,
+			LastUpdatedAt: at,
+			Version:       2,
+		},
+	}
+}
+
+func TestJSONLPostsRoundTrip(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	posts := samplePosts(t)
+
+	var buf bytes.Buffer
+	for i := range posts {
+		require.NoError(t, writeJSONLPost(&buf, cdc, &posts[i]))
+	}
+	buf.WriteString("\n")
+
+	got, err := readJSONLPosts(&buf, cdc)
+	require.NoError(t, err)
+	require.Equal(t, nullify.Fill(posts), nullify.Fill(got))
+}
+
+func TestMarkdownPostsRoundTrip(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	posts := samplePosts(t)
+
+	dir := t.TempDir()
+	// write in reverse to check that posts are read back in ID order
+	for i := len(posts) - 1; i >= 0; i-- {
+		bz, err := formatMarkdownPost(posts[i])
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(filepath.Join(dir, markdownPostFileName(posts[i])), bz, 0o644))
+	}
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "notes.txt"), []byte("ignored"), 0o644))
+
+	got, err := readPostFiles(dir, cdc)
+	require.NoError(t, err)
+	require.Equal(t, posts, got)
+
+	got, err = readPostFiles(filepath.Join(dir, markdownPostFileName(posts[0])), cdc)
+	require.NoError(t, err)
+	require.Equal(t, posts[:1], got)
+}
+
+func TestParseMarkdownPostErrors(t *testing.T) {
+	_, err := parseMarkdownPost([]byte("no front matter"))
+	require.ErrorContains(t, err, "missing front matter")
+
+	_, err = parseMarkdownPost([]byte("---\ntitle: x\n"))
+	require.ErrorContains(t, err, "unterminated front matter")
+
+	_, err = parseMarkdownPost([]byte("---\ntitle: x\nbody_format: rst\n---\n"))
+	require.ErrorContains(t, err, `unknown body_format "rst"`)
+}
+
+func TestImportCheckpoint(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "posts.checkpoint")
+
+	checkpoint, err := readImportCheckpoint(path)
+	require.NoError(t, err)
+	require.Equal(t, importCheckpoint{}, checkpoint)
+
+	want := importCheckpoint{Imported: 20, PendingTxHash: "ABCD", PendingCount: 10}
+	require.NoError(t, writeImportCheckpoint(path, want))
+	checkpoint, err = readImportCheckpoint(path)
+	require.NoError(t, err)
+	require.Equal(t, want, checkpoint)
+}
+
+func TestImportPostMsgs(t *testing.T) {
+	posts := samplePosts(t)
+	creator := posts[2].Creator
+
+	msgs := make([]sdk.Msg, len(posts))
+	for i, post := range posts {
+		msg, err := importPostMsg(creator, post, false)
+		require.NoError(t, err)
+		msgs[i] = msg
+	}
+
+	// Test: Plain posts are created by one batch and encrypted ones on their own
+	merged := mergePostOps(msgs)
+	require.Len(t, merged, 2)
+	batch, ok := merged[0].(*types.MsgBatchPostOps)
+	require.True(t, ok)
+	require.Equal(t, creator, batch.Creator)
+	require.Equal(t, []types.PostOp{
+		{OpType: types.PostOpType_POST_OP_TYPE_CREATE, Title: posts[0].Title, Body: posts[0].Body, BodyFormat: posts[0].BodyFormat, Slug: posts[0].Slug},
+		{OpType: types.PostOpType_POST_OP_TYPE_CREATE, Title: posts[1].Title, Body: posts[1].Body, Slug: posts[1].Slug, Tags: posts[1].Tags},
+	}, batch.Ops)
+	require.Len(t, msgs[0].(*types.MsgBatchPostOps).Ops, 1, "merging leaves the messages of the posts unchanged")
+
+	// Test: Encrypted posts keep their summary, price, body URI, tags and envelopes
+	private, ok := merged[1].(*types.MsgCreatePrivatePost)
+	require.True(t, ok)
+	require.Equal(t, &types.MsgCreatePrivatePost{
+		Creator:      creator,
+		Title:        posts[2].Title,
+		Body:         posts[2].Body,
+		Slug:         posts[2].Slug,
+		KeyEnvelopes: posts[2].KeyEnvelopes,
+		Summary:      posts[2].Summary,
+		Price:        posts[2].Price,
+		BodyUri:      posts[2].BodyUri,
+		Tags:         posts[2].Tags,
+	}, private)
+
+	// Test: Derived slugs are left empty
+	msg, err := importPostMsg(creator, posts[2], true)
+	require.NoError(t, err)
+	require.Empty(t, msg.(*types.MsgCreatePrivatePost).Slug)
+
+	// Test: The importer must hold an envelope of the encrypted posts
+	_, err = importPostMsg(posts[0].Creator, posts[2], false)
+	require.ErrorIs(t, err, types.ErrInvalidKeyEnvelope)
+}
@@ -0,0 +1,546 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/spf13/cobra"
+
+	"blog/x/blog/types"
+)
+
+const (
+	flagRotate   = "rotate"
+	flagPrice    = "price"
+	flagSummary  = "summary"
+	flagBodyURI  = "body-uri"
+	flagBodyOut  = "body-out"
+	flagBodyFile = "body-file"
+	flagTags     = "tags"
+)
+
+// blogTxCommand returns the `blogd tx blog` command with the private post
+// commands, which encrypt on the client. autocli adds the generated tx
+// commands to it.
+func blogTxCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Transactions commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         false,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		createPrivatePostCmd(),
+		grantReadAccessCmd(),
+		fulfillPurchasesCmd(),
+		revokeReadAccessCmd(),
+	)
+
+	return cmd
+}
+
+// blogQueryCommand returns the `blogd query blog` command with decrypt-post.
+// autocli adds the generated query commands to it.
+func blogQueryCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         false,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(decryptPostCmd())
+
+	return cmd
+}
+
+func createPrivatePostCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-private-post [title] [body] [reader]...",
+		Short: "Create a post whose body is encrypted for the --from account and the given readers",
+		Long: `Create a post whose body is encrypted with a new content key. The content key
+is wrapped in a key envelope for the --from account and for each reader, given
+as a key name of the keyring or as an address. The public key of an address not
+in the keyring is read from its account, so it must have signed a tx before.
+
+The title and the --summary stay public and are the only parts of the post that
+are searchable; the --tags are public too. With a --price, the post is for sale: buyers pay the price to
+the creator, who then grants them access with fulfill-purchases.
+
+With a --body-uri, the ciphertext is written to the --body-out file instead of
+being stored on chain, to be uploaded to the URI.`,
+		Example: `blogd tx blog create-private-post "Draft" "For your eyes only" bob --from alice --chain-id blog
+blogd tx blog create-private-post "Guide" "$(cat guide.md)" --summary "A paid guide" --price 10stake --from alice --chain-id blog
+blogd tx blog create-private-post "Book" "$(cat book.md)" --body-uri ipfs://bafy... --body-out book.enc --price 10stake --from alice --chain-id blog`,
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			key, err := types.NewContentKey()
+			if err != nil {
+				return err
+			}
+			body, err := types.EncryptBody(key, args[1])
+			if err != nil {
+				return err
+			}
+
+			readers := append([]string{clientCtx.GetFromAddress().String()}, args[2:]...)
+			envelopes := make([]types.KeyEnvelope, 0, len(readers))
+			for _, reader := range readers {
+				addr, pubKey, err := readerPubKey(clientCtx, reader)
+				if err != nil {
+					return err
+				}
+				if _, found := types.KeyEnvelopeOf(envelopes, addr); found {
+					continue
+				}
+				env, err := types.SealContentKey(addr, pubKey, key)
+				if err != nil {
+					return err
+				}
+				envelopes = append(envelopes, env)
+			}
+
+			msg := types.NewMsgCreatePrivatePost(clientCtx.GetFromAddress().String(), args[0], body, envelopes)
+			if msg.Body, msg.BodyUri, err = storeEncryptedBody(cmd, body); err != nil {
+				return err
+			}
+			msg.Summary, _ = cmd.Flags().GetString(flagSummary)
+			msg.Tags, _ = cmd.Flags().GetStringSlice(flagTags)
+			if price, _ := cmd.Flags().GetString(flagPrice); price != "" {
+				if msg.Price, err = sdk.ParseCoinsNormalized(price); err != nil {
+					return fmt.Errorf("invalid price %q: %w", price, err)
+				}
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagSummary, "", "Public summary of the post")
+	cmd.Flags().StringSlice(flagTags, nil, "Comma-separated public tags of the post")
+	cmd.Flags().String(flagPrice, "", "Price buyers pay for access to the post, e.g. 10stake")
+	addBodyURIFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func grantReadAccessCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-read-access [id] [reader]",
+		Short: "Let a reader decrypt an encrypted post of the --from account",
+		Long: `Unwrap the content key of an encrypted post from the envelope of the --from
+account and wrap it for the reader, given as a key name of the keyring or as an
+address whose account has signed a tx before.`,
+		Example: `blogd tx blog grant-read-access 3 bob --from alice --chain-id blog`,
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid post ID %q: %w", args[0], err)
+			}
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			post, key, err := openPost(cmd, clientCtx, id)
+			if err != nil {
+				return err
+			}
+			reader, pubKey, err := readerPubKey(clientCtx, args[1])
+			if err != nil {
+				return err
+			}
+			if _, found := types.KeyEnvelopeOf(post.KeyEnvelopes, reader); found {
+				return fmt.Errorf("%s can already read post %d", reader, id)
+			}
+			env, err := types.SealContentKey(reader, pubKey, key)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgGrantReadAccess(clientCtx.GetFromAddress().String(), id, env)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func fulfillPurchasesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fulfill-purchases [id]",
+		Short: "Grant read access to every purchaser of a post of the --from account lacking it",
+		Long: `Unwrap the content key of an encrypted post from the envelope of the --from
+account and wrap it for every purchaser without an envelope, granting them all in
+one tx, up to the max_tx_msgs param. Purchasers have signed a tx, so their
+public keys are known.`,
+		Example: `blogd tx blog fulfill-purchases 3 --from alice --chain-id blog`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid post ID %q: %w", args[0], err)
+			}
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			post, key, err := openPost(cmd, clientCtx, id)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			var msgs []sdk.Msg
+			var pageKey []byte
+			for {
+				res, err := queryClient.PostPurchasers(cmd.Context(), &types.QueryPostPurchasersRequest{Id: id, Pagination: &query.PageRequest{Key: pageKey}})
+				if err != nil {
+					return err
+				}
+				for _, purchaser := range res.Purchasers {
+					if _, found := types.KeyEnvelopeOf(post.KeyEnvelopes, purchaser); found {
+						continue
+					}
+					_, pubKey, err := readerPubKey(clientCtx, purchaser)
+					if err != nil {
+						return err
+					}
+					env, err := types.SealContentKey(purchaser, pubKey, key)
+					if err != nil {
+						return err
+					}
+					msg := types.NewMsgGrantReadAccess(clientCtx.GetFromAddress().String(), id, env)
+					if err := msg.ValidateBasic(); err != nil {
+						return err
+					}
+					msgs = append(msgs, msg)
+				}
+				if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+					break
+				}
+				pageKey = res.Pagination.NextKey
+			}
+
+			if len(msgs) == 0 {
+				return clientCtx.PrintString("every purchaser can already read the post\n")
+			}
+			// txs carry at most max_tx_msgs blog messages
+			params, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+			if maxMsgs := int(params.Params.MaxTxMsgs); maxMsgs > 0 && len(msgs) > maxMsgs {
+				fmt.Fprintf(cmd.ErrOrStderr(), "granting %d of %d purchasers, run the command again for the others\n", maxMsgs, len(msgs))
+				msgs = msgs[:maxMsgs]
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msgs...)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func revokeReadAccessCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-read-access [id] [reader]",
+		Short: "Remove the key envelope of a reader from an encrypted post of the --from account",
+		Long: `Remove the key envelope of a reader from an encrypted post. Since the reader
+may have kept the content key, the body is by default encrypted again with a new
+content key, wrapped for every remaining reader with the public key of its
+envelope. Pass --rotate=false to only remove the envelope. The ciphertext of a
+body stored off chain is read from --body-file, and the new one is written to
+--body-out for the new --body-uri.
+
+Past versions of the body remain readable with the old key in the history of
+the chain.`,
+		Example: `blogd tx blog revoke-read-access 3 bob --from alice --chain-id blog`,
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid post ID %q: %w", args[0], err)
+			}
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			reader, err := readerAddress(clientCtx, args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeReadAccess(clientCtx.GetFromAddress().String(), id, reader)
+			if rotate, _ := cmd.Flags().GetBool(flagRotate); rotate {
+				post, key, err := openPost(cmd, clientCtx, id)
+				if err != nil {
+					return err
+				}
+				sealed, err := encryptedBody(cmd, post)
+				if err != nil {
+					return err
+				}
+				body, err := types.DecryptBody(key, sealed)
+				if err != nil {
+					return err
+				}
+				if key, err = types.NewContentKey(); err != nil {
+					return err
+				}
+				if sealed, err = types.EncryptBody(key, body); err != nil {
+					return err
+				}
+				if msg.Body, msg.BodyUri, err = storeEncryptedBody(cmd, sealed); err != nil {
+					return err
+				}
+				for _, env := range post.KeyEnvelopes {
+					if env.Reader == reader {
+						continue
+					}
+					rotated, err := types.SealContentKey(env.Reader, env.ReaderPubKey, key)
+					if err != nil {
+						return err
+					}
+					msg.KeyEnvelopes = append(msg.KeyEnvelopes, rotated)
+				}
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(flagRotate, true, "Encrypt the body again with a new content key for the remaining readers")
+	cmd.Flags().String(flagBodyFile, "", "File holding the ciphertext of a body stored off chain")
+	addBodyURIFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func decryptPostCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "decrypt-post [id]",
+		Short: "Print the body of an encrypted post the --from account can read",
+		Long: `Print the body of an encrypted post the --from account can read. The
+ciphertext of a body stored off chain, at the body_uri of the post, is read
+from --body-file.`,
+		Example: `blogd q blog decrypt-post 3 --from bob
+blogd q blog decrypt-post 4 --from bob --body-file book.enc`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid post ID %q: %w", args[0], err)
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			// query contexts do not resolve --from
+			from, _ := cmd.Flags().GetString(flags.FlagFrom)
+			if from == "" {
+				return errors.New("--from is required to decrypt a post")
+			}
+			addr, name, _, err := client.GetFromFields(clientCtx, clientCtx.Keyring, from)
+			if err != nil {
+				return err
+			}
+			clientCtx = clientCtx.WithFromAddress(addr).WithFromName(name)
+
+			post, key, err := openPost(cmd, clientCtx, id)
+			if err != nil {
+				return err
+			}
+			sealed, err := encryptedBody(cmd, post)
+			if err != nil {
+				return err
+			}
+			body, err := types.DecryptBody(key, sealed)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintString(body + "\n")
+		},
+	}
+
+	cmd.Flags().String(flags.FlagFrom, "", "Name or address of the key reading the post")
+	cmd.Flags().String(flagBodyFile, "", "File holding the ciphertext of a body stored off chain")
+	cmd.Flags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test|memory)")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// addBodyURIFlags adds the flags storing the ciphertext of a body off chain
+func addBodyURIFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagBodyURI, "", "https, ipfs or ar URI the ciphertext of the body is uploaded to, instead of storing it on chain")
+	cmd.Flags().String(flagBodyOut, "", "File the ciphertext of the body is written to with --body-uri")
+}
+
+// storeEncryptedBody returns the body and body URI of a message carrying the
+// ciphertext sealed. With --body-uri, the ciphertext is written to the
+// --body-out file and the body is left empty.
+func storeEncryptedBody(cmd *cobra.Command, sealed string) (string, string, error) {
+	uri, _ := cmd.Flags().GetString(flagBodyURI)
+	if uri == "" {
+		return sealed, "", nil
+	}
+	out, _ := cmd.Flags().GetString(flagBodyOut)
+	if out == "" {
+		return "", "", fmt.Errorf("--%s is required with --%s", flagBodyOut, flagBodyURI)
+	}
+	if err := os.WriteFile(out, []byte(sealed), 0o600); err != nil {
+		return "", "", err
+	}
+	fmt.Fprintf(cmd.ErrOrStderr(), "upload %s to %s\n", out, uri)
+	return "", uri, nil
+}
+
+// encryptedBody returns the ciphertext of the body of post, read from the
+// --body-file when it is stored off chain
+func encryptedBody(cmd *cobra.Command, post types.Post) (string, error) {
+	if post.Body != "" {
+		return post.Body, nil
+	}
+	file, _ := cmd.Flags().GetString(flagBodyFile)
+	if file == "" {
+		return "", fmt.Errorf("the body of post %d is stored at %s, download it and pass --%s", post.Id, post.BodyUri, flagBodyFile)
+	}
+	bz, err := os.ReadFile(file)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(bz)), nil
+}
+
+// openPost queries an encrypted post and unwraps its content key from the
+// envelope of the --from account
+func openPost(cmd *cobra.Command, clientCtx client.Context, id uint64) (types.Post, []byte, error) {
+	res, err := types.NewQueryClient(clientCtx).ShowPost(cmd.Context(), &types.QueryShowPostRequest{Id: id})
+	if err != nil {
+		return types.Post{}, nil, err
+	}
+	post := res.Post
+	if post.BodyFormat != types.BodyFormat_BODY_FORMAT_ENCRYPTED {
+		return types.Post{}, nil, fmt.Errorf("post %d is not encrypted", id)
+	}
+
+	env, found := types.KeyEnvelopeOf(post.KeyEnvelopes, clientCtx.GetFromAddress().String())
+	if !found {
+		return types.Post{}, nil, fmt.Errorf("%s cannot read post %d", clientCtx.GetFromAddress(), id)
+	}
+	privKey, err := exportPrivKey(clientCtx, clientCtx.GetFromName())
+	if err != nil {
+		return types.Post{}, nil, err
+	}
+	key, err := types.OpenContentKey(env, privKey)
+	if err != nil {
+		return types.Post{}, nil, err
+	}
+	return post, key, nil
+}
+
+// exportPrivKey returns the secp256k1 private key of a keyring entry. Keys held
+// off the keyring, such as Ledger keys, cannot open envelopes.
+func exportPrivKey(clientCtx client.Context, name string) ([]byte, error) {
+	// the armor is only held in memory, so its passphrase does not matter
+	const passphrase = "blog-key-envelope"
+	armor, err := clientCtx.Keyring.ExportPrivKeyArmor(name, passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("exporting the private key of %s: %w", name, err)
+	}
+	privKey, _, err := crypto.UnarmorDecryptPrivKey(armor, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	key, ok := privKey.(*secp256k1.PrivKey)
+	if !ok {
+		return nil, fmt.Errorf("key %s is a %s key, only secp256k1 keys can open envelopes", name, privKey.Type())
+	}
+	return key.Key, nil
+}
+
+// readerPubKey resolves a reader given as a key name or an address to its
+// address and compressed secp256k1 public key, looking up the keyring first
+// and the account of the address otherwise
+func readerPubKey(clientCtx client.Context, reader string) (string, []byte, error) {
+	addr, err := readerAddress(clientCtx, reader)
+	if err != nil {
+		return "", nil, err
+	}
+	accAddr := sdk.MustAccAddressFromBech32(addr)
+
+	if record, err := clientCtx.Keyring.KeyByAddress(accAddr); err == nil {
+		pubKey, err := record.GetPubKey()
+		if err != nil {
+			return "", nil, err
+		}
+		return checkReaderPubKey(addr, pubKey.Type(), pubKey.Bytes())
+	}
+
+	account, err := clientCtx.AccountRetriever.GetAccount(clientCtx, accAddr)
+	if err != nil {
+		return "", nil, fmt.Errorf("reading the account of %s: %w", addr, err)
+	}
+	pubKey := account.GetPubKey()
+	if pubKey == nil {
+		return "", nil, fmt.Errorf("the public key of %s is unknown until it signs a tx", addr)
+	}
+	return checkReaderPubKey(addr, pubKey.Type(), pubKey.Bytes())
+}
+
+func checkReaderPubKey(addr string, keyType string, pubKey []byte) (string, []byte, error) {
+	if keyType != (&secp256k1.PubKey{}).Type() {
+		return "", nil, fmt.Errorf("%s has a %s key, only secp256k1 keys can read encrypted posts", addr, keyType)
+	}
+	return addr, pubKey, nil
+}
+
+// readerAddress resolves a reader given as a key name or an address
+func readerAddress(clientCtx client.Context, reader string) (string, error) {
+	if _, err := sdk.AccAddressFromBech32(reader); err == nil {
+		return reader, nil
+	}
+	record, err := clientCtx.Keyring.Key(reader)
+	if err != nil {
+		return "", fmt.Errorf("%q is neither an address nor a key name: %w", reader, err)
+	}
+	addr, err := record.GetAddress()
+	if err != nil {
+		return "", err
+	}
+	return addr.String(), nil
+}
@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// BankKeeper is an in-memory stand-in for the bank keeper holding balances
+// by address
+type BankKeeper struct {
+	balances map[string]sdk.Coins
+}
+
+func NewBankKeeper() *BankKeeper {
+	return &BankKeeper{balances: make(map[string]sdk.Coins)}
+}
+
+// Fund adds coins to the balance of addr
+func (b *BankKeeper) Fund(addr sdk.AccAddress, coins sdk.Coins) {
+	b.balances[addr.String()] = b.balances[addr.String()].Add(coins...)
+}
+
+func (b *BankKeeper) SpendableCoins(_ context.Context, addr sdk.AccAddress) sdk.Coins {
+	return b.balances[addr.String()]
+}
+
+func (b *BankKeeper) GetBalance(_ context.Context, addr sdk.AccAddress, denom string) sdk.Coin {
+	return sdk.NewCoin(denom, b.balances[addr.String()].AmountOf(denom))
+}
+
+func (b *BankKeeper) SendCoins(_ context.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	balance, hasNeg := b.balances[fromAddr.String()].SafeSub(amt...)
+	if hasNeg {
+		return errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, "%s is smaller than %s", b.balances[fromAddr.String()], amt)
+	}
+	b.balances[fromAddr.String()] = balance
+	b.balances[toAddr.String()] = b.balances[toAddr.String()].Add(amt...)
+	return nil
+}
+
+func (b *BankKeeper) SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	return b.SendCoins(ctx, senderAddr, authtypes.NewModuleAddress(recipientModule), amt)
+}
+
+func (b *BankKeeper) SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	return b.SendCoins(ctx, authtypes.NewModuleAddress(senderModule), recipientAddr, amt)
+}
@@ -22,6 +22,24 @@ import (
 )
 
 func BlogKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
+	k, _, ctx := BlogKeeperWithBank(t)
+	return k, ctx
+}
+
+// BlogKeeperWithBank returns a blog keeper backed by an in-memory bank keeper
+func BlogKeeperWithBank(t testing.TB) (keeper.Keeper, *BankKeeper, sdk.Context) {
+	k, bank, _, ctx := blogKeeper(t)
+	return k, bank, ctx
+}
+
+// BlogKeeperWithStoreKey returns a blog keeper along with the key of its
+// store, for tests that write the store directly
+func BlogKeeperWithStoreKey(t testing.TB) (keeper.Keeper, storetypes.StoreKey, sdk.Context) {
+	k, _, storeKey, ctx := blogKeeper(t)
+	return k, storeKey, ctx
+}
+
+func blogKeeper(t testing.TB) (keeper.Keeper, *BankKeeper, storetypes.StoreKey, sdk.Context) {
 	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
 
 	db := dbm.NewMemDB()
@@ -32,12 +50,14 @@ func BlogKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
 	registry := codectypes.NewInterfaceRegistry()
 	cdc := codec.NewProtoCodec(registry)
 	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+	bank := NewBankKeeper()
 
 	k := keeper.NewKeeper(
 		cdc,
 		runtime.NewKVStoreService(storeKey),
 		log.NewNopLogger(),
 		authority.String(),
+		bank,
 	)
 
 	ctx := sdk.NewContext(stateStore, cmtproto.Header{}, false, log.NewNopLogger())
@@ -47,5 +67,5 @@ func BlogKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
 		panic(err)
 	}
 
-	return k, ctx
+	return k, bank, storeKey, ctx
 }
@@ -0,0 +1,165 @@
+package ante
+
+import (
+	"context"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"blog/x/blog/types"
+)
+
+// blogMsgTypeURLPrefix prefixes the type URL of every blog message.
+const blogMsgTypeURLPrefix = "/blog.blog."
+
+// ParamsKeeper defines the blog keeper methods the decorator needs.
+type ParamsKeeper interface {
+	GetParams(ctx context.Context) types.Params
+}
+
+// nestedMsgs is implemented by messages wrapping other messages, such as
+// authz MsgExec, so that wrapped blog messages are checked as well.
+type nestedMsgs interface {
+	GetMessages() ([]sdk.Msg, error)
+}
+
+// BlogDecorator enforces the blog tx limits of the params before the messages
+// of a tx are executed: the number of blog messages per tx, the size of post
+// bodies and the minimum fee of txs creating posts.
+type BlogDecorator struct {
+	keeper ParamsKeeper
+}
+
+// NewBlogDecorator returns a BlogDecorator reading the limits from keeper
+func NewBlogDecorator(keeper ParamsKeeper) BlogDecorator {
+	return BlogDecorator{keeper: keeper}
+}
+
+func (d BlogDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	msgs, err := blogMsgs(tx.GetMsgs())
+	if err != nil {
+		return ctx, err
+	}
+	if len(msgs) == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	params := d.keeper.GetParams(ctx)
+
+	if params.MaxTxMsgs != 0 && uint64(len(msgs)) > params.MaxTxMsgs {
+		return ctx, errorsmod.Wrapf(types.ErrTooManyMsgs, "tx carries %d blog messages, the limit is %d", len(msgs), params.MaxTxMsgs)
+	}
+
+	createsPost := false
+	for _, msg := range msgs {
+		if params.MaxBodyBytes != 0 {
+			for _, body := range postBodies(msg) {
+				if uint64(len(body)) > params.MaxBodyBytes {
+					return ctx, errorsmod.Wrapf(types.ErrBodyTooLarge, "%s carries a body of %d bytes, the limit is %d", sdk.MsgTypeURL(msg), len(body), params.MaxBodyBytes)
+				}
+			}
+		}
+		createsPost = createsPost || isPostCreation(msg)
+	}
+
+	if createsPost && !simulate {
+		if err := checkMinPostFee(tx, params.MinPostFee); err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// checkMinPostFee checks that tx pays at least minFee. A zero minFee disables
+// the check.
+func checkMinPostFee(tx sdk.Tx, minFee sdk.Coin) error {
+	if minFee.Amount.IsNil() || minFee.IsZero() {
+		return nil
+	}
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return errorsmod.Wrap(sdkerrors.ErrTxDecode, "tx must be a FeeTx")
+	}
+	if paid := feeTx.GetFee().AmountOf(minFee.Denom); paid.LT(minFee.Amount) {
+		return errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "txs creating posts must pay at least %s, got %s%s", minFee, paid, minFee.Denom)
+	}
+	return nil
+}
+
+// blogMsgs returns the blog messages of msgs, including those wrapped in
+// other messages
+func blogMsgs(msgs []sdk.Msg) ([]sdk.Msg, error) {
+	var blog []sdk.Msg
+	for _, msg := range msgs {
+		if strings.HasPrefix(sdk.MsgTypeURL(msg), blogMsgTypeURLPrefix) {
+			blog = append(blog, msg)
+			continue
+		}
+		if nested, ok := msg.(nestedMsgs); ok {
+			inner, err := nested.GetMessages()
+			if err != nil {
+				return nil, err
+			}
+			innerBlog, err := blogMsgs(inner)
+			if err != nil {
+				return nil, err
+			}
+			blog = append(blog, innerBlog...)
+		}
+	}
+	return blog, nil
+}
+
+// postBodies returns the post body content carried by msg. For patches these
+// are the inserted texts, since the resulting body is only known at execution.
+func postBodies(msg sdk.Msg) []string {
+	switch msg := msg.(type) {
+	case *types.MsgCreatePost:
+		return []string{msg.Body}
+	case *types.MsgUpdatePost:
+		return []string{msg.Body}
+	case *types.MsgProposePostChange:
+		return []string{msg.Body}
+	case *types.MsgCreatePrivatePost:
+		return []string{msg.Body}
+	case *types.MsgRevokeReadAccess:
+		// the body is only rewritten when the revocation rotates the key
+		if msg.Body != "" {
+			return []string{msg.Body}
+		}
+	case *types.MsgBatchPostOps:
+		bodies := make([]string, 0, len(msg.Ops))
+		for _, op := range msg.Ops {
+			bodies = append(bodies, op.Body)
+		}
+		return bodies
+	case *types.MsgPatchPost:
+		bodies := make([]string, 0, len(msg.Ops))
+		for _, op := range msg.Ops {
+			bodies = append(bodies, op.Text)
+		}
+		return bodies
+	}
+	return nil
+}
+
+// isPostCreation reports whether msg creates a post. Revocations rotating the
+// key of an encrypted post publish its whole body again and count as well.
+func isPostCreation(msg sdk.Msg) bool {
+	switch msg := msg.(type) {
+	case *types.MsgCreatePost, *types.MsgCreatePrivatePost:
+		return true
+	case *types.MsgRevokeReadAccess:
+		return msg.Body != ""
+	case *types.MsgBatchPostOps:
+		for _, op := range msg.Ops {
+			if op.OpType == types.PostOpType_POST_OP_TYPE_CREATE {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,195 @@
+package ante_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+	protov2 "google.golang.org/protobuf/proto"
+
+	"blog/testutil/sample"
+	"blog/x/blog/ante"
+	"blog/x/blog/types"
+)
+
+type mockKeeper struct {
+	params types.Params
+}
+
+func (k mockKeeper) GetParams(context.Context) types.Params {
+	return k.params
+}
+
+// mockTx is a FeeTx carrying msgs and paying fee.
+type mockTx struct {
+	msgs []sdk.Msg
+	fee  sdk.Coins
+}
+
+func (tx mockTx) GetMsgs() []sdk.Msg                    { return tx.msgs }
+func (tx mockTx) GetMsgsV2() ([]protov2.Message, error) { return nil, nil }
+func (tx mockTx) GetGas() uint64                        { return 200_000 }
+func (tx mockTx) GetFee() sdk.Coins                     { return tx.fee }
+func (tx mockTx) FeePayer() []byte                      { return nil }
+func (tx mockTx) FeeGranter() []byte                    { return nil }
+
+func TestBlogDecorator(t *testing.T) {
+	creator := sample.AccAddress()
+	params := types.DefaultParams()
+	params.MaxTxMsgs = 2
+	params.MaxBodyBytes = 10
+	params.MinPostFee = sdk.NewInt64Coin("stake", 100)
+	decorator := ante.NewBlogDecorator(mockKeeper{params: params})
+
+	create := func(body string) sdk.Msg {
+		return &types.MsgCreatePost{Creator: creator, Title: "title", Body: body}
+	}
+	update := &types.MsgUpdatePost{Creator: creator, Id: 1, Title: "title", Body: "body"}
+	createPrivate := func(body string) sdk.Msg {
+		return &types.MsgCreatePrivatePost{Creator: creator, Title: "title", Body: body}
+	}
+	rotate := func(body string) sdk.Msg {
+		return &types.MsgRevokeReadAccess{Creator: creator, Id: 1, Reader: sample.AccAddress(), Body: body}
+	}
+	send := &banktypes.MsgSend{FromAddress: creator, ToAddress: creator}
+	exec := func(msgs ...sdk.Msg) sdk.Msg {
+		msg := authz.NewMsgExec(sdk.MustAccAddressFromBech32(creator), msgs)
+		return &msg
+	}
+	fee := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+
+	tests := []struct {
+		name     string
+		tx       mockTx
+		simulate bool
+		err      error
+	}{
+		{
+			name: "no blog messages",
+			tx:   mockTx{msgs: []sdk.Msg{send, send, send}},
+		},
+		{
+			name: "valid",
+			tx:   mockTx{msgs: []sdk.Msg{create("body"), update, send}, fee: fee},
+		},
+		{
+			name: "too many messages",
+			tx:   mockTx{msgs: []sdk.Msg{update, update, update}},
+			err:  types.ErrTooManyMsgs,
+		},
+		{
+			name: "too many messages wrapped in exec",
+			tx:   mockTx{msgs: []sdk.Msg{update, exec(update, update)}},
+			err:  types.ErrTooManyMsgs,
+		},
+		{
+			name: "body too large",
+			tx:   mockTx{msgs: []sdk.Msg{create(strings.Repeat("a", 11))}, fee: fee},
+			err:  types.ErrBodyTooLarge,
+		},
+		{
+			name: "batch body too large",
+			tx: mockTx{msgs: []sdk.Msg{&types.MsgBatchPostOps{Creator: creator, Ops: []types.PostOp{
+				{OpType: types.PostOpType_POST_OP_TYPE_UPDATE, Id: 1, Title: "title", Body: strings.Repeat("a", 11)},
+			}}}},
+			err: types.ErrBodyTooLarge,
+		},
+		{
+			name: "patch text too large",
+			tx: mockTx{msgs: []sdk.Msg{&types.MsgPatchPost{Creator: creator, Id: 1, Ops: []types.PatchOp{
+				{Text: strings.Repeat("a", 11)},
+			}}}},
+			err: types.ErrBodyTooLarge,
+		},
+		{
+			name: "private post body too large",
+			tx:   mockTx{msgs: []sdk.Msg{createPrivate(strings.Repeat("a", 11))}, fee: fee},
+			err:  types.ErrBodyTooLarge,
+		},
+		{
+			name: "rotated body too large",
+			tx:   mockTx{msgs: []sdk.Msg{rotate(strings.Repeat("a", 11))}, fee: fee},
+			err:  types.ErrBodyTooLarge,
+		},
+		{
+			name: "private post without fee",
+			tx:   mockTx{msgs: []sdk.Msg{createPrivate("body")}},
+			err:  sdkerrors.ErrInsufficientFee,
+		},
+		{
+			name: "rotation without fee",
+			tx:   mockTx{msgs: []sdk.Msg{rotate("body")}},
+			err:  sdkerrors.ErrInsufficientFee,
+		},
+		{
+			name: "revocations without rotation need no fee",
+			tx:   mockTx{msgs: []sdk.Msg{rotate("")}},
+		},
+		{
+			name: "insufficient fee",
+			tx:   mockTx{msgs: []sdk.Msg{create("body")}, fee: sdk.NewCoins(sdk.NewInt64Coin("stake", 99))},
+			err:  sdkerrors.ErrInsufficientFee,
+		},
+		{
+			name: "fee in another denom",
+			tx:   mockTx{msgs: []sdk.Msg{exec(create("body"))}, fee: sdk.NewCoins(sdk.NewInt64Coin("atom", 100))},
+			err:  sdkerrors.ErrInsufficientFee,
+		},
+		{
+			name: "batch creating posts without fee",
+			tx: mockTx{msgs: []sdk.Msg{&types.MsgBatchPostOps{Creator: creator, Ops: []types.PostOp{
+				{OpType: types.PostOpType_POST_OP_TYPE_CREATE, Title: "title", Body: "body"},
+			}}}},
+			err: sdkerrors.ErrInsufficientFee,
+		},
+		{
+			name:     "fee is not checked in simulation",
+			tx:       mockTx{msgs: []sdk.Msg{create("body")}},
+			simulate: true,
+		},
+		{
+			name: "updates need no fee",
+			tx:   mockTx{msgs: []sdk.Msg{update}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			called := false
+			next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+				called = true
+				return ctx, nil
+			}
+			_, err := decorator.AnteHandle(sdk.Context{}, tt.tx, tt.simulate, next)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				require.False(t, called)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, called)
+		})
+	}
+}
+
+func TestBlogDecoratorDisabledLimits(t *testing.T) {
+	creator := sample.AccAddress()
+	params := types.DefaultParams()
+	params.MaxTxMsgs = 0
+	params.MaxBodyBytes = 0
+	params.MinPostFee = sdk.Coin{Denom: "stake", Amount: math.ZeroInt()}
+	decorator := ante.NewBlogDecorator(mockKeeper{params: params})
+
+	msgs := make([]sdk.Msg, 20)
+	for i := range msgs {
+		msgs[i] = &types.MsgCreatePost{Creator: creator, Title: "title", Body: strings.Repeat("a", 1<<20)}
+	}
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+	_, err := decorator.AnteHandle(sdk.Context{}, mockTx{msgs: msgs}, false, next)
+	require.NoError(t, err)
+}
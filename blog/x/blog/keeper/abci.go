@@ -0,0 +1,18 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlocker runs the end of block housekeeping of the module
+func (k Keeper) EndBlocker(goCtx context.Context) error {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	k.ExpirePostChangeProposals(ctx)
+	k.PurgeExpiredTrash(ctx)
+	k.PrunePostQuota(ctx)
+
+	return k.RefundExpiredPurchases(ctx)
+}
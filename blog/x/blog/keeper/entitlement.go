@@ -0,0 +1,162 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+
+	"blog/x/blog/types"
+)
+
+// SetEntitlement stores an entitlement under its address and its post, and
+// under its refund time while its payment is held in escrow
+func (k Keeper) SetEntitlement(ctx sdk.Context, entitlement types.Entitlement) {
+	if previous, found := k.GetEntitlement(ctx, entitlement.Address, entitlement.PostId); found {
+		k.removeEntitlementRefund(ctx, previous)
+	}
+
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	prefix.NewStore(storeAdapter, types.KeyPrefix(types.EntitlementKey)).
+		Set(entitlementKey(entitlement.Address, entitlement.PostId), k.cdc.MustMarshal(&entitlement))
+	prefix.NewStore(storeAdapter, types.KeyPrefix(types.EntitlementPostKey)).
+		Set(append(GetPostIDBytes(entitlement.PostId), entitlement.Address...), []byte{})
+	if entitlement.Escrowed && entitlement.RefundAt != nil {
+		prefix.NewStore(storeAdapter, types.KeyPrefix(types.EntitlementRefundKey)).
+			Set(entitlementRefundKey(entitlement), []byte{})
+	}
+}
+
+// RemoveEntitlement removes the entitlement of addr to post id
+func (k Keeper) RemoveEntitlement(ctx sdk.Context, addr string, id uint64) {
+	entitlement, found := k.GetEntitlement(ctx, addr, id)
+	if !found {
+		return
+	}
+	k.removeEntitlementRefund(ctx, entitlement)
+
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	prefix.NewStore(storeAdapter, types.KeyPrefix(types.EntitlementKey)).Delete(entitlementKey(addr, id))
+	prefix.NewStore(storeAdapter, types.KeyPrefix(types.EntitlementPostKey)).Delete(append(GetPostIDBytes(id), addr...))
+}
+
+// GetEntitlement returns the entitlement of addr to post id
+func (k Keeper) GetEntitlement(ctx sdk.Context, addr string, id uint64) (entitlement types.Entitlement, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.EntitlementKey))
+	b := store.Get(entitlementKey(addr, id))
+	if b == nil {
+		return entitlement, false
+	}
+	k.cdc.MustUnmarshal(b, &entitlement)
+	return entitlement, true
+}
+
+// HasEntitlement reports whether addr purchased access to post id
+func (k Keeper) HasEntitlement(ctx sdk.Context, addr string, id uint64) bool {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.EntitlementKey))
+	return store.Has(entitlementKey(addr, id))
+}
+
+// GetAllEntitlement returns every entitlement, ordered by address
+func (k Keeper) GetAllEntitlement(ctx sdk.Context) (list []types.Entitlement) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.EntitlementKey))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Entitlement
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+	return
+}
+
+// ReleasePurchase pays the creator of post id the purchase of addr held in
+// escrow. It does nothing if the purchase was already paid.
+func (k Keeper) ReleasePurchase(ctx sdk.Context, addr string, post types.Post) error {
+	entitlement, found := k.GetEntitlement(ctx, addr, post.Id)
+	if !found || !entitlement.Escrowed {
+		return nil
+	}
+
+	creator := sdk.MustAccAddressFromBech32(post.Creator)
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, creator, entitlement.Price); err != nil {
+		return err
+	}
+	entitlement.Escrowed = false
+	entitlement.RefundAt = nil
+	k.SetEntitlement(ctx, entitlement)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeReleasePurchase,
+			sdk.NewAttribute(types.AttributeKeyPostID, fmt.Sprintf("%d", post.Id)),
+			sdk.NewAttribute(types.AttributeKeyBuyer, addr),
+			sdk.NewAttribute(types.AttributeKeyCreator, post.Creator),
+			sdk.NewAttribute(types.AttributeKeyPrice, entitlement.Price.String()),
+		),
+	)
+	return nil
+}
+
+// RefundExpiredPurchases returns to their buyers the payments still held in
+// escrow at their refund time and removes the matching entitlements
+func (k Keeper) RefundExpiredPurchases(ctx sdk.Context) error {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.EntitlementRefundKey))
+	iterator := store.Iterator(nil, storetypes.PrefixEndBytes(sdk.FormatTimeBytes(ctx.BlockTime())))
+	var expired []types.Entitlement
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		id := GetPostIDFromBytes(key[timeIndexKeyLength-8 : timeIndexKeyLength])
+		if entitlement, found := k.GetEntitlement(ctx, string(key[timeIndexKeyLength:]), id); found {
+			expired = append(expired, entitlement)
+		}
+	}
+	iterator.Close()
+
+	for _, entitlement := range expired {
+		buyer := sdk.MustAccAddressFromBech32(entitlement.Address)
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, buyer, entitlement.Price); err != nil {
+			return err
+		}
+		k.RemoveEntitlement(ctx, entitlement.Address, entitlement.PostId)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeRefundPurchase,
+				sdk.NewAttribute(types.AttributeKeyPostID, fmt.Sprintf("%d", entitlement.PostId)),
+				sdk.NewAttribute(types.AttributeKeyBuyer, entitlement.Address),
+				sdk.NewAttribute(types.AttributeKeyPrice, entitlement.Price.String()),
+			),
+		)
+	}
+	return nil
+}
+
+// removeEntitlementRefund removes the refund index entry of an entitlement
+func (k Keeper) removeEntitlementRefund(ctx sdk.Context, entitlement types.Entitlement) {
+	if !entitlement.Escrowed || entitlement.RefundAt == nil {
+		return
+	}
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	prefix.NewStore(storeAdapter, types.KeyPrefix(types.EntitlementRefundKey)).
+		Delete(entitlementRefundKey(entitlement))
+}
+
+// entitlementKey returns the key of an entitlement relative to EntitlementKey:
+// the length-prefixed address followed by the post ID.
+func entitlementKey(addr string, id uint64) []byte {
+	return append(address.MustLengthPrefix([]byte(addr)), GetPostIDBytes(id)...)
+}
+
+// entitlementRefundKey returns the key of an entitlement relative to
+// EntitlementRefundKey: its refund time and post ID followed by the address.
+func entitlementRefundKey(entitlement types.Entitlement) []byte {
+	return append(timeIndexKey(*entitlement.RefundAt, entitlement.PostId), entitlement.Address...)
+}
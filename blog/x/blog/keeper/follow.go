@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+
+	"blog/x/blog/types"
+)
+
+// IsFollowing reports whether follower follows followee
+func (k Keeper) IsFollowing(ctx sdk.Context, follower string, followee string) bool {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.FollowingKey))
+	return store.Has(followEdgeKey(follower, followee))
+}
+
+// GetFollowingCount returns the number of accounts follower follows
+func (k Keeper) GetFollowingCount(ctx sdk.Context, follower string) uint64 {
+	return k.getCounter(ctx, followingCountKey(follower))
+}
+
+// SetFollow stores the edge from follower to followee in both directions
+func (k Keeper) SetFollow(ctx sdk.Context, follower string, followee string) {
+	if k.IsFollowing(ctx, follower, followee) {
+		return
+	}
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	prefix.NewStore(storeAdapter, types.KeyPrefix(types.FollowingKey)).Set(followEdgeKey(follower, followee), []byte{})
+	prefix.NewStore(storeAdapter, types.KeyPrefix(types.FollowerKey)).Set(followEdgeKey(followee, follower), []byte{})
+	k.addToCounter(ctx, followingCountKey(follower), 1)
+}
+
+// RemoveFollow removes the edge from follower to followee
+func (k Keeper) RemoveFollow(ctx sdk.Context, follower string, followee string) {
+	if !k.IsFollowing(ctx, follower, followee) {
+		return
+	}
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	prefix.NewStore(storeAdapter, types.KeyPrefix(types.FollowingKey)).Delete(followEdgeKey(follower, followee))
+	prefix.NewStore(storeAdapter, types.KeyPrefix(types.FollowerKey)).Delete(followEdgeKey(followee, follower))
+	k.addToCounter(ctx, followingCountKey(follower), -1)
+}
+
+// GetAllFollow returns every edge of the follow graph, ordered by follower
+func (k Keeper) GetAllFollow(ctx sdk.Context) (list []types.Follow) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.FollowingKey))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		n := int(key[0])
+		list = append(list, types.Follow{Follower: string(key[1 : 1+n]), Followee: string(key[1+n:])})
+	}
+	return
+}
+
+// getFollowing returns every account follower follows
+func (k Keeper) getFollowing(ctx sdk.Context, follower string) []string {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, append(types.KeyPrefix(types.FollowingKey), address.MustLengthPrefix([]byte(follower))...))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var following []string
+	for ; iterator.Valid(); iterator.Next() {
+		following = append(following, string(iterator.Key()))
+	}
+	return following
+}
+
+// followEdgeKey returns the key of an edge relative to FollowingKey or
+// FollowerKey: the length-prefixed first address followed by the second one.
+func followEdgeKey(from string, to string) []byte {
+	return append(address.MustLengthPrefix([]byte(from)), to...)
+}
+
+func followingCountKey(follower string) []byte {
+	return append(types.KeyPrefix(types.FollowingCountKey), follower...)
+}
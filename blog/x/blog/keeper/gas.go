@@ -0,0 +1,22 @@
+package keeper
+
+import (
+	"math"
+	"math/bits"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// consumePostBytesGas charges gasPerByte for each of size bytes of post
+// content. The product saturates so that huge params run out of gas rather
+// than wrap around.
+func consumePostBytesGas(ctx sdk.Context, gasPerByte uint64, size int, descriptor string) {
+	if gasPerByte == 0 || size == 0 {
+		return
+	}
+	hi, gas := bits.Mul64(gasPerByte, uint64(size))
+	if hi != 0 {
+		gas = math.MaxUint64
+	}
+	ctx.GasMeter().ConsumeGas(gas, descriptor)
+}
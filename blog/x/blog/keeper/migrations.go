@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"blog/x/blog/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 builds the search index for the posts stored before it existed.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	for _, post := range m.keeper.GetAllPost(ctx) {
+		m.keeper.updateSearchIndex(ctx, nil, &post)
+	}
+	return nil
+}
+
+// Migrate2to3 builds the creator and last update indexes used by ListPost.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	for _, post := range m.keeper.GetAllPost(ctx) {
+		m.keeper.updateTimeIndexes(ctx, nil, &post)
+	}
+	return nil
+}
+
+// Migrate3to4 seeds the post counters. Edits made before the counters existed
+// were not recorded, so a post counts one edit if it was ever updated.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	for _, post := range m.keeper.GetAllPost(ctx) {
+		m.keeper.updateStats(ctx, nil, &post)
+		if post.LastUpdatedAt.After(post.CreatedAt) {
+			m.keeper.RecordPostEdit(ctx, post)
+		}
+	}
+	return nil
+}
+
+// Migrate4to5 derives a slug from the title of every existing post.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	for _, post := range m.keeper.GetAllPost(ctx) {
+		if post.Slug != "" {
+			continue
+		}
+		post.Slug = m.keeper.availableSlug(ctx, types.SlugFromTitle(post.Title))
+		m.keeper.SetPost(ctx, post)
+	}
+	return nil
+}
+
+// Migrate5to6 sets the version of every existing post to 1, so that a zero
+// expected version can mean that no check is requested.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	for _, post := range m.keeper.GetAllPost(ctx) {
+		if post.Version == 0 {
+			m.keeper.SetPost(ctx, post)
+		}
+	}
+	return nil
+}
+
+// Migrate6to7 sets the params added since version 1 to their defaults. Chains
+// upgrading from an older version stored none of them, and a zero value would
+// disable the limit, fee or delay it configures.
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	params, defaults := m.keeper.GetParams(ctx), types.DefaultParams()
+	for _, field := range []struct {
+		value    *uint64
+		fallback uint64
+	}{
+		{&params.MaxBatchSize, defaults.MaxBatchSize},
+		{&params.MaxFollowing, defaults.MaxFollowing},
+		{&params.ChangeProposalTtl, defaults.ChangeProposalTtl},
+		{&params.TrashRetention, defaults.TrashRetention},
+		{&params.CreateGasPerByte, defaults.CreateGasPerByte},
+		{&params.UpdateGasPerByte, defaults.UpdateGasPerByte},
+		{&params.PostQuota, defaults.PostQuota},
+		{&params.PostQuotaWindow, defaults.PostQuotaWindow},
+		{&params.MaxTxMsgs, defaults.MaxTxMsgs},
+		{&params.MaxBodyBytes, defaults.MaxBodyBytes},
+		{&params.PurchaseRefundTimeout, defaults.PurchaseRefundTimeout},
+	} {
+		if *field.value == 0 {
+			*field.value = field.fallback
+		}
+	}
+	if params.MinPostFee.Denom == "" {
+		params.MinPostFee = defaults.MinPostFee
+	}
+	return m.keeper.SetParams(ctx, params)
+}
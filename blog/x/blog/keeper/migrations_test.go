@@ -0,0 +1,111 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/stretchr/testify/require"
+
+	keepertest "blog/testutil/keeper"
+	"blog/x/blog/keeper"
+	"blog/x/blog/types"
+)
+
+func TestMigrations(t *testing.T) {
+	k, storeKey, ctx := keepertest.BlogKeeperWithStoreKey(t)
+	m := keeper.NewMigrator(k)
+	createdAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	creator := creator1.String()
+
+	// posts as stored by version 1 of the module: no indexes, counters,
+	// slugs or versions
+	store := prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefix(types.PostKey))
+	for _, post := range []types.Post{
+		{Id: 1, Creator: creator, Title: "Hello World", Body: "cosmos", CreatedAt: createdAt, LastUpdatedAt: createdAt},
+		{Id: 2, Creator: creator, Title: "hello world", Body: "tendermint", CreatedAt: createdAt, LastUpdatedAt: createdAt.Add(time.Hour)},
+		{Id: 3, Creator: creator, Title: "Custom", Body: "cosmos", CreatedAt: createdAt, LastUpdatedAt: createdAt},
+	} {
+		setRawPost(t, store, post)
+	}
+	k.SetPostCount(ctx, 3)
+
+	// Test: Migrate1to2 indexes the terms of the existing posts
+	search := func() []types.Post {
+		res, err := k.SearchPosts(ctx, &types.QuerySearchPostsRequest{Query: "cosmos"})
+		require.NoError(t, err)
+		return res.Posts
+	}
+	require.Empty(t, search())
+	require.NoError(t, m.Migrate1to2(ctx))
+	require.Len(t, search(), 2)
+
+	// Test: Migrate2to3 indexes the existing posts by creator and last update
+	list := func(req *types.QueryListPostRequest) []uint64 {
+		res, err := k.ListPost(ctx, req)
+		require.NoError(t, err)
+		var ids []uint64
+		for _, post := range res.Post {
+			ids = append(ids, post.Id)
+		}
+		return ids
+	}
+	byCreator := &types.QueryListPostRequest{Creator: creator}
+	byUpdate := &types.QueryListPostRequest{Order: types.ListPostOrder_LIST_POST_ORDER_LAST_UPDATED_DESC}
+	require.Empty(t, list(byCreator))
+	require.NoError(t, m.Migrate2to3(ctx))
+	require.Equal(t, []uint64{1, 2, 3}, list(byCreator))
+	require.Equal(t, uint64(2), list(byUpdate)[0])
+
+	// Test: Migrate3to4 counts the posts and one edit per updated post
+	require.Zero(t, k.GetTotalPosts(ctx))
+	require.NoError(t, m.Migrate3to4(ctx))
+	require.Equal(t, uint64(3), k.GetTotalPosts(ctx))
+	require.Equal(t, uint64(1), k.GetTotalCreators(ctx))
+	require.Equal(t, uint64(3), k.GetCreatorPostCount(ctx, creator))
+	require.Equal(t, uint64(1), k.GetTotalEdits(ctx))
+	require.Equal(t, uint64(1), k.GetPostEditCount(ctx, 2))
+	require.Equal(t, uint64(1), k.GetCreatorEditCount(ctx, creator))
+
+	// Test: Migrate4to5 derives distinct slugs from the titles
+	require.NoError(t, m.Migrate4to5(ctx))
+	for slug, id := range map[string]uint64{"hello-world": 1, "hello-world-2": 2, "custom": 3} {
+		got, found := k.GetPostIDBySlug(ctx, slug)
+		require.True(t, found, slug)
+		require.Equal(t, id, got, slug)
+	}
+
+	// Test: Migrate5to6 sets the version of the posts still without one
+	setRawPost(t, store, types.Post{Id: 4, Creator: creator, Title: "Late", Body: "late", CreatedAt: createdAt, LastUpdatedAt: createdAt})
+	k.SetPostCount(ctx, 4)
+	post, _ := k.GetPost(ctx, 4)
+	require.Zero(t, post.Version)
+	require.NoError(t, m.Migrate5to6(ctx))
+	for id := uint64(1); id <= 4; id++ {
+		post, _ := k.GetPost(ctx, id)
+		require.Equal(t, uint64(1), post.Version, id)
+	}
+
+	// Test: Migrate6to7 sets the params missing from the store to their
+	// defaults and keeps the others
+	require.NoError(t, k.SetParams(ctx, types.Params{MaxTxMsgs: 3}))
+	require.NoError(t, m.Migrate6to7(ctx))
+	want := types.DefaultParams()
+	want.MaxTxMsgs = 3
+	require.Equal(t, want, k.GetParams(ctx))
+
+	// Test: The migrated posts behave like new ones
+	ms := keeper.NewMsgServerImpl(k)
+	_, err := ms.UpdatePost(ctx, &types.MsgUpdatePost{Creator: creator, Id: 3, Title: "Custom", Body: "gaia", ExpectedVersion: 1})
+	require.NoError(t, err)
+	require.Len(t, search(), 1)
+}
+
+// setRawPost stores a post without going through the keeper, as an older
+// version of the module did
+func setRawPost(t *testing.T, store prefix.Store, post types.Post) {
+	post.Editors = []string{post.Creator}
+	bz, err := post.Marshal()
+	require.NoError(t, err)
+	store.Set(keeper.GetPostIDBytes(post.Id), bz)
+}
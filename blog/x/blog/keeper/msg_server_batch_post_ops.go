@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"blog/x/blog/types"
+)
+
+// BatchPostOps applies a list of create, update and delete operations atomically.
+// Either every operation succeeds or none of them is written to the store.
+func (k msgServer) BatchPostOps(goCtx context.Context, msg *types.MsgBatchPostOps) (*types.MsgBatchPostOpsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	maxBatchSize := k.GetParams(ctx).MaxBatchSize
+	if maxBatchSize > 0 && uint64(len(msg.Ops)) > maxBatchSize {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "batch of %d ops exceeds the maximum of %d", len(msg.Ops), maxBatchSize)
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	results := make([]types.PostOpResult, 0, len(msg.Ops))
+	for i, op := range msg.Ops {
+		id, err := k.applyPostOp(cacheCtx, msg.Creator, op)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "op %d", i)
+		}
+		results = append(results, types.PostOpResult{Id: id})
+	}
+	write()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBatchPostOps,
+			sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyOpCount, strconv.Itoa(len(msg.Ops))),
+		),
+	)
+
+	return &types.MsgBatchPostOpsResponse{Results: results}, nil
+}
+
+// applyPostOp runs a single batch operation through the same code path as the
+// standalone Msg and returns the ID of the affected post
+func (k msgServer) applyPostOp(ctx sdk.Context, signer string, op types.PostOp) (uint64, error) {
+	switch op.OpType {
+	case types.PostOpType_POST_OP_TYPE_CREATE:
+		return k.createPost(ctx, signer, types.Post{Title: op.Title, Body: op.Body, BodyFormat: op.BodyFormat, Slug: op.Slug, Tags: op.Tags})
+	case types.PostOpType_POST_OP_TYPE_UPDATE:
+		return op.Id, k.updatePost(ctx, signer, op.Id, op.Title, op.Body, op.BodyFormat, "", 0)
+	case types.PostOpType_POST_OP_TYPE_DELETE:
+		return op.Id, k.deletePost(ctx, signer, op.Id, 0)
+	default:
+		return 0, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "unknown op type %s", op.OpType)
+	}
+}
@@ -4,8 +4,10 @@ import (
 	"context"
 	"strconv"
 
-	"blog/x/blog/types"
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"blog/x/blog/types"
 )
 
 // CreatePost creates a new blog post
@@ -16,29 +18,77 @@ func (k msgServer) CreatePost(goCtx context.Context, msg *types.MsgCreatePost) (
 		return nil, err
 	}
 
+	id, err := k.createPost(ctx, msg.Creator, types.Post{
+		Title:      msg.Title,
+		Body:       msg.Body,
+		BodyFormat: msg.BodyFormat,
+		Slug:       msg.Slug,
+		Tags:       msg.Tags,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreatePostResponse{
+		Id: id,
+	}, nil
+}
+
+// createPost stores a new post owned by creator and returns its ID. The
+// content of the post is taken from the title, body, body format, slug,
+// summary, tags, key envelopes, price and body URI of content. The slug is derived from the
+// title when empty.
+func (k msgServer) createPost(ctx sdk.Context, creator string, content types.Post) (uint64, error) {
+	if err := types.ValidatePostEncryption(creator, content.BodyFormat, content.KeyEnvelopes); err != nil {
+		return 0, err
+	}
+	if err := types.ValidatePostPrice(content.BodyFormat, content.Price); err != nil {
+		return 0, err
+	}
+
+	title, slug := content.Title, content.Slug
+	if slug == "" {
+		slug = k.availableSlug(ctx, types.SlugFromTitle(title))
+	} else if _, taken := k.GetPostIDBySlug(ctx, slug); taken {
+		return 0, errorsmod.Wrapf(types.ErrSlugTaken, "slug %q is used by another post", slug)
+	}
+
+	if err := k.consumePostQuota(ctx, creator); err != nil {
+		return 0, err
+	}
+
+	consumePostBytesGas(ctx, k.GetParams(ctx).CreateGasPerByte, len(title)+len(content.Summary)+len(content.Body)+len(content.BodyUri)+len(slug), "blog post create bytes")
+
 	currentTime := ctx.BlockHeader().Time
 
 	post := types.Post{
-		Creator:       msg.Creator,
-		Title:         msg.Title,
-		Body:          msg.Body,
+		Creator:       creator,
+		Title:         title,
+		Body:          content.Body,
+		BodyFormat:    content.BodyFormat,
 		CreatedAt:     currentTime,
 		LastUpdatedAt: currentTime,
-		Editors:       []string{msg.Creator},
+		Editors:       []string{creator},
+		Slug:          slug,
+		KeyEnvelopes:  content.KeyEnvelopes,
+		Price:         content.Price,
+		Summary:       content.Summary,
+		Tags:          content.Tags,
+		BodyUri:       content.BodyUri,
 	}
 
 	id := k.AppendPost(ctx, post)
+	k.AppendPostLogEntry(ctx, id, types.PostLogAction_POST_LOG_ACTION_CREATE, creator)
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeCreatePost,
 			sdk.NewAttribute(types.AttributeKeyPostID, strconv.FormatUint(id, 10)),
-			sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
-			sdk.NewAttribute(types.AttributeKeyTitle, msg.Title),
+			sdk.NewAttribute(types.AttributeKeyCreator, creator),
+			sdk.NewAttribute(types.AttributeKeyTitle, title),
+			sdk.NewAttribute(types.AttributeKeySlug, slug),
 		),
 	)
 
-	return &types.MsgCreatePostResponse{
-		Id: id,
-	}, nil
+	return id, nil
 }
@@ -6,7 +6,6 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"blog/x/blog/types"
 )
@@ -20,33 +19,48 @@ func (k msgServer) DeletePost(goCtx context.Context, msg *types.MsgDeletePost) (
 		return nil, err
 	}
 
-	// Get the post
-	post, found := k.GetPost(ctx, msg.Id)
-	if !found {
-		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("key %d doesn't exist", msg.Id))
+	if err := k.deletePost(ctx, msg.Creator, msg.Id, msg.ExpectedVersion); err != nil {
+		return nil, err
 	}
 
-	// Check authorization
-	if !k.HasEditor(post, msg.Creator) {
-		return nil, errorsmod.Wrapf(
-			sdkerrors.ErrUnauthorized,
-			"address %s is not authorized to delete post %d",
-			msg.Creator,
-			msg.Id,
-		)
+	return &types.MsgDeletePostResponse{}, nil
+}
+
+// deletePost moves a post to the trash if deleter is one of its editors and a
+// non-zero expectedVersion matches the version of the post. Co-authored posts
+// are deleted through a change proposal instead.
+func (k msgServer) deletePost(ctx sdk.Context, deleter string, id uint64, expectedVersion uint64) error {
+	// Get the post and check authorization
+	post, err := k.validatePostAndEditor(ctx, id, deleter)
+	if err != nil {
+		return err
 	}
 
-	// Remove the post
-	k.RemovePost(ctx, msg.Id)
+	if err := validateExpectedVersion(post, expectedVersion); err != nil {
+		return err
+	}
+
+	if post.RequiresApproval() {
+		return errorsmod.Wrapf(types.ErrApprovalRequired, "propose the deletion of post %d instead", id)
+	}
+
+	k.moveToTrash(ctx, id, deleter)
+
+	return nil
+}
+
+// moveToTrash moves a post to the trash on behalf of deleter, logging the
+// deletion and emitting the delete event
+func (k msgServer) moveToTrash(ctx sdk.Context, id uint64, deleter string) {
+	k.TrashPost(ctx, id, deleter)
+	k.AppendPostLogEntry(ctx, id, types.PostLogAction_POST_LOG_ACTION_DELETE, deleter)
 
 	// Emit event
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeDeletePost,
-			sdk.NewAttribute(types.AttributeKeyPostID, fmt.Sprintf("%d", msg.Id)),
-			sdk.NewAttribute(types.AttributeKeyDeleter, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyPostID, fmt.Sprintf("%d", id)),
+			sdk.NewAttribute(types.AttributeKeyDeleter, deleter),
 		),
 	)
-
-	return &types.MsgDeletePostResponse{}, nil
 }
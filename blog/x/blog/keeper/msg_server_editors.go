@@ -22,12 +22,17 @@ func (k msgServer) AddEditor(goCtx context.Context, msg *types.MsgAddEditor) (*t
 		return nil, err
 	}
 
+	if err := validateExpectedVersion(post, msg.ExpectedVersion); err != nil {
+		return nil, err
+	}
+
 	if k.HasEditor(post, msg.Editor) {
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "editor already exists")
 	}
 
 	post.Editors = append(post.Editors, msg.Editor)
 	k.SetPost(ctx, post)
+	k.AppendPostLogEntry(ctx, post.Id, types.PostLogAction_POST_LOG_ACTION_ADD_EDITOR, msg.Creator)
 
 	// Emit event
 	ctx.EventManager().EmitEvent(
@@ -55,17 +60,22 @@ func (k msgServer) DeleteEditor(goCtx context.Context, msg *types.MsgDeleteEdito
 		return nil, err
 	}
 
+	if err := validateExpectedVersion(post, msg.ExpectedVersion); err != nil {
+		return nil, err
+	}
+
 	if msg.Editor == post.Creator {
 		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "creator cannot be deleted from editors")
 	}
 
 	editorIndex, found := k.FindEditorIndex(post, msg.Editor)
 	if !found {
-		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "editor already exists")
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "editor does not exist")
 	}
 
 	post.Editors = append(post.Editors[:editorIndex], post.Editors[editorIndex+1:]...)
 	k.SetPost(ctx, post)
+	k.AppendPostLogEntry(ctx, post.Id, types.PostLogAction_POST_LOG_ACTION_DELETE_EDITOR, msg.Creator)
 
 	// Emit event
 	ctx.EventManager().EmitEvent(
@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"blog/x/blog/types"
+)
+
+// Follow adds the followee to the feed of the signer
+func (k msgServer) Follow(goCtx context.Context, msg *types.MsgFollow) (*types.MsgFollowResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if k.IsFollowing(ctx, msg.Creator, msg.Followee) {
+		return nil, errorsmod.Wrapf(types.ErrAlreadyFollowing, "%s already follows %s", msg.Creator, msg.Followee)
+	}
+
+	params := k.GetParams(ctx)
+	if params.MaxFollowing > 0 && k.GetFollowingCount(ctx, msg.Creator) >= params.MaxFollowing {
+		return nil, errorsmod.Wrapf(types.ErrFollowLimit, "an account may follow at most %d accounts", params.MaxFollowing)
+	}
+
+	k.SetFollow(ctx, msg.Creator, msg.Followee)
+
+	// Emit event
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFollow,
+			sdk.NewAttribute(types.AttributeKeyFollower, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyFollowee, msg.Followee),
+		),
+	)
+
+	return &types.MsgFollowResponse{}, nil
+}
+
+// Unfollow removes the followee from the feed of the signer
+func (k msgServer) Unfollow(goCtx context.Context, msg *types.MsgUnfollow) (*types.MsgUnfollowResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if !k.IsFollowing(ctx, msg.Creator, msg.Followee) {
+		return nil, errorsmod.Wrapf(types.ErrNotFollowing, "%s does not follow %s", msg.Creator, msg.Followee)
+	}
+
+	k.RemoveFollow(ctx, msg.Creator, msg.Followee)
+
+	// Emit event
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUnfollow,
+			sdk.NewAttribute(types.AttributeKeyFollower, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyFollowee, msg.Followee),
+		),
+	)
+
+	return &types.MsgUnfollowResponse{}, nil
+}
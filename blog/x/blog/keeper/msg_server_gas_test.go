@@ -0,0 +1,83 @@
+package keeper_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"blog/x/blog/types"
+)
+
+// createPostGas returns the gas used to create a post with a body of size
+// bytes in a fresh store, with the given per-byte cost.
+func createPostGas(t testing.TB, gasPerByte uint64, size int) uint64 {
+	k, ms, ctx := setupMsgServer(t)
+	wctx := sdk.UnwrapSDKContext(ctx)
+	params := types.DefaultParams()
+	params.CreateGasPerByte = gasPerByte
+	require.NoError(t, k.SetParams(wctx, params))
+
+	wctx = wctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	_, err := ms.CreatePost(wctx, &types.MsgCreatePost{
+		Creator: creator1.String(),
+		Title:   "title",
+		Body:    strings.Repeat("a", size),
+		Slug:    "slug",
+	})
+	require.NoError(t, err)
+	return wctx.GasMeter().GasConsumed()
+}
+
+// The tests compare runs with per-byte costs of equal encoded length, so that
+// reading the params costs the same gas in both runs.
+
+func TestCreatePostBytesGas(t *testing.T) {
+	perByte := types.DefaultCreateGasPerByte
+	for _, size := range []int{0, 10, 1000, 100_000} {
+		content := len("title") + len("slug") + size
+		require.Equal(t, uint64(content)*perByte, createPostGas(t, 2*perByte, size)-createPostGas(t, perByte, size), "size %d", size)
+	}
+}
+
+func TestUpdatePostBytesGas(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	wctx := sdk.UnwrapSDKContext(ctx)
+	_, err := ms.CreatePost(wctx, &types.MsgCreatePost{Creator: creator1.String(), Title: "title", Body: "body"})
+	require.NoError(t, err)
+
+	update := func(gasPerByte uint64, body string) uint64 {
+		params := types.DefaultParams()
+		params.UpdateGasPerByte = gasPerByte
+		require.NoError(t, k.SetParams(wctx, params))
+		gctx := wctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+		_, err := ms.UpdatePost(gctx, &types.MsgUpdatePost{Creator: creator1.String(), Id: 1, Title: "title", Body: body})
+		require.NoError(t, err)
+		return gctx.GasMeter().GasConsumed()
+	}
+
+	// Rewriting the same content costs the same store gas both times.
+	perByte := types.DefaultUpdateGasPerByte
+	body := strings.Repeat("b", 5000)
+	update(perByte, body)
+	single := update(perByte, body)
+	double := update(2*perByte, body)
+	require.Equal(t, uint64(len("title")+len(body))*perByte, double-single)
+}
+
+// BenchmarkCreatePostGas reports the gas used to create posts of increasing
+// size, including the store writes, to help tune the per-byte params.
+func BenchmarkCreatePostGas(b *testing.B) {
+	for _, size := range []int{10, 1_000, 10_000, 100_000} {
+		b.Run(fmt.Sprintf("body=%dB", size), func(b *testing.B) {
+			var gas uint64
+			for i := 0; i < b.N; i++ {
+				gas = createPostGas(b, types.DefaultCreateGasPerByte, size)
+			}
+			b.ReportMetric(float64(gas), "gas/op")
+		})
+	}
+}
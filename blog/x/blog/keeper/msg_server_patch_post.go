@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"blog/x/blog/types"
+)
+
+// PatchPost applies byte-range operations to the body of a post if it has not
+// changed since the revision the patch was computed against
+func (k msgServer) PatchPost(goCtx context.Context, msg *types.MsgPatchPost) (*types.MsgPatchPostResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	post, err := k.validatePostAndEditor(ctx, msg.Id, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	if post.BodyFormat == types.BodyFormat_BODY_FORMAT_ENCRYPTED {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "post %d is encrypted and cannot be patched", msg.Id)
+	}
+
+	if post.RequiresApproval() {
+		return nil, errorsmod.Wrapf(types.ErrApprovalRequired, "propose the change to post %d instead", msg.Id)
+	}
+
+	if current := types.PostRevision(post); current != msg.ExpectedRevision {
+		return nil, errorsmod.Wrapf(types.ErrRevisionMismatch, "post %d is at revision %s", msg.Id, current)
+	}
+
+	body, err := types.ApplyPatch(post.Body, msg.Ops)
+	if err != nil {
+		return nil, err
+	}
+	if err := types.ValidateBody(post.BodyFormat, body); err != nil {
+		return nil, err
+	}
+
+	// the ante handler only sees the inserted text, so the cap on the body
+	// is enforced on the patched body
+	params := k.GetParams(ctx)
+	if params.MaxBodyBytes != 0 && uint64(len(body)) > params.MaxBodyBytes {
+		return nil, errorsmod.Wrapf(types.ErrBodyTooLarge, "the patched body of post %d has %d bytes, the limit is %d", msg.Id, len(body), params.MaxBodyBytes)
+	}
+
+	inserted := 0
+	for _, op := range msg.Ops {
+		inserted += len(op.Text)
+	}
+	consumePostBytesGas(ctx, params.UpdateGasPerByte, inserted, "blog post patch bytes")
+
+	post.Body = body
+	revision := types.PostRevision(post)
+	k.applyPostUpdate(ctx, post, msg.Creator,
+		sdk.NewAttribute(types.AttributeKeyBody, body),
+		sdk.NewAttribute(types.AttributeKeyRevision, revision),
+	)
+
+	return &types.MsgPatchPostResponse{Revision: revision}, nil
+}
@@ -0,0 +1,65 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"blog/x/blog/types"
+)
+
+func TestMsgPatchPost(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	wctx := sdk.UnwrapSDKContext(ctx)
+
+	_, err := ms.CreatePost(wctx, &types.MsgCreatePost{Creator: creator1.String(), Title: "title", Body: "hello world"})
+	require.NoError(t, err)
+	show, err := k.ShowPost(wctx, &types.QueryShowPostRequest{Id: 1})
+	require.NoError(t, err)
+	revision := show.Revision
+
+	// Test: Only editors may patch
+	_, err = ms.PatchPost(wctx, &types.MsgPatchPost{Creator: creator2.String(), Id: 1, Ops: []types.PatchOp{{Start: 0, End: 5, Text: "bye"}}, ExpectedRevision: revision})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// Test: Patch against the current revision
+	res, err := ms.PatchPost(wctx, &types.MsgPatchPost{Creator: creator1.String(), Id: 1, Ops: []types.PatchOp{{Start: 6, End: 11, Text: "cosmos"}}, ExpectedRevision: revision})
+	require.NoError(t, err)
+	post, _ := k.GetPost(wctx, 1)
+	require.Equal(t, "hello cosmos", post.Body)
+	require.Equal(t, types.PostRevision(post), res.Revision)
+	require.Equal(t, uint64(1), k.GetPostEditCount(wctx, 1))
+
+	// Test: The update event carries the patched body
+	var body string
+	for _, event := range wctx.EventManager().Events() {
+		if event.Type != types.EventTypeUpdatePost {
+			continue
+		}
+		if attr, ok := event.GetAttribute(types.AttributeKeyBody); ok {
+			body = attr.Value
+		}
+	}
+	require.Equal(t, "hello cosmos", body)
+
+	// Test: A patch computed against a stale revision is rejected
+	_, err = ms.PatchPost(wctx, &types.MsgPatchPost{Creator: creator1.String(), Id: 1, Ops: []types.PatchOp{{Start: 0, End: 5, Text: "bye"}}, ExpectedRevision: revision})
+	require.ErrorIs(t, err, types.ErrRevisionMismatch)
+
+	// Test: Ranges past the end of the body are rejected
+	_, err = ms.PatchPost(wctx, &types.MsgPatchPost{Creator: creator1.String(), Id: 1, Ops: []types.PatchOp{{Start: 0, End: 100}}, ExpectedRevision: res.Revision})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// Test: Patches cannot grow the body past the body cap
+	params := k.GetParams(wctx)
+	params.MaxBodyBytes = 16
+	require.NoError(t, k.SetParams(wctx, params))
+	res, err = ms.PatchPost(wctx, &types.MsgPatchPost{Creator: creator1.String(), Id: 1, Ops: []types.PatchOp{{Start: 12, End: 12, Text: "!!!!"}}, ExpectedRevision: res.Revision})
+	require.NoError(t, err)
+	_, err = ms.PatchPost(wctx, &types.MsgPatchPost{Creator: creator1.String(), Id: 1, Ops: []types.PatchOp{{Start: 16, End: 16, Text: "!"}}, ExpectedRevision: res.Revision})
+	require.ErrorIs(t, err, types.ErrBodyTooLarge)
+	post, _ = k.GetPost(wctx, 1)
+	require.Equal(t, "hello cosmos!!!!", post.Body)
+}
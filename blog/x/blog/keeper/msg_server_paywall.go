@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"blog/x/blog/types"
+)
+
+// SetPostPrice changes the price of an encrypted post of the signer
+func (k msgServer) SetPostPrice(goCtx context.Context, msg *types.MsgSetPostPrice) (*types.MsgSetPostPriceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	post, err := k.validateEncryptedPostAndOwnership(ctx, msg.Id, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	post.Price = msg.Price
+	k.SetPost(ctx, post)
+	k.AppendPostLogEntry(ctx, post.Id, types.PostLogAction_POST_LOG_ACTION_SET_PRICE, msg.Creator)
+
+	// Emit event
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetPostPrice,
+			sdk.NewAttribute(types.AttributeKeyPostID, fmt.Sprintf("%d", msg.Id)),
+			sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyPrice, msg.Price.String()),
+		),
+	)
+
+	return &types.MsgSetPostPriceResponse{}, nil
+}
+
+// PurchaseAccess pays the price of a paywalled post and records the entitlement
+// of the buyer. The buyer reads the post once the creator grants it a key
+// envelope. Unless the purchase refund timeout is zero, the payment is held in
+// escrow until that grant and refunded if it doesn't come in time.
+func (k msgServer) PurchaseAccess(goCtx context.Context, msg *types.MsgPurchaseAccess) (*types.MsgPurchaseAccessResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	post, found := k.GetPost(ctx, msg.Id)
+	if !found {
+		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("key %d doesn't exist", msg.Id))
+	}
+	if post.Price.Empty() {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "post %d is not for sale", msg.Id)
+	}
+	if !msg.Price.Equal(post.Price) {
+		return nil, errorsmod.Wrapf(types.ErrPriceMismatch, "post %d costs %s, not %s", msg.Id, post.Price, msg.Price)
+	}
+	if _, found := types.KeyEnvelopeOf(post.KeyEnvelopes, msg.Buyer); found {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "%s can already read post %d", msg.Buyer, msg.Id)
+	}
+	if k.HasEntitlement(ctx, msg.Buyer, msg.Id) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "%s already purchased access to post %d", msg.Buyer, msg.Id)
+	}
+
+	entitlement := types.Entitlement{
+		Address:     msg.Buyer,
+		PostId:      msg.Id,
+		Price:       post.Price,
+		Height:      ctx.BlockHeight(),
+		PurchasedAt: ctx.BlockTime(),
+	}
+	buyer := sdk.MustAccAddressFromBech32(msg.Buyer)
+	if timeout := k.GetParams(ctx).PurchaseRefundTimeout; timeout == 0 {
+		creator := sdk.MustAccAddressFromBech32(post.Creator)
+		if err := k.bankKeeper.SendCoins(ctx, buyer, creator, post.Price); err != nil {
+			return nil, err
+		}
+	} else {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, buyer, types.ModuleName, post.Price); err != nil {
+			return nil, err
+		}
+		refundAt := ctx.BlockTime().Add(secondsToDuration(timeout))
+		entitlement.Escrowed = true
+		entitlement.RefundAt = &refundAt
+	}
+	k.SetEntitlement(ctx, entitlement)
+
+	// Emit event
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePurchaseAccess,
+			sdk.NewAttribute(types.AttributeKeyPostID, fmt.Sprintf("%d", msg.Id)),
+			sdk.NewAttribute(types.AttributeKeyBuyer, msg.Buyer),
+			sdk.NewAttribute(types.AttributeKeyCreator, post.Creator),
+			sdk.NewAttribute(types.AttributeKeyPrice, post.Price.String()),
+			sdk.NewAttribute(types.AttributeKeyEscrowed, strconv.FormatBool(entitlement.Escrowed)),
+		),
+	)
+
+	return &types.MsgPurchaseAccessResponse{}, nil
+}
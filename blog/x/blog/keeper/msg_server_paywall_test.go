@@ -0,0 +1,182 @@
+package keeper_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "blog/testutil/keeper"
+	"blog/x/blog/keeper"
+	"blog/x/blog/types"
+)
+
+func TestPaywalledPost(t *testing.T) {
+	k, bank, ctx := keepertest.BlogKeeperWithBank(t)
+	ms := keeper.NewMsgServerImpl(k)
+	wctx := ctx.WithBlockHeight(5)
+
+	owner, buyer := secp256k1.GenPrivKey(), secp256k1.GenPrivKey()
+	ownerAddr, buyerAddr := sdk.AccAddress(owner.PubKey().Address()), sdk.AccAddress(buyer.PubKey().Address())
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+	price := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+	bank.Fund(buyerAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 25)))
+
+	key, err := types.NewContentKey()
+	require.NoError(t, err)
+	body, err := types.EncryptBody(key, "the secret sauce")
+	require.NoError(t, err)
+	env, err := types.SealContentKey(ownerAddr.String(), owner.PubKey().Bytes(), key)
+	require.NoError(t, err)
+
+	// Test: Plain posts cannot be sold
+	_, err = ms.CreatePost(wctx, &types.MsgCreatePost{Creator: ownerAddr.String(), Title: "public", Body: "hello"})
+	require.NoError(t, err)
+	_, err = ms.SetPostPrice(wctx, &types.MsgSetPostPrice{Creator: ownerAddr.String(), Id: 1, Price: price})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// Test: The title and summary of a paywalled post are public and searchable
+	_, err = ms.CreatePrivatePost(wctx, &types.MsgCreatePrivatePost{
+		Creator: ownerAddr.String(), Title: "recipes", Summary: "grandma cooking", Body: body,
+		KeyEnvelopes: []types.KeyEnvelope{env}, Price: price,
+	})
+	require.NoError(t, err)
+	search, err := k.SearchPosts(wctx, &types.QuerySearchPostsRequest{Query: "grandma"})
+	require.NoError(t, err)
+	require.Len(t, search.Posts, 1)
+	access, err := k.HasAccess(wctx, &types.QueryHasAccessRequest{Address: buyerAddr.String(), Id: 2})
+	require.NoError(t, err)
+	require.False(t, access.HasAccess)
+
+	// Test: The purchase must be at the current price
+	_, err = ms.PurchaseAccess(wctx, &types.MsgPurchaseAccess{Buyer: buyerAddr.String(), Id: 2, Price: sdk.NewCoins(sdk.NewInt64Coin("stake", 5))})
+	require.ErrorIs(t, err, types.ErrPriceMismatch)
+	_, err = ms.PurchaseAccess(wctx, &types.MsgPurchaseAccess{Buyer: buyerAddr.String(), Id: 1, Price: price})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// Test: A purchase holds the payment in escrow and records the entitlement once
+	_, err = ms.PurchaseAccess(wctx, &types.MsgPurchaseAccess{Buyer: buyerAddr.String(), Id: 2, Price: price})
+	require.NoError(t, err)
+	require.Equal(t, int64(15), bank.GetBalance(wctx, buyerAddr, "stake").Amount.Int64())
+	require.Zero(t, bank.GetBalance(wctx, ownerAddr, "stake").Amount.Int64())
+	require.Equal(t, int64(10), bank.GetBalance(wctx, moduleAddr, "stake").Amount.Int64())
+	_, err = ms.PurchaseAccess(wctx, &types.MsgPurchaseAccess{Buyer: buyerAddr.String(), Id: 2, Price: price})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	access, err = k.HasAccess(wctx, &types.QueryHasAccessRequest{Address: buyerAddr.String(), Id: 2})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryHasAccessResponse{HasAccess: true, Purchased: true}, access)
+	purchased, err := k.PurchasedPosts(wctx, &types.QueryPurchasedPostsRequest{Address: buyerAddr.String()})
+	require.NoError(t, err)
+	require.Len(t, purchased.Entitlements, 1)
+	require.Equal(t, uint64(2), purchased.Entitlements[0].PostId)
+	require.Equal(t, price, purchased.Entitlements[0].Price)
+	require.Equal(t, int64(5), purchased.Entitlements[0].Height)
+	require.True(t, purchased.Entitlements[0].Escrowed)
+	purchasers, err := k.PostPurchasers(wctx, &types.QueryPostPurchasersRequest{Id: 2})
+	require.NoError(t, err)
+	require.Equal(t, []string{buyerAddr.String()}, purchasers.Purchasers)
+	purchasers, err = k.PostPurchasers(wctx, &types.QueryPostPurchasersRequest{Id: 2, Escrowed: true})
+	require.NoError(t, err)
+	require.Equal(t, []string{buyerAddr.String()}, purchasers.Purchasers)
+	_, err = k.HasAccess(wctx, &types.QueryHasAccessRequest{Address: strings.Repeat("a", 300), Id: 2})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = k.PurchasedPosts(wctx, &types.QueryPurchasedPostsRequest{Address: "not-an-address"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Test: The creator grants the purchaser an envelope, which releases the
+	// payment and cannot be revoked
+	buyerEnv, err := types.SealContentKey(buyerAddr.String(), buyer.PubKey().Bytes(), key)
+	require.NoError(t, err)
+	_, err = ms.GrantReadAccess(wctx, &types.MsgGrantReadAccess{Creator: ownerAddr.String(), Id: 2, Envelope: buyerEnv})
+	require.NoError(t, err)
+	require.Equal(t, int64(10), bank.GetBalance(wctx, ownerAddr, "stake").Amount.Int64())
+	require.Zero(t, bank.GetBalance(wctx, moduleAddr, "stake").Amount.Int64())
+	purchasers, err = k.PostPurchasers(wctx, &types.QueryPostPurchasersRequest{Id: 2, Escrowed: true})
+	require.NoError(t, err)
+	require.Empty(t, purchasers.Purchasers)
+	access, err = k.HasAccess(wctx, &types.QueryHasAccessRequest{Address: buyerAddr.String(), Id: 2})
+	require.NoError(t, err)
+	require.True(t, access.HasEnvelope)
+	_, err = ms.RevokeReadAccess(wctx, &types.MsgRevokeReadAccess{Creator: ownerAddr.String(), Id: 2, Reader: buyerAddr.String()})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// Test: Funds must cover the price
+	_, err = ms.SetPostPrice(wctx, &types.MsgSetPostPrice{Creator: ownerAddr.String(), Id: 2, Price: sdk.NewCoins(sdk.NewInt64Coin("stake", 100))})
+	require.NoError(t, err)
+	other := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	bank.Fund(other, price)
+	_, err = ms.PurchaseAccess(wctx, &types.MsgPurchaseAccess{Buyer: other.String(), Id: 2, Price: sdk.NewCoins(sdk.NewInt64Coin("stake", 100))})
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+
+	// Test: Posts off sale cannot be purchased
+	_, err = ms.SetPostPrice(wctx, &types.MsgSetPostPrice{Creator: ownerAddr.String(), Id: 2})
+	require.NoError(t, err)
+	_, err = ms.PurchaseAccess(wctx, &types.MsgPurchaseAccess{Buyer: other.String(), Id: 2, Price: price})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+}
+
+func TestPurchaseRefund(t *testing.T) {
+	k, bank, ctx := keepertest.BlogKeeperWithBank(t)
+	ms := keeper.NewMsgServerImpl(k)
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	wctx := ctx.WithBlockTime(now)
+
+	owner := secp256k1.GenPrivKey()
+	ownerAddr := sdk.AccAddress(owner.PubKey().Address())
+	buyerAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+	price := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+	bank.Fund(buyerAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 20)))
+
+	key, err := types.NewContentKey()
+	require.NoError(t, err)
+	body, err := types.EncryptBody(key, "the secret sauce")
+	require.NoError(t, err)
+	env, err := types.SealContentKey(ownerAddr.String(), owner.PubKey().Bytes(), key)
+	require.NoError(t, err)
+	_, err = ms.CreatePrivatePost(wctx, &types.MsgCreatePrivatePost{
+		Creator: ownerAddr.String(), Title: "recipes", Body: body,
+		KeyEnvelopes: []types.KeyEnvelope{env}, Price: price,
+	})
+	require.NoError(t, err)
+
+	// Test: The payment stays in escrow until the refund timeout
+	params := k.GetParams(wctx)
+	params.PurchaseRefundTimeout = 60
+	require.NoError(t, k.SetParams(wctx, params))
+	_, err = ms.PurchaseAccess(wctx, &types.MsgPurchaseAccess{Buyer: buyerAddr.String(), Id: 1, Price: price})
+	require.NoError(t, err)
+	entitlement, found := k.GetEntitlement(wctx, buyerAddr.String(), 1)
+	require.True(t, found)
+	require.Equal(t, now.Add(time.Minute), *entitlement.RefundAt)
+	require.NoError(t, k.EndBlocker(wctx.WithBlockTime(now.Add(59*time.Second))))
+	require.True(t, k.HasEntitlement(wctx, buyerAddr.String(), 1))
+	require.Equal(t, int64(10), bank.GetBalance(wctx, moduleAddr, "stake").Amount.Int64())
+
+	// Test: A purchase the creator didn't grant in time is refunded
+	require.NoError(t, k.EndBlocker(wctx.WithBlockTime(now.Add(time.Minute))))
+	require.False(t, k.HasEntitlement(wctx, buyerAddr.String(), 1))
+	require.Equal(t, int64(20), bank.GetBalance(wctx, buyerAddr, "stake").Amount.Int64())
+	require.Zero(t, bank.GetBalance(wctx, moduleAddr, "stake").Amount.Int64())
+	purchasers, err := k.PostPurchasers(wctx, &types.QueryPostPurchasersRequest{Id: 1})
+	require.NoError(t, err)
+	require.Empty(t, purchasers.Purchasers)
+
+	// Test: Without a refund timeout the creator is paid at once
+	params.PurchaseRefundTimeout = 0
+	require.NoError(t, k.SetParams(wctx, params))
+	_, err = ms.PurchaseAccess(wctx, &types.MsgPurchaseAccess{Buyer: buyerAddr.String(), Id: 1, Price: price})
+	require.NoError(t, err)
+	require.Equal(t, int64(10), bank.GetBalance(wctx, ownerAddr, "stake").Amount.Int64())
+	entitlement, _ = k.GetEntitlement(wctx, buyerAddr.String(), 1)
+	require.False(t, entitlement.Escrowed)
+	require.Nil(t, entitlement.RefundAt)
+}
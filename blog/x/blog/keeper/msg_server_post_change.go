@@ -0,0 +1,212 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"blog/x/blog/types"
+)
+
+// SetCoAuthors names the co-authors and approval threshold of a post that
+// does not require approval yet. Once it does, the co-authors change through
+// a proposal so that the creator alone cannot remove them.
+func (k msgServer) SetCoAuthors(goCtx context.Context, msg *types.MsgSetCoAuthors) (*types.MsgSetCoAuthorsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	post, err := k.validatePostAndOwnership(ctx, msg.Id, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	if post.RequiresApproval() {
+		return nil, errorsmod.Wrapf(types.ErrApprovalRequired, "propose the co-author change to post %d instead", msg.Id)
+	}
+
+	k.setCoAuthors(ctx, post, msg.CoAuthors, msg.ApprovalThreshold, msg.Creator)
+
+	return &types.MsgSetCoAuthorsResponse{}, nil
+}
+
+// setCoAuthors replaces the co-authors and approval threshold of a post on
+// behalf of actor. Pending proposals are dropped since their approvals may no
+// longer count.
+func (k msgServer) setCoAuthors(ctx sdk.Context, post types.Post, coAuthors []string, approvalThreshold uint32, actor string) {
+	post.CoAuthors = coAuthors
+	post.ApprovalThreshold = approvalThreshold
+	k.SetPost(ctx, post)
+	k.AppendPostLogEntry(ctx, post.Id, types.PostLogAction_POST_LOG_ACTION_SET_CO_AUTHORS, actor)
+	k.removePostChangeProposals(ctx, post.Id)
+
+	// Emit event
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetCoAuthors,
+			sdk.NewAttribute(types.AttributeKeyPostID, strconv.FormatUint(post.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyCreator, actor),
+			sdk.NewAttribute(types.AttributeKeyCoAuthors, strings.Join(coAuthors, ",")),
+			sdk.NewAttribute(types.AttributeKeyThreshold, strconv.FormatUint(uint64(approvalThreshold), 10)),
+		),
+	)
+}
+
+// ProposePostChange opens a proposal to change a co-authored post, applying it
+// right away if the proposer's approval meets the threshold
+func (k msgServer) ProposePostChange(goCtx context.Context, msg *types.MsgProposePostChange) (*types.MsgProposePostChangeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	post, err := k.validatePostAndCoAuthor(ctx, msg.PostId, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateExpectedVersion(post, msg.ExpectedVersion); err != nil {
+		return nil, err
+	}
+	if msg.Kind == types.PostChangeKind_POST_CHANGE_KIND_CONTENT {
+		if err := types.ValidateBody(post.BodyFormat, msg.Body); err != nil {
+			return nil, err
+		}
+	}
+
+	proposal := types.PostChangeProposal{
+		PostId:            post.Id,
+		Proposer:          msg.Creator,
+		Title:             msg.Title,
+		Body:              msg.Body,
+		Approvals:         []string{msg.Creator},
+		CreatedAt:         ctx.BlockTime(),
+		Kind:              msg.Kind,
+		CoAuthors:         msg.CoAuthors,
+		ApprovalThreshold: msg.ApprovalThreshold,
+		BaseVersion:       post.Version,
+	}
+	if ttl := k.GetParams(ctx).ChangeProposalTtl; ttl > 0 {
+		expiresAt := ctx.BlockTime().Add(secondsToDuration(ttl))
+		proposal.ExpiresAt = &expiresAt
+	}
+	proposal.Id = k.AppendPostChangeProposal(ctx, proposal)
+
+	// Emit event
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeProposePostChange,
+			sdk.NewAttribute(types.AttributeKeyProposalID, strconv.FormatUint(proposal.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyPostID, strconv.FormatUint(post.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyTitle, msg.Title),
+		),
+	)
+
+	applied, err := k.applyPostChangeIfApproved(ctx, post, proposal, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgProposePostChangeResponse{ProposalId: proposal.Id, Applied: applied}, nil
+}
+
+// ApprovePostChange records the approval of a co-author and applies the
+// change once the threshold is met
+func (k msgServer) ApprovePostChange(goCtx context.Context, msg *types.MsgApprovePostChange) (*types.MsgApprovePostChangeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	proposal, found := k.GetPostChangeProposal(ctx, msg.ProposalId)
+	if !found {
+		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("proposal %d doesn't exist", msg.ProposalId))
+	}
+	post, err := k.validatePostAndCoAuthor(ctx, proposal.PostId, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	for _, approver := range proposal.Approvals {
+		if approver == msg.Creator {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "proposal already approved")
+		}
+	}
+
+	proposal.Approvals = append(proposal.Approvals, msg.Creator)
+
+	// Emit event
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeApprovePostChange,
+			sdk.NewAttribute(types.AttributeKeyProposalID, strconv.FormatUint(proposal.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyPostID, strconv.FormatUint(post.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyApprover, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyApprovals, strconv.Itoa(len(proposal.Approvals))),
+		),
+	)
+
+	applied, err := k.applyPostChangeIfApproved(ctx, post, proposal, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	if !applied {
+		k.SetPostChangeProposal(ctx, proposal)
+	}
+
+	return &types.MsgApprovePostChangeResponse{Applied: applied}, nil
+}
+
+// applyPostChangeIfApproved applies a proposal and removes it if it has
+// enough approvals, reporting whether it did. Proposals made against an
+// earlier version of the post are refused, so that a change approved for one
+// version never overwrites a later one. Proposals stored without a base
+// version predate the check and are applied as before.
+func (k msgServer) applyPostChangeIfApproved(ctx sdk.Context, post types.Post, proposal types.PostChangeProposal, approver string) (bool, error) {
+	if len(proposal.Approvals) < int(post.ApprovalThreshold) {
+		return false, nil
+	}
+	if proposal.BaseVersion != 0 && proposal.BaseVersion != post.Version {
+		return false, errorsmod.Wrapf(types.ErrVersionConflict, "post %d is at version %d, proposal %d was made against version %d", post.Id, post.Version, proposal.Id, proposal.BaseVersion)
+	}
+
+	k.RemovePostChangeProposal(ctx, proposal.Id)
+	switch proposal.Kind {
+	case types.PostChangeKind_POST_CHANGE_KIND_CO_AUTHORS:
+		k.setCoAuthors(ctx, post, proposal.CoAuthors, proposal.ApprovalThreshold, approver)
+	case types.PostChangeKind_POST_CHANGE_KIND_DELETE:
+		k.moveToTrash(ctx, post.Id, approver)
+	default:
+		post.Title = proposal.Title
+		post.Body = proposal.Body
+		k.applyPostUpdate(ctx, post, approver)
+	}
+
+	return true, nil
+}
+
+// validatePostAndCoAuthor checks if a post requires approval for changes and
+// if the address is one of its co-authors
+func (k msgServer) validatePostAndCoAuthor(ctx sdk.Context, postID uint64, address string) (types.Post, error) {
+	post, found := k.GetPost(ctx, postID)
+	if !found {
+		return types.Post{}, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("key %d doesn't exist", postID))
+	}
+
+	if !post.RequiresApproval() {
+		return types.Post{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "post has no co-authors, update it directly")
+	}
+
+	if !post.IsCoAuthor(address) {
+		return types.Post{}, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "not a co-author")
+	}
+
+	return post, nil
+}
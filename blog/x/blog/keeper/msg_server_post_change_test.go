@@ -0,0 +1,177 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cometbft/cometbft/crypto"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"blog/x/blog/types"
+)
+
+func TestPostChangeApproval(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	wctx := sdk.UnwrapSDKContext(ctx).WithBlockTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	coAuthor := sdk.AccAddress(crypto.AddressHash([]byte("co-author"))).String()
+
+	_, err := ms.CreatePost(wctx, &types.MsgCreatePost{Creator: creator1.String(), Title: "draft", Body: "body"})
+	require.NoError(t, err)
+	_, err = ms.AddEditor(wctx, &types.MsgAddEditor{Creator: creator1.String(), Id: 1, Editor: creator2.String()})
+	require.NoError(t, err)
+
+	// Test: Only the creator names co-authors
+	setCoAuthors := &types.MsgSetCoAuthors{Creator: creator1.String(), Id: 1, CoAuthors: []string{creator1.String(), coAuthor}, ApprovalThreshold: 2}
+	_, err = ms.SetCoAuthors(wctx, &types.MsgSetCoAuthors{Creator: creator2.String(), Id: 1, CoAuthors: setCoAuthors.CoAuthors, ApprovalThreshold: 2})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = ms.SetCoAuthors(wctx, setCoAuthors)
+	require.NoError(t, err)
+
+	// Test: Editors can no longer update the post directly
+	_, err = ms.UpdatePost(wctx, &types.MsgUpdatePost{Creator: creator2.String(), Id: 1, Title: "sneaky", Body: "body"})
+	require.ErrorIs(t, err, types.ErrApprovalRequired)
+
+	// Test: Only co-authors may propose
+	_, err = ms.ProposePostChange(wctx, &types.MsgProposePostChange{Creator: creator2.String(), PostId: 1, Title: "final", Body: "body"})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	proposed, err := ms.ProposePostChange(wctx, &types.MsgProposePostChange{Creator: creator1.String(), PostId: 1, Title: "final", Body: "new body"})
+	require.NoError(t, err)
+	require.False(t, proposed.Applied)
+
+	pending, err := k.PostChangeProposals(wctx, &types.QueryPostChangeProposalsRequest{PostId: 1})
+	require.NoError(t, err)
+	require.Len(t, pending.Proposals, 1)
+	require.Equal(t, []string{creator1.String()}, pending.Proposals[0].Approvals)
+
+	// Test: Approvals are counted once per co-author
+	_, err = ms.ApprovePostChange(wctx, &types.MsgApprovePostChange{Creator: creator1.String(), ProposalId: proposed.ProposalId})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	post, _ := k.GetPost(wctx, 1)
+	require.Equal(t, "draft", post.Title)
+
+	// Test: Reaching the threshold applies the change and closes the proposal
+	approved, err := ms.ApprovePostChange(wctx, &types.MsgApprovePostChange{Creator: coAuthor, ProposalId: proposed.ProposalId})
+	require.NoError(t, err)
+	require.True(t, approved.Applied)
+	post, _ = k.GetPost(wctx, 1)
+	require.Equal(t, "final", post.Title)
+	require.Equal(t, "new body", post.Body)
+	require.Equal(t, uint64(1), k.GetPostEditCount(wctx, 1))
+	_, found := k.GetPostChangeProposal(wctx, proposed.ProposalId)
+	require.False(t, found)
+}
+
+func TestPostChangeProposalExpiry(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	wctx := sdk.UnwrapSDKContext(ctx).WithBlockTime(start)
+	coAuthor := sdk.AccAddress(crypto.AddressHash([]byte("co-author"))).String()
+
+	params := types.DefaultParams()
+	params.ChangeProposalTtl = 60
+	require.NoError(t, k.SetParams(wctx, params))
+
+	_, err := ms.CreatePost(wctx, &types.MsgCreatePost{Creator: creator1.String(), Title: "draft", Body: "body"})
+	require.NoError(t, err)
+	_, err = ms.SetCoAuthors(wctx, &types.MsgSetCoAuthors{Creator: creator1.String(), Id: 1, CoAuthors: []string{creator1.String(), coAuthor}, ApprovalThreshold: 2})
+	require.NoError(t, err)
+	proposed, err := ms.ProposePostChange(wctx, &types.MsgProposePostChange{Creator: coAuthor, PostId: 1, Title: "final"})
+	require.NoError(t, err)
+
+	// Test: Proposals survive until their expiry time
+	require.NoError(t, k.EndBlocker(wctx.WithBlockTime(start.Add(59*time.Second))))
+	_, found := k.GetPostChangeProposal(wctx, proposed.ProposalId)
+	require.True(t, found)
+
+	require.NoError(t, k.EndBlocker(wctx.WithBlockTime(start.Add(60*time.Second))))
+	_, found = k.GetPostChangeProposal(wctx, proposed.ProposalId)
+	require.False(t, found)
+	_, err = ms.ApprovePostChange(wctx, &types.MsgApprovePostChange{Creator: creator1.String(), ProposalId: proposed.ProposalId})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+
+	// Test: Deleting the post drops its proposals
+	proposed, err = ms.ProposePostChange(wctx, &types.MsgProposePostChange{Creator: coAuthor, PostId: 1, Title: "final"})
+	require.NoError(t, err)
+	deletion, err := ms.ProposePostChange(wctx, &types.MsgProposePostChange{Creator: coAuthor, PostId: 1, Kind: types.PostChangeKind_POST_CHANGE_KIND_DELETE})
+	require.NoError(t, err)
+	_, err = ms.ApprovePostChange(wctx, &types.MsgApprovePostChange{Creator: creator1.String(), ProposalId: deletion.ProposalId})
+	require.NoError(t, err)
+	_, found = k.GetPostChangeProposal(wctx, proposed.ProposalId)
+	require.False(t, found)
+}
+
+func TestPostChangeKinds(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	wctx := sdk.UnwrapSDKContext(ctx).WithBlockTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	coAuthor := sdk.AccAddress(crypto.AddressHash([]byte("co-author"))).String()
+
+	_, err := ms.CreatePost(wctx, &types.MsgCreatePost{Creator: creator1.String(), Title: "draft", Body: "body"})
+	require.NoError(t, err)
+	_, err = ms.SetCoAuthors(wctx, &types.MsgSetCoAuthors{Creator: creator1.String(), Id: 1, CoAuthors: []string{creator1.String(), coAuthor}, ApprovalThreshold: 2})
+	require.NoError(t, err)
+
+	// Test: The creator alone can no longer change the co-authors or delete
+	_, err = ms.SetCoAuthors(wctx, &types.MsgSetCoAuthors{Creator: creator1.String(), Id: 1})
+	require.ErrorIs(t, err, types.ErrApprovalRequired)
+	_, err = ms.SetCoAuthors(wctx, &types.MsgSetCoAuthors{Creator: creator1.String(), Id: 1, CoAuthors: []string{creator1.String(), coAuthor}, ApprovalThreshold: 1})
+	require.ErrorIs(t, err, types.ErrApprovalRequired)
+	_, err = ms.DeletePost(wctx, &types.MsgDeletePost{Creator: creator1.String(), Id: 1})
+	require.ErrorIs(t, err, types.ErrApprovalRequired)
+	_, err = ms.BatchPostOps(wctx, &types.MsgBatchPostOps{Creator: creator1.String(), Ops: []types.PostOp{{OpType: types.PostOpType_POST_OP_TYPE_DELETE, Id: 1}}})
+	require.ErrorIs(t, err, types.ErrApprovalRequired)
+
+	// Test: Proposals are checked against the version they expect
+	post, _ := k.GetPost(wctx, 1)
+	_, err = ms.ProposePostChange(wctx, &types.MsgProposePostChange{Creator: creator1.String(), PostId: 1, Title: "final", ExpectedVersion: post.Version - 1})
+	require.ErrorIs(t, err, types.ErrVersionConflict)
+
+	// Test: A change approved after another one was applied is refused
+	stale, err := ms.ProposePostChange(wctx, &types.MsgProposePostChange{Creator: creator1.String(), PostId: 1, Title: "stale", Body: "stale"})
+	require.NoError(t, err)
+	fresh, err := ms.ProposePostChange(wctx, &types.MsgProposePostChange{Creator: creator1.String(), PostId: 1, Title: "fresh", Body: "fresh", ExpectedVersion: post.Version})
+	require.NoError(t, err)
+	pending, err := k.PostChangeProposals(wctx, &types.QueryPostChangeProposalsRequest{PostId: 1})
+	require.NoError(t, err)
+	require.Equal(t, post.Version, pending.Proposals[0].BaseVersion)
+	_, err = ms.ApprovePostChange(wctx, &types.MsgApprovePostChange{Creator: coAuthor, ProposalId: fresh.ProposalId})
+	require.NoError(t, err)
+	_, err = ms.ApprovePostChange(wctx, &types.MsgApprovePostChange{Creator: coAuthor, ProposalId: stale.ProposalId})
+	require.ErrorIs(t, err, types.ErrVersionConflict)
+	post, _ = k.GetPost(wctx, 1)
+	require.Equal(t, "fresh", post.Title)
+
+	// Test: Co-authors and threshold change once approved
+	lower, err := ms.ProposePostChange(wctx, &types.MsgProposePostChange{Creator: coAuthor, PostId: 1, Kind: types.PostChangeKind_POST_CHANGE_KIND_CO_AUTHORS, CoAuthors: []string{creator1.String(), coAuthor}, ApprovalThreshold: 1})
+	require.NoError(t, err)
+	require.False(t, lower.Applied)
+	post, _ = k.GetPost(wctx, 1)
+	require.Equal(t, uint32(2), post.ApprovalThreshold)
+	_, err = ms.ApprovePostChange(wctx, &types.MsgApprovePostChange{Creator: creator1.String(), ProposalId: lower.ProposalId})
+	require.NoError(t, err)
+	post, _ = k.GetPost(wctx, 1)
+	require.Equal(t, uint32(1), post.ApprovalThreshold)
+	_, found := k.GetPostChangeProposal(wctx, stale.ProposalId)
+	require.False(t, found)
+
+	// Test: Deletion applies at once when the threshold is met
+	deletion, err := ms.ProposePostChange(wctx, &types.MsgProposePostChange{Creator: coAuthor, PostId: 1, Kind: types.PostChangeKind_POST_CHANGE_KIND_DELETE})
+	require.NoError(t, err)
+	require.True(t, deletion.Applied)
+	_, found = k.GetPost(wctx, 1)
+	require.False(t, found)
+	_, found = k.GetDeletedPost(wctx, 1)
+	require.True(t, found)
+
+	// Test: Ending co-authorship restores direct edits and deletion
+	_, err = ms.CreatePost(wctx, &types.MsgCreatePost{Creator: creator1.String(), Title: "second", Body: "body"})
+	require.NoError(t, err)
+	_, err = ms.SetCoAuthors(wctx, &types.MsgSetCoAuthors{Creator: creator1.String(), Id: 2, CoAuthors: []string{coAuthor}, ApprovalThreshold: 1})
+	require.NoError(t, err)
+	end, err := ms.ProposePostChange(wctx, &types.MsgProposePostChange{Creator: coAuthor, PostId: 2, Kind: types.PostChangeKind_POST_CHANGE_KIND_CO_AUTHORS})
+	require.NoError(t, err)
+	require.True(t, end.Applied)
+	_, err = ms.DeletePost(wctx, &types.MsgDeletePost{Creator: creator1.String(), Id: 2})
+	require.NoError(t, err)
+}
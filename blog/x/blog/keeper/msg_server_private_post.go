@@ -0,0 +1,171 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"blog/x/blog/types"
+)
+
+// CreatePrivatePost creates a post whose body was encrypted by the creator.
+// The chain only stores the ciphertext and the key envelopes of the readers.
+func (k msgServer) CreatePrivatePost(goCtx context.Context, msg *types.MsgCreatePrivatePost) (*types.MsgCreatePrivatePostResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	id, err := k.createPost(ctx, msg.Creator, types.Post{
+		Title:        msg.Title,
+		Body:         msg.Body,
+		BodyFormat:   types.BodyFormat_BODY_FORMAT_ENCRYPTED,
+		Slug:         msg.Slug,
+		Summary:      msg.Summary,
+		KeyEnvelopes: msg.KeyEnvelopes,
+		Price:        msg.Price,
+		BodyUri:      msg.BodyUri,
+		Tags:         msg.Tags,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreatePrivatePostResponse{
+		Id: id,
+	}, nil
+}
+
+// GrantReadAccess adds the key envelope of a new reader to an encrypted post
+// of the signer and releases the payment of the reader held in escrow
+func (k msgServer) GrantReadAccess(goCtx context.Context, msg *types.MsgGrantReadAccess) (*types.MsgGrantReadAccessResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	post, err := k.validateEncryptedPostAndOwnership(ctx, msg.Id, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, found := types.KeyEnvelopeOf(post.KeyEnvelopes, msg.Envelope.Reader); found {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "%s can already read post %d", msg.Envelope.Reader, msg.Id)
+	}
+
+	// a purchase waiting for this grant is paid to the creator
+	if err := k.ReleasePurchase(ctx, msg.Envelope.Reader, post); err != nil {
+		return nil, err
+	}
+
+	post.KeyEnvelopes = append(post.KeyEnvelopes, msg.Envelope)
+	k.SetPost(ctx, post)
+	k.AppendPostLogEntry(ctx, post.Id, types.PostLogAction_POST_LOG_ACTION_GRANT_READ_ACCESS, msg.Creator)
+
+	// Emit event
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeGrantReadAccess,
+			sdk.NewAttribute(types.AttributeKeyPostID, fmt.Sprintf("%d", msg.Id)),
+			sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyReader, msg.Envelope.Reader),
+		),
+	)
+
+	return &types.MsgGrantReadAccessResponse{}, nil
+}
+
+// RevokeReadAccess removes the key envelope of a reader from an encrypted post
+// of the signer. When the message rotates the content key, the new envelopes
+// must be for exactly the remaining readers.
+func (k msgServer) RevokeReadAccess(goCtx context.Context, msg *types.MsgRevokeReadAccess) (*types.MsgRevokeReadAccessResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	post, err := k.validateEncryptedPostAndOwnership(ctx, msg.Id, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	// purchasers keep the access they paid for
+	if k.HasEntitlement(ctx, msg.Reader, msg.Id) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s purchased access to post %d", msg.Reader, msg.Id)
+	}
+
+	remaining := make([]types.KeyEnvelope, 0, len(post.KeyEnvelopes))
+	for _, env := range post.KeyEnvelopes {
+		if env.Reader != msg.Reader {
+			remaining = append(remaining, env)
+		}
+	}
+	if len(remaining) == len(post.KeyEnvelopes) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "%s cannot read post %d", msg.Reader, msg.Id)
+	}
+
+	rotated := len(msg.KeyEnvelopes) > 0
+	if rotated {
+		// a rotation rewrites the body, which co-authors must approve
+		if post.RequiresApproval() {
+			return nil, errorsmod.Wrapf(types.ErrApprovalRequired, "post %d requires approval to rotate its body", msg.Id)
+		}
+		if len(msg.KeyEnvelopes) != len(remaining) {
+			return nil, errorsmod.Wrap(types.ErrInvalidKeyEnvelope, "rotated envelopes must be for the remaining readers")
+		}
+		for _, env := range remaining {
+			if _, found := types.KeyEnvelopeOf(msg.KeyEnvelopes, env.Reader); !found {
+				return nil, errorsmod.Wrapf(types.ErrInvalidKeyEnvelope, "missing the rotated envelope of %s", env.Reader)
+			}
+		}
+
+		consumePostBytesGas(ctx, k.GetParams(ctx).UpdateGasPerByte, len(msg.Body)+len(msg.BodyUri), "blog post update bytes")
+		post.Body = msg.Body
+		post.BodyUri = msg.BodyUri
+		remaining = msg.KeyEnvelopes
+	}
+
+	post.KeyEnvelopes = remaining
+	if rotated {
+		// the rotated body is an edit of the post
+		k.applyPostUpdate(ctx, post, msg.Creator)
+	} else {
+		k.SetPost(ctx, post)
+	}
+	k.AppendPostLogEntry(ctx, post.Id, types.PostLogAction_POST_LOG_ACTION_REVOKE_READ_ACCESS, msg.Creator)
+
+	// Emit event
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRevokeReadAccess,
+			sdk.NewAttribute(types.AttributeKeyPostID, fmt.Sprintf("%d", msg.Id)),
+			sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyReader, msg.Reader),
+			sdk.NewAttribute(types.AttributeKeyRotated, strconv.FormatBool(rotated)),
+		),
+	)
+
+	return &types.MsgRevokeReadAccessResponse{}, nil
+}
+
+// validateEncryptedPostAndOwnership checks that the post exists, is owned by
+// creator and is encrypted
+func (k msgServer) validateEncryptedPostAndOwnership(ctx sdk.Context, postID uint64, creator string) (types.Post, error) {
+	post, err := k.validatePostAndOwnership(ctx, postID, creator)
+	if err != nil {
+		return types.Post{}, err
+	}
+
+	if post.BodyFormat != types.BodyFormat_BODY_FORMAT_ENCRYPTED {
+		return types.Post{}, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "post %d is not encrypted", postID)
+	}
+
+	return post, nil
+}
@@ -0,0 +1,154 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"blog/x/blog/types"
+)
+
+func TestPrivatePost(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	wctx := sdk.UnwrapSDKContext(ctx)
+
+	owner, reader, other := secp256k1.GenPrivKey(), secp256k1.GenPrivKey(), secp256k1.GenPrivKey()
+	addr := func(priv *secp256k1.PrivKey) string { return sdk.AccAddress(priv.PubKey().Address()).String() }
+	seal := func(priv *secp256k1.PrivKey, key []byte) types.KeyEnvelope {
+		env, err := types.SealContentKey(addr(priv), priv.PubKey().Bytes(), key)
+		require.NoError(t, err)
+		return env
+	}
+	decrypt := func(priv *secp256k1.PrivKey) (string, error) {
+		post, found := k.GetPost(wctx, 1)
+		require.True(t, found)
+		env, found := types.KeyEnvelopeOf(post.KeyEnvelopes, addr(priv))
+		if !found {
+			return "", sdkerrors.ErrUnauthorized
+		}
+		key, err := types.OpenContentKey(env, priv.Key)
+		require.NoError(t, err)
+		return types.DecryptBody(key, post.Body)
+	}
+
+	key, err := types.NewContentKey()
+	require.NoError(t, err)
+	body, err := types.EncryptBody(key, "secret plans")
+	require.NoError(t, err)
+
+	// Test: The creator must hold an envelope
+	_, err = ms.CreatePrivatePost(wctx, &types.MsgCreatePrivatePost{Creator: addr(owner), Title: "plans", Body: body, KeyEnvelopes: []types.KeyEnvelope{seal(reader, key)}})
+	require.ErrorIs(t, err, types.ErrInvalidKeyEnvelope)
+
+	// Test: The body is stored encrypted and only the title is searchable
+	res, err := ms.CreatePrivatePost(wctx, &types.MsgCreatePrivatePost{Creator: addr(owner), Title: "plans", Body: body, KeyEnvelopes: []types.KeyEnvelope{seal(owner, key)}})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.Id)
+	post, _ := k.GetPost(wctx, 1)
+	require.Equal(t, types.BodyFormat_BODY_FORMAT_ENCRYPTED, post.BodyFormat)
+	require.NotContains(t, post.Body, "secret")
+	require.NotContains(t, types.PostTerms(post), "secret")
+	plain, err := decrypt(owner)
+	require.NoError(t, err)
+	require.Equal(t, "secret plans", plain)
+
+	// Test: Only the owner grants access, once per reader
+	_, err = ms.GrantReadAccess(wctx, &types.MsgGrantReadAccess{Creator: addr(reader), Id: 1, Envelope: seal(reader, key)})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = ms.GrantReadAccess(wctx, &types.MsgGrantReadAccess{Creator: addr(owner), Id: 1, Envelope: seal(reader, key)})
+	require.NoError(t, err)
+	_, err = ms.GrantReadAccess(wctx, &types.MsgGrantReadAccess{Creator: addr(owner), Id: 1, Envelope: seal(reader, key)})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	_, err = ms.GrantReadAccess(wctx, &types.MsgGrantReadAccess{Creator: addr(owner), Id: 1, Envelope: seal(other, key)})
+	require.NoError(t, err)
+	plain, err = decrypt(reader)
+	require.NoError(t, err)
+	require.Equal(t, "secret plans", plain)
+
+	// Test: Encrypted posts are neither patched nor turned into plain posts
+	_, err = ms.PatchPost(wctx, &types.MsgPatchPost{Creator: addr(owner), Id: 1, Ops: []types.PatchOp{{Start: 0, End: 1, Text: "x"}}, ExpectedRevision: types.PostRevision(post)})
+	require.ErrorContains(t, err, "encrypted")
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	_, err = ms.UpdatePost(wctx, &types.MsgUpdatePost{Creator: addr(owner), Id: 1, Title: "plans", Body: "secret plans"})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// Test: A revocation rotating the key must cover the remaining readers
+	newKey, err := types.NewContentKey()
+	require.NoError(t, err)
+	newBody, err := types.EncryptBody(newKey, "secret plans")
+	require.NoError(t, err)
+	_, err = ms.RevokeReadAccess(wctx, &types.MsgRevokeReadAccess{Creator: addr(owner), Id: 1, Reader: addr(reader), Body: newBody, KeyEnvelopes: []types.KeyEnvelope{seal(owner, newKey)}})
+	require.ErrorIs(t, err, types.ErrInvalidKeyEnvelope)
+	wctx = wctx.WithBlockTime(wctx.BlockTime().Add(time.Hour))
+	_, err = ms.RevokeReadAccess(wctx, &types.MsgRevokeReadAccess{Creator: addr(owner), Id: 1, Reader: addr(reader), Body: newBody, KeyEnvelopes: []types.KeyEnvelope{seal(owner, newKey), seal(other, newKey)}})
+	require.NoError(t, err)
+
+	// Test: The rotation is recorded as an edit of the post
+	post, _ = k.GetPost(wctx, 1)
+	require.Equal(t, wctx.BlockTime(), post.LastUpdatedAt)
+	require.Equal(t, uint64(1), k.GetPostEditCount(wctx, 1))
+	_, err = decrypt(reader)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	plain, err = decrypt(other)
+	require.NoError(t, err)
+	require.Equal(t, "secret plans", plain)
+
+	// Test: A revocation without rotation only removes the envelope
+	_, err = ms.RevokeReadAccess(wctx, &types.MsgRevokeReadAccess{Creator: addr(owner), Id: 1, Reader: addr(other)})
+	require.NoError(t, err)
+	_, err = ms.RevokeReadAccess(wctx, &types.MsgRevokeReadAccess{Creator: addr(owner), Id: 1, Reader: addr(other)})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	post, _ = k.GetPost(wctx, 1)
+	require.Len(t, post.KeyEnvelopes, 1)
+
+	// Test: Access changes are logged
+	var actions []types.PostLogAction
+	for _, entry := range k.GetPostLog(wctx, 1) {
+		actions = append(actions, entry.Action)
+	}
+	require.Equal(t, []types.PostLogAction{
+		types.PostLogAction_POST_LOG_ACTION_CREATE,
+		types.PostLogAction_POST_LOG_ACTION_GRANT_READ_ACCESS,
+		types.PostLogAction_POST_LOG_ACTION_GRANT_READ_ACCESS,
+		types.PostLogAction_POST_LOG_ACTION_UPDATE,
+		types.PostLogAction_POST_LOG_ACTION_REVOKE_READ_ACCESS,
+		types.PostLogAction_POST_LOG_ACTION_REVOKE_READ_ACCESS,
+	}, actions)
+
+	// Test: Plain posts have no envelopes
+	_, err = ms.CreatePost(wctx, &types.MsgCreatePost{Creator: addr(owner), Title: "public", Body: "hello"})
+	require.NoError(t, err)
+	_, err = ms.GrantReadAccess(wctx, &types.MsgGrantReadAccess{Creator: addr(owner), Id: 2, Envelope: seal(reader, key)})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// Test: The ciphertext may be stored off chain at the body URI
+	_, err = ms.CreatePrivatePost(wctx, &types.MsgCreatePrivatePost{Creator: addr(owner), Title: "book", KeyEnvelopes: []types.KeyEnvelope{seal(owner, key)}})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	res, err = ms.CreatePrivatePost(wctx, &types.MsgCreatePrivatePost{Creator: addr(owner), Title: "book", BodyUri: "ipfs://bafybook", KeyEnvelopes: []types.KeyEnvelope{seal(owner, key), seal(reader, key)}})
+	require.NoError(t, err)
+	post, _ = k.GetPost(wctx, res.Id)
+	require.Empty(t, post.Body)
+	require.Equal(t, "ipfs://bafybook", post.BodyUri)
+	require.NoError(t, post.Validate())
+
+	// Test: A rotation replaces the body URI
+	_, err = ms.RevokeReadAccess(wctx, &types.MsgRevokeReadAccess{Creator: addr(owner), Id: res.Id, Reader: addr(reader), BodyUri: "ipfs://bafyrotated", KeyEnvelopes: []types.KeyEnvelope{seal(owner, newKey)}})
+	require.NoError(t, err)
+	post, _ = k.GetPost(wctx, res.Id)
+	require.Equal(t, "ipfs://bafyrotated", post.BodyUri)
+	require.Len(t, post.KeyEnvelopes, 1)
+
+	// Test: Co-authored posts need approval to rotate the body
+	_, err = ms.GrantReadAccess(wctx, &types.MsgGrantReadAccess{Creator: addr(owner), Id: res.Id, Envelope: seal(reader, newKey)})
+	require.NoError(t, err)
+	_, err = ms.SetCoAuthors(wctx, &types.MsgSetCoAuthors{Creator: addr(owner), Id: res.Id, CoAuthors: []string{addr(owner), addr(other)}, ApprovalThreshold: 2})
+	require.NoError(t, err)
+	_, err = ms.RevokeReadAccess(wctx, &types.MsgRevokeReadAccess{Creator: addr(owner), Id: res.Id, Reader: addr(reader), BodyUri: "ipfs://bafyagain", KeyEnvelopes: []types.KeyEnvelope{seal(owner, key)}})
+	require.ErrorIs(t, err, types.ErrApprovalRequired)
+	_, err = ms.RevokeReadAccess(wctx, &types.MsgRevokeReadAccess{Creator: addr(owner), Id: res.Id, Reader: addr(reader)})
+	require.NoError(t, err)
+}
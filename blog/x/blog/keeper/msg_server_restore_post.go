@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"blog/x/blog/types"
+)
+
+// RestorePost moves a post out of the trash if the signer was one of its
+// editors and the retention period has not elapsed. The post keeps its ID but
+// gets a new slug if another post took its slug meanwhile. It goes back to the
+// series it was removed from that still exist and have room, at its previous
+// position, and gets its edit count back.
+func (k msgServer) RestorePost(goCtx context.Context, msg *types.MsgRestorePost) (*types.MsgRestorePostResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	deleted, found := k.GetDeletedPost(ctx, msg.Id)
+	if !found {
+		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("deleted post %d doesn't exist", msg.Id))
+	}
+	if !k.HasEditor(deleted.Post, msg.Creator) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect editor")
+	}
+	if expiry, ok := k.trashExpiry(ctx, deleted); ok && !ctx.BlockTime().Before(expiry) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "the recovery window of post %d ended at %s", msg.Id, expiry)
+	}
+
+	post := deleted.Post
+	if _, taken := k.GetPostIDBySlug(ctx, post.Slug); taken {
+		post.Slug = k.availableSlug(ctx, post.Slug)
+	}
+	post.Version++
+	k.RemoveDeletedPost(ctx, msg.Id)
+	k.SetPost(ctx, post)
+	k.SetPostEditCount(ctx, post.Id, deleted.Edits)
+	for _, position := range deleted.Series {
+		series, found := k.GetSeries(ctx, position.SeriesId)
+		if !found || len(series.PostIds) >= types.MaxSeriesPosts || slices.Contains(series.PostIds, post.Id) {
+			continue
+		}
+		i := min(int(position.Position), len(series.PostIds))
+		series.PostIds = slices.Insert(series.PostIds, i, post.Id)
+		k.SetSeries(ctx, series)
+		k.emitUpdateSeriesEvent(ctx, series, msg.Creator)
+	}
+	k.AppendPostLogEntry(ctx, post.Id, types.PostLogAction_POST_LOG_ACTION_RESTORE, msg.Creator)
+
+	// Emit event
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRestorePost,
+			sdk.NewAttribute(types.AttributeKeyPostID, fmt.Sprintf("%d", msg.Id)),
+			sdk.NewAttribute(types.AttributeKeyEditor, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeySlug, post.Slug),
+		),
+	)
+
+	return &types.MsgRestorePostResponse{}, nil
+}
@@ -0,0 +1,120 @@
+package keeper_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cometbft/cometbft/crypto"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"blog/x/blog/types"
+)
+
+func TestSoftDelete(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	at := func(seconds int) sdk.Context {
+		return sdk.UnwrapSDKContext(ctx).WithBlockTime(start.Add(time.Duration(seconds) * time.Second))
+	}
+	stranger := sdk.AccAddress(crypto.AddressHash([]byte("stranger"))).String()
+
+	params := types.DefaultParams()
+	params.TrashRetention = 100
+	require.NoError(t, k.SetParams(at(0), params))
+
+	for _, title := range []string{"first", "second"} {
+		_, err := ms.CreatePost(at(0), &types.MsgCreatePost{Creator: creator1.String(), Title: title, Body: "body"})
+		require.NoError(t, err)
+	}
+	_, err := ms.AddEditor(at(0), &types.MsgAddEditor{Creator: creator1.String(), Id: 1, Editor: creator2.String()})
+	require.NoError(t, err)
+
+	// Test: A delete by an editor moves the post to the trash
+	_, err = ms.DeletePost(at(10), &types.MsgDeletePost{Creator: creator2.String(), Id: 1})
+	require.NoError(t, err)
+	_, found := k.GetPost(at(10), 1)
+	require.False(t, found)
+	trash, err := k.DeletedPosts(at(10), &types.QueryDeletedPostsRequest{Creator: creator1.String()})
+	require.NoError(t, err)
+	require.Len(t, trash.Posts, 1)
+	require.Equal(t, creator2.String(), trash.Posts[0].DeletedBy)
+	require.Equal(t, start.Add(10*time.Second), trash.Posts[0].DeletedAt)
+
+	// Test: Only editors of the deleted post may restore it
+	_, err = ms.RestorePost(at(20), &types.MsgRestorePost{Creator: stranger, Id: 1})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = ms.RestorePost(at(20), &types.MsgRestorePost{Creator: creator1.String(), Id: 1})
+	require.NoError(t, err)
+	post, found := k.GetPost(at(20), 1)
+	require.True(t, found)
+	require.Equal(t, "first", post.Title)
+	res, err := k.ShowPostBySlug(at(20), &types.QueryShowPostBySlugRequest{Slug: "first"})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.Post.Id)
+	trash, err = k.DeletedPosts(at(20), &types.QueryDeletedPostsRequest{Creator: creator1.String()})
+	require.NoError(t, err)
+	require.Empty(t, trash.Posts)
+
+	// Test: Creators that are not addresses are rejected
+	for _, creator := range []string{"", "not-an-address", strings.Repeat("a", 300)} {
+		_, err = k.DeletedPosts(at(20), &types.QueryDeletedPostsRequest{Creator: creator})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	}
+
+	// Test: Restores are refused once the recovery window has elapsed
+	_, err = ms.DeletePost(at(30), &types.MsgDeletePost{Creator: creator1.String(), Id: 2})
+	require.NoError(t, err)
+	_, err = ms.RestorePost(at(130), &types.MsgRestorePost{Creator: creator1.String(), Id: 2})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// Test: EndBlock purges expired trash only
+	require.NoError(t, k.EndBlocker(at(129)))
+	_, found = k.GetDeletedPost(at(129), 2)
+	require.True(t, found)
+	require.NoError(t, k.EndBlocker(at(130)))
+	_, found = k.GetDeletedPost(at(130), 2)
+	require.False(t, found)
+}
+
+func TestRestorePostKeepsSeriesAndEdits(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	wctx := sdk.UnwrapSDKContext(ctx).WithBlockTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	for _, title := range []string{"one", "two", "three"} {
+		_, err := ms.CreatePost(wctx, &types.MsgCreatePost{Creator: creator1.String(), Title: title, Body: "body"})
+		require.NoError(t, err)
+	}
+	_, err := ms.UpdatePost(wctx, &types.MsgUpdatePost{Creator: creator1.String(), Id: 2, Title: "two", Body: "edited"})
+	require.NoError(t, err)
+	_, err = ms.CreateSeries(wctx, &types.MsgCreateSeries{Creator: creator1.String(), Title: "all", PostIds: []uint64{1, 2, 3}})
+	require.NoError(t, err)
+	_, err = ms.CreateSeries(wctx, &types.MsgCreateSeries{Creator: creator1.String(), Title: "gone", PostIds: []uint64{2}})
+	require.NoError(t, err)
+
+	// Test: Deleting a post records its series positions and edits
+	_, err = ms.DeletePost(wctx, &types.MsgDeletePost{Creator: creator1.String(), Id: 2})
+	require.NoError(t, err)
+	deleted, found := k.GetDeletedPost(wctx, 2)
+	require.True(t, found)
+	require.Equal(t, []types.SeriesPosition{{SeriesId: 1, Position: 1}, {SeriesId: 2, Position: 0}}, deleted.Series)
+	require.Equal(t, uint64(1), deleted.Edits)
+	require.Equal(t, uint64(0), k.GetCreatorEditCount(wctx, creator1.String()))
+
+	// Test: Restoring puts the post back in place and restores its edit counters
+	_, err = ms.RemoveSeriesPost(wctx, &types.MsgRemoveSeriesPost{Creator: creator1.String(), SeriesId: 1, PostId: 3})
+	require.NoError(t, err)
+	_, err = ms.RestorePost(wctx, &types.MsgRestorePost{Creator: creator1.String(), Id: 2})
+	require.NoError(t, err)
+	series, _ := k.GetSeries(wctx, 1)
+	require.Equal(t, []uint64{1, 2}, series.PostIds)
+	series, _ = k.GetSeries(wctx, 2)
+	require.Equal(t, []uint64{2}, series.PostIds)
+	require.ElementsMatch(t, []uint64{1, 2}, k.GetPostSeriesIDs(wctx, 2))
+	require.Equal(t, uint64(1), k.GetPostEditCount(wctx, 2))
+	require.Equal(t, uint64(1), k.GetCreatorEditCount(wctx, creator1.String()))
+}
@@ -0,0 +1,183 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"blog/x/blog/types"
+)
+
+// CreateSeries creates a new series owned by the signer
+func (k msgServer) CreateSeries(goCtx context.Context, msg *types.MsgCreateSeries) (*types.MsgCreateSeriesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	series := types.Series{
+		Owner:   msg.Creator,
+		Title:   msg.Title,
+		PostIds: msg.PostIds,
+	}
+	for _, postID := range msg.PostIds {
+		if _, err := k.validateSeriesPost(ctx, series, postID, msg.Creator); err != nil {
+			return nil, err
+		}
+	}
+
+	id := k.AppendSeries(ctx, series)
+
+	// Emit event
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateSeries,
+			sdk.NewAttribute(types.AttributeKeySeriesID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyTitle, msg.Title),
+			sdk.NewAttribute(types.AttributeKeyPostIDs, formatSeriesPostIDs(msg.PostIds)),
+		),
+	)
+
+	return &types.MsgCreateSeriesResponse{Id: id}, nil
+}
+
+// AppendSeriesPost adds a post at the end of a series
+func (k msgServer) AppendSeriesPost(goCtx context.Context, msg *types.MsgAppendSeriesPost) (*types.MsgAppendSeriesPostResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	series, found := k.GetSeries(ctx, msg.SeriesId)
+	if !found {
+		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("series %d doesn't exist", msg.SeriesId))
+	}
+	if _, err := k.validateSeriesPost(ctx, series, msg.PostId, msg.Creator); err != nil {
+		return nil, err
+	}
+	for _, postID := range series.PostIds {
+		if postID == msg.PostId {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "post %d is already in the series", msg.PostId)
+		}
+	}
+	if len(series.PostIds) >= types.MaxSeriesPosts {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "a series holds at most %d posts", types.MaxSeriesPosts)
+	}
+
+	series.PostIds = append(series.PostIds, msg.PostId)
+	k.SetSeries(ctx, series)
+	k.emitUpdateSeriesEvent(ctx, series, msg.Creator)
+
+	return &types.MsgAppendSeriesPostResponse{}, nil
+}
+
+// ReorderSeries replaces the order of the posts of a series
+func (k msgServer) ReorderSeries(goCtx context.Context, msg *types.MsgReorderSeries) (*types.MsgReorderSeriesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	series, found := k.GetSeries(ctx, msg.SeriesId)
+	if !found {
+		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("series %d doesn't exist", msg.SeriesId))
+	}
+	if series.Owner != msg.Creator {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
+	}
+
+	// ValidateBasic rules out duplicates, so equal lengths and membership make
+	// post_ids a permutation of the current posts.
+	current := make(map[uint64]bool, len(series.PostIds))
+	for _, postID := range series.PostIds {
+		current[postID] = true
+	}
+	if len(msg.PostIds) != len(series.PostIds) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "post_ids must list every post of the series exactly once")
+	}
+	for _, postID := range msg.PostIds {
+		if !current[postID] {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "post %d is not in the series", postID)
+		}
+	}
+
+	series.PostIds = msg.PostIds
+	k.SetSeries(ctx, series)
+	k.emitUpdateSeriesEvent(ctx, series, msg.Creator)
+
+	return &types.MsgReorderSeriesResponse{}, nil
+}
+
+// RemoveSeriesPost removes a post from a series
+func (k msgServer) RemoveSeriesPost(goCtx context.Context, msg *types.MsgRemoveSeriesPost) (*types.MsgRemoveSeriesPostResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	series, found := k.GetSeries(ctx, msg.SeriesId)
+	if !found {
+		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("series %d doesn't exist", msg.SeriesId))
+	}
+	if series.Owner != msg.Creator {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
+	}
+	remaining := removeSeriesPostID(series.PostIds, msg.PostId)
+	if len(remaining) == len(series.PostIds) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "post %d is not in the series", msg.PostId)
+	}
+
+	series.PostIds = remaining
+	k.SetSeries(ctx, series)
+	k.emitUpdateSeriesEvent(ctx, series, msg.Creator)
+
+	return &types.MsgRemoveSeriesPostResponse{}, nil
+}
+
+// validateSeriesPost checks that signer owns series and that the post exists
+// and may be added to it by signer, as an editor of the post
+func (k msgServer) validateSeriesPost(ctx sdk.Context, series types.Series, postID uint64, signer string) (types.Post, error) {
+	if signer != series.Owner {
+		return types.Post{}, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
+	}
+
+	post, found := k.GetPost(ctx, postID)
+	if !found {
+		return types.Post{}, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("key %d doesn't exist", postID))
+	}
+	if !k.HasEditor(post, signer) {
+		return types.Post{}, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "not an editor of post %d", postID)
+	}
+
+	return post, nil
+}
+
+func (k msgServer) emitUpdateSeriesEvent(ctx sdk.Context, series types.Series, signer string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateSeries,
+			sdk.NewAttribute(types.AttributeKeySeriesID, strconv.FormatUint(series.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyEditor, signer),
+			sdk.NewAttribute(types.AttributeKeyPostIDs, formatSeriesPostIDs(series.PostIds)),
+		),
+	)
+}
+
+// formatSeriesPostIDs renders post IDs as a comma separated list
+func formatSeriesPostIDs(ids []uint64) string {
+	parts := make([]string, len(ids))
+	for i, id := range ids {
+		parts[i] = strconv.FormatUint(id, 10)
+	}
+	return strings.Join(parts, ",")
+}
@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"blog/x/blog/types"
+)
+
+// SetPostTags replaces the tags of a post the signer may edit
+func (k msgServer) SetPostTags(goCtx context.Context, msg *types.MsgSetPostTags) (*types.MsgSetPostTagsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	post, err := k.validatePostAndEditor(ctx, msg.Id, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	if post.RequiresApproval() {
+		return nil, errorsmod.Wrapf(types.ErrApprovalRequired, "post %d requires co-author approval", msg.Id)
+	}
+
+	post.Tags = msg.Tags
+	k.SetPost(ctx, post)
+	k.AppendPostLogEntry(ctx, post.Id, types.PostLogAction_POST_LOG_ACTION_SET_TAGS, msg.Creator)
+
+	// Emit event
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetPostTags,
+			sdk.NewAttribute(types.AttributeKeyPostID, fmt.Sprintf("%d", msg.Id)),
+			sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyTags, strings.Join(msg.Tags, ",")),
+		),
+	)
+
+	return &types.MsgSetPostTagsResponse{}, nil
+}
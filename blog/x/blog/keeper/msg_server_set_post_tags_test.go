@@ -0,0 +1,71 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"blog/x/blog/types"
+)
+
+func TestSetPostTags(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	wctx := sdk.UnwrapSDKContext(ctx)
+
+	for _, msg := range []*types.MsgCreatePost{
+		{Creator: creator1.String(), Title: "first", Body: "body", Tags: []string{"cosmos", "go"}},
+		{Creator: creator2.String(), Title: "second", Body: "body", Tags: []string{"cosmos"}},
+		{Creator: creator1.String(), Title: "third", Body: "body"},
+	} {
+		_, err := ms.CreatePost(wctx, msg)
+		require.NoError(t, err)
+	}
+
+	tagged := func(tag string) []uint64 {
+		res, err := k.ListPost(wctx, &types.QueryListPostRequest{Tag: tag})
+		require.NoError(t, err)
+		ids := make([]uint64, 0, len(res.Post))
+		for _, post := range res.Post {
+			ids = append(ids, post.Id)
+		}
+		return ids
+	}
+
+	// Test: Posts are listed by tag
+	require.Equal(t, []uint64{1, 2}, tagged("cosmos"))
+	require.Equal(t, []uint64{1}, tagged("go"))
+	require.Empty(t, tagged("rust"))
+	res, err := k.ListPost(wctx, &types.QueryListPostRequest{Tag: "cosmos", Creator: creator2.String()})
+	require.NoError(t, err)
+	require.Len(t, res.Post, 1)
+	require.Equal(t, uint64(2), res.Post[0].Id)
+
+	// Test: Editors replace the tags and the index follows
+	_, err = ms.SetPostTags(wctx, &types.MsgSetPostTags{Creator: creator1.String(), Id: 3, Tags: []string{"go"}})
+	require.NoError(t, err)
+	_, err = ms.SetPostTags(wctx, &types.MsgSetPostTags{Creator: creator1.String(), Id: 1})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{2}, tagged("cosmos"))
+	require.Equal(t, []uint64{3}, tagged("go"))
+	post, found := k.GetPost(wctx, 3)
+	require.True(t, found)
+	require.Equal(t, []string{"go"}, post.Tags)
+	require.Equal(t, types.PostLogAction_POST_LOG_ACTION_SET_TAGS, k.GetPostLog(wctx, 3)[1].Action)
+
+	// Test: Deleted posts leave the index
+	_, err = ms.DeletePost(wctx, &types.MsgDeletePost{Creator: creator2.String(), Id: 2})
+	require.NoError(t, err)
+	require.Empty(t, tagged("cosmos"))
+
+	// Test: Only editors set tags, and only valid ones
+	_, err = ms.SetPostTags(wctx, &types.MsgSetPostTags{Creator: creator2.String(), Id: 3, Tags: []string{"go"}})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = ms.SetPostTags(wctx, &types.MsgSetPostTags{Creator: creator1.String(), Id: 3, Tags: []string{"Go"}})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	_, err = k.ListPost(wctx, &types.QueryListPostRequest{Tag: "Not a tag"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"blog/x/blog/types"
+)
+
+// SetProfile replaces the profile of the signer
+func (k msgServer) SetProfile(goCtx context.Context, msg *types.MsgSetProfile) (*types.MsgSetProfileResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	profile := msg.Profile()
+	if profile.DisplayName != "" {
+		if owner, taken := k.GetProfileAddressByName(ctx, profile.DisplayName); taken && owner != msg.Creator {
+			return nil, errorsmod.Wrapf(types.ErrDisplayNameTaken, "display name %q is used by another account", profile.DisplayName)
+		}
+	}
+
+	k.Keeper.SetProfile(ctx, profile)
+
+	// Emit event
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetProfile,
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyName, profile.DisplayName),
+		),
+	)
+
+	return &types.MsgSetProfileResponse{}, nil
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"github.com/cometbft/cometbft/crypto"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/stretchr/testify/require"
 	"testing"
 
@@ -317,3 +318,66 @@ func TestDeleteEditor(t *testing.T) {
 	_, err = ms.DeleteEditor(wctx, msg)
 	require.EqualError(t, err, "creator cannot be deleted from editors: unauthorized")
 }
+
+func TestMsgBatchPostOps(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	wctx := sdk.UnwrapSDKContext(ctx)
+
+	k.SetPost(wctx, types.Post{
+		Id:      1,
+		Creator: creator1.String(),
+		Title:   "Existing Post",
+		Body:    "Existing Body",
+		Editors: []string{creator1.String()},
+	})
+	k.SetPostCount(wctx, 1)
+
+	// Test: Create, update and delete in one batch
+	res, err := ms.BatchPostOps(wctx, &types.MsgBatchPostOps{
+		Creator: creator1.String(),
+		Ops: []types.PostOp{
+			{OpType: types.PostOpType_POST_OP_TYPE_CREATE, Title: "New Post 1", Body: "Body 1"},
+			{OpType: types.PostOpType_POST_OP_TYPE_CREATE, Title: "New Post 2", Body: "Body 2", Slug: "second"},
+			{OpType: types.PostOpType_POST_OP_TYPE_UPDATE, Id: 2, Title: "Updated Post 1", Body: "Updated Body 1"},
+			{OpType: types.PostOpType_POST_OP_TYPE_DELETE, Id: 1},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []types.PostOpResult{{Id: 2}, {Id: 3}, {Id: 2}, {Id: 1}}, res.Results)
+
+	// Verify: The batch was applied in order
+	_, found := k.GetPost(wctx, 1)
+	require.False(t, found, "Post 1 should be deleted")
+	updatedPost, found := k.GetPost(wctx, 2)
+	require.True(t, found, "Post 2 should exist")
+	require.Equal(t, "Updated Post 1", updatedPost.Title)
+	createdPost, found := k.GetPost(wctx, 3)
+	require.True(t, found, "Post 3 should exist")
+	require.Equal(t, "second", createdPost.Slug)
+
+	// Test: A failing op reverts the whole batch
+	_, err = ms.BatchPostOps(wctx, &types.MsgBatchPostOps{
+		Creator: creator2.String(),
+		Ops: []types.PostOp{
+			{OpType: types.PostOpType_POST_OP_TYPE_CREATE, Title: "Never Stored", Body: "Body"},
+			{OpType: types.PostOpType_POST_OP_TYPE_DELETE, Id: 2},
+		},
+	})
+	require.EqualError(t, err, "op 1: incorrect editor: unauthorized")
+	_, found = k.GetPost(wctx, 4)
+	require.False(t, found, "Post created in a failed batch should not be stored")
+	require.Equal(t, uint64(3), k.GetPostCount(wctx))
+
+	// Test: Batches above the params limit are rejected
+	params := types.DefaultParams()
+	params.MaxBatchSize = 1
+	require.NoError(t, k.SetParams(wctx, params))
+	_, err = ms.BatchPostOps(wctx, &types.MsgBatchPostOps{
+		Creator: creator1.String(),
+		Ops: []types.PostOp{
+			{OpType: types.PostOpType_POST_OP_TYPE_DELETE, Id: 2},
+			{OpType: types.PostOpType_POST_OP_TYPE_DELETE, Id: 3},
+		},
+	})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+}
@@ -19,32 +19,94 @@ func (k msgServer) UpdatePost(goCtx context.Context, msg *types.MsgUpdatePost) (
 		return nil, err
 	}
 
-	val, found := k.GetPost(ctx, msg.Id)
-	if !found {
-		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("key %d doesn't exist", msg.Id))
+	if err := k.updatePost(ctx, msg.Creator, msg.Id, msg.Title, msg.Body, msg.BodyFormat, msg.Slug, msg.ExpectedVersion); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdatePostResponse{}, nil
+}
+
+// updatePost replaces the title, body and body format of a post if editor is
+// allowed to edit it. A non-empty slug replaces the current one and a non-zero
+// expectedVersion must match the version of the post.
+func (k msgServer) updatePost(ctx sdk.Context, editor string, id uint64, title string, body string, bodyFormat types.BodyFormat, slug string, expectedVersion uint64) error {
+	val, err := k.validatePostAndEditor(ctx, id, editor)
+	if err != nil {
+		return err
+	}
+
+	if err := validateExpectedVersion(val, expectedVersion); err != nil {
+		return err
+	}
+
+	if val.RequiresApproval() {
+		return errorsmod.Wrapf(types.ErrApprovalRequired, "propose the change to post %d instead", id)
+	}
+
+	// the envelopes only hold for encrypted bodies, so posts cannot be
+	// encrypted or decrypted in place
+	if (val.BodyFormat == types.BodyFormat_BODY_FORMAT_ENCRYPTED) != (bodyFormat == types.BodyFormat_BODY_FORMAT_ENCRYPTED) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "the body of post %d cannot change to or from the encrypted format", id)
 	}
 
-	foundEditor := k.HasEditor(val, msg.Creator)
-	if !foundEditor {
-		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect editor")
+	if slug != "" && slug != val.Slug {
+		if owner, taken := k.GetPostIDBySlug(ctx, slug); taken && owner != id {
+			return errorsmod.Wrapf(types.ErrSlugTaken, "slug %q is used by another post", slug)
+		}
+		val.Slug = slug
 	}
 
+	consumePostBytesGas(ctx, k.GetParams(ctx).UpdateGasPerByte, len(title)+len(body), "blog post update bytes")
+
 	// update val details
-	val.LastUpdatedAt = ctx.BlockHeader().Time
-	val.Body = msg.Body
-	val.Title = msg.Title
-	k.SetPost(ctx, val)
+	val.Body = body
+	val.BodyFormat = bodyFormat
+	val.Title = title
+	k.applyPostUpdate(ctx, val, editor)
+
+	return nil
+}
+
+// applyPostUpdate stores an edited post, counts the edit and emits the update
+// event on behalf of editor with any extra attributes
+func (k msgServer) applyPostUpdate(ctx sdk.Context, post types.Post, editor string, extra ...sdk.Attribute) {
+	post.LastUpdatedAt = ctx.BlockHeader().Time
+	k.SetPost(ctx, post)
+	k.RecordPostEdit(ctx, post)
+	k.AppendPostLogEntry(ctx, post.Id, types.PostLogAction_POST_LOG_ACTION_UPDATE, editor)
 
 	// Emit event
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeUpdatePost,
-			sdk.NewAttribute(types.AttributeKeyPostID, fmt.Sprintf("%d", msg.Id)),
-			sdk.NewAttribute(types.AttributeKeyEditor, msg.Creator),
-			sdk.NewAttribute(types.AttributeKeyTitle, msg.Title),
-			sdk.NewAttribute(types.AttributeKeyUpdateTime, val.LastUpdatedAt.String()),
-		),
+			sdk.NewAttribute(types.AttributeKeyPostID, fmt.Sprintf("%d", post.Id)),
+			sdk.NewAttribute(types.AttributeKeyEditor, editor),
+			sdk.NewAttribute(types.AttributeKeyTitle, post.Title),
+			sdk.NewAttribute(types.AttributeKeySlug, post.Slug),
+			sdk.NewAttribute(types.AttributeKeyUpdateTime, post.LastUpdatedAt.String()),
+		).AppendAttributes(extra...),
 	)
+}
 
-	return &types.MsgUpdatePostResponse{}, nil
+// validatePostAndEditor checks if post exists and if the address is one of its editors
+func (k msgServer) validatePostAndEditor(ctx sdk.Context, postID uint64, editor string) (types.Post, error) {
+	post, found := k.GetPost(ctx, postID)
+	if !found {
+		return types.Post{}, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("key %d doesn't exist", postID))
+	}
+
+	if !k.HasEditor(post, editor) {
+		return types.Post{}, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect editor")
+	}
+
+	return post, nil
+}
+
+// validateExpectedVersion returns a conflict error if expectedVersion is set
+// and the post is at another version
+func validateExpectedVersion(post types.Post, expectedVersion uint64) error {
+	if expectedVersion != 0 && expectedVersion != post.Version {
+		return errorsmod.Wrapf(types.ErrVersionConflict, "post %d is at version %d, expected %d", post.Id, post.Version, expectedVersion)
+	}
+	return nil
 }
@@ -0,0 +1,45 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"blog/x/blog/types"
+)
+
+func TestPostVersion(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	wctx := sdk.UnwrapSDKContext(ctx)
+
+	_, err := ms.CreatePost(wctx, &types.MsgCreatePost{Creator: creator1.String(), Title: "title", Body: "body"})
+	require.NoError(t, err)
+	show, err := k.ShowPost(wctx, &types.QueryShowPostRequest{Id: 1})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), show.Post.Version)
+
+	// Test: Every write increments the version
+	_, err = ms.AddEditor(wctx, &types.MsgAddEditor{Creator: creator1.String(), Id: 1, Editor: creator2.String(), ExpectedVersion: 1})
+	require.NoError(t, err)
+	_, err = ms.UpdatePost(wctx, &types.MsgUpdatePost{Creator: creator2.String(), Id: 1, Title: "title", Body: "edited", ExpectedVersion: 2})
+	require.NoError(t, err)
+	post, _ := k.GetPost(wctx, 1)
+	require.Equal(t, uint64(3), post.Version)
+
+	// Test: A stale expected version is a conflict
+	_, err = ms.UpdatePost(wctx, &types.MsgUpdatePost{Creator: creator1.String(), Id: 1, Title: "title", Body: "lost update", ExpectedVersion: 2})
+	require.ErrorIs(t, err, types.ErrVersionConflict)
+	_, err = ms.DeleteEditor(wctx, &types.MsgDeleteEditor{Creator: creator1.String(), Id: 1, Editor: creator2.String(), ExpectedVersion: 2})
+	require.ErrorIs(t, err, types.ErrVersionConflict)
+	_, err = ms.DeletePost(wctx, &types.MsgDeletePost{Creator: creator1.String(), Id: 1, ExpectedVersion: 2})
+	require.ErrorIs(t, err, types.ErrVersionConflict)
+	post, _ = k.GetPost(wctx, 1)
+	require.Equal(t, "edited", post.Body)
+
+	// Test: Zero skips the check
+	_, err = ms.UpdatePost(wctx, &types.MsgUpdatePost{Creator: creator1.String(), Id: 1, Title: "title", Body: "unchecked"})
+	require.NoError(t, err)
+	_, err = ms.DeletePost(wctx, &types.MsgDeletePost{Creator: creator1.String(), Id: 1, ExpectedVersion: 4})
+	require.NoError(t, err)
+}
@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// paginateIDs applies a PageRequest to an ascending list of post IDs that was
+// computed in memory rather than read from a single store prefix. The page key
+// is the big-endian encoding of the first ID of the next page.
+func paginateIDs(ids []uint64, pageRequest *query.PageRequest) ([]uint64, *query.PageResponse, error) {
+	if pageRequest == nil {
+		pageRequest = &query.PageRequest{}
+	}
+	if len(pageRequest.Key) > 0 && len(pageRequest.Key) != len(GetPostIDBytes(0)) {
+		return nil, nil, status.Error(codes.InvalidArgument, "invalid page key")
+	}
+
+	if pageRequest.Reverse {
+		reversed := make([]uint64, len(ids))
+		for i, id := range ids {
+			reversed[len(ids)-1-i] = id
+		}
+		ids = reversed
+	}
+
+	limit := pageRequest.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+
+	start := 0
+	if len(pageRequest.Key) > 0 {
+		key := GetPostIDFromBytes(pageRequest.Key)
+		start = sort.Search(len(ids), func(i int) bool {
+			if pageRequest.Reverse {
+				return ids[i] <= key
+			}
+			return ids[i] >= key
+		})
+	} else if pageRequest.Offset > 0 {
+		start = len(ids)
+		if pageRequest.Offset < uint64(len(ids)) {
+			start = int(pageRequest.Offset)
+		}
+	}
+
+	end := len(ids)
+	if uint64(end-start) > limit {
+		end = start + int(limit)
+	}
+
+	pageResponse := &query.PageResponse{}
+	if end < len(ids) {
+		pageResponse.NextKey = GetPostIDBytes(ids[end])
+	}
+	if pageRequest.CountTotal && len(pageRequest.Key) == 0 {
+		pageResponse.Total = uint64(len(ids))
+	}
+
+	return ids[start:end], pageResponse, nil
+}
+
+// sortedIDs returns the members of a set of post IDs in ascending order.
+func sortedIDs(set map[uint64]struct{}) []uint64 {
+	ids := make([]uint64, 0, len(set))
+	for id := range set {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
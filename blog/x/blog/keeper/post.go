@@ -12,12 +12,14 @@ import (
 func (k Keeper) AppendPost(ctx sdk.Context, post types.Post) uint64 {
 	count := k.GetPostCount(ctx)
 	post.Id = count + 1
+	post.Version = 1
 	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PostKey))
 	appendedValue := k.cdc.MustMarshal(&post)
 	store.Set(GetPostIDBytes(post.Id), appendedValue)
+	k.updatePostIndexes(ctx, nil, &post)
 	k.SetPostCount(ctx, post.Id)
-	return count
+	return post.Id
 }
 
 func (k Keeper) GetPostCount(ctx sdk.Context) uint64 {
@@ -37,6 +39,10 @@ func GetPostIDBytes(id uint64) []byte {
 	return bz
 }
 
+func GetPostIDFromBytes(bz []byte) uint64 {
+	return binary.BigEndian.Uint64(bz)
+}
+
 func (k Keeper) SetPostCount(ctx sdk.Context, count uint64) {
 	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	store := prefix.NewStore(storeAdapter, []byte{})
@@ -58,15 +64,48 @@ func (k Keeper) GetPost(ctx sdk.Context, id uint64) (val types.Post, found bool)
 	return val, true
 }
 
+// SetPost stores a post, setting its version to one past the stored version
 func (k Keeper) SetPost(ctx sdk.Context, post types.Post) {
+	prev, found := k.GetPost(ctx, post.Id)
+	if found {
+		post.Version = prev.Version + 1
+	} else if post.Version == 0 {
+		post.Version = 1
+	}
 	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PostKey))
 	b := k.cdc.MustMarshal(&post)
 	store.Set(GetPostIDBytes(post.Id), b)
+	if found {
+		k.updatePostIndexes(ctx, &prev, &post)
+	} else {
+		k.updatePostIndexes(ctx, nil, &post)
+	}
 }
 
 func (k Keeper) RemovePost(ctx sdk.Context, id uint64) {
+	prev, found := k.GetPost(ctx, id)
+	if !found {
+		return
+	}
 	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PostKey))
 	store.Delete(GetPostIDBytes(id))
+	k.updatePostIndexes(ctx, &prev, nil)
+}
+
+// GetAllPost returns all posts in ID order
+func (k Keeper) GetAllPost(ctx sdk.Context) (list []types.Post) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PostKey))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Post
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
 }
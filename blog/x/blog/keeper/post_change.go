@@ -0,0 +1,165 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"math"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"blog/x/blog/types"
+)
+
+// AppendPostChangeProposal stores a new proposal and returns its ID
+func (k Keeper) AppendPostChangeProposal(ctx sdk.Context, proposal types.PostChangeProposal) uint64 {
+	proposal.Id = k.GetPostChangeProposalCount(ctx) + 1
+	k.SetPostChangeProposal(ctx, proposal)
+	k.SetPostChangeProposalCount(ctx, proposal.Id)
+	return proposal.Id
+}
+
+func (k Keeper) GetPostChangeProposalCount(ctx sdk.Context) uint64 {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := store.Get(types.KeyPrefix(types.PostChangeProposalCountKey))
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) SetPostChangeProposalCount(ctx sdk.Context, count uint64) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Set(types.KeyPrefix(types.PostChangeProposalCountKey), GetPostIDBytes(count))
+}
+
+func (k Keeper) GetPostChangeProposal(ctx sdk.Context, id uint64) (val types.PostChangeProposal, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PostChangeProposalKey))
+	b := store.Get(GetPostIDBytes(id))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// SetPostChangeProposal stores a proposal along with its expiry and post
+// index entries. The expiry and post of a proposal never change.
+func (k Keeper) SetPostChangeProposal(ctx sdk.Context, proposal types.PostChangeProposal) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PostChangeProposalKey))
+	store.Set(GetPostIDBytes(proposal.Id), k.cdc.MustMarshal(&proposal))
+
+	postStore := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PostChangePostKey))
+	postStore.Set(postChangePostKey(proposal.PostId, proposal.Id), []byte{})
+	if proposal.ExpiresAt != nil {
+		expiryStore := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PostChangeExpiryKey))
+		expiryStore.Set(timeIndexKey(*proposal.ExpiresAt, proposal.Id), []byte{})
+	}
+}
+
+// RemovePostChangeProposal deletes a proposal and its index entries
+func (k Keeper) RemovePostChangeProposal(ctx sdk.Context, id uint64) {
+	proposal, found := k.GetPostChangeProposal(ctx, id)
+	if !found {
+		return
+	}
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	prefix.NewStore(storeAdapter, types.KeyPrefix(types.PostChangeProposalKey)).Delete(GetPostIDBytes(id))
+	prefix.NewStore(storeAdapter, types.KeyPrefix(types.PostChangePostKey)).Delete(postChangePostKey(proposal.PostId, id))
+	if proposal.ExpiresAt != nil {
+		prefix.NewStore(storeAdapter, types.KeyPrefix(types.PostChangeExpiryKey)).Delete(timeIndexKey(*proposal.ExpiresAt, id))
+	}
+}
+
+// GetAllPostChangeProposal returns every pending proposal in ID order
+func (k Keeper) GetAllPostChangeProposal(ctx sdk.Context) (list []types.PostChangeProposal) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PostChangeProposalKey))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.PostChangeProposal
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+	return
+}
+
+// GetPostChangeProposals returns the pending proposals of a post in ID order
+func (k Keeper) GetPostChangeProposals(ctx sdk.Context, postID uint64) []types.PostChangeProposal {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, append(types.KeyPrefix(types.PostChangePostKey), GetPostIDBytes(postID)...))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var proposals []types.PostChangeProposal
+	for ; iterator.Valid(); iterator.Next() {
+		if proposal, found := k.GetPostChangeProposal(ctx, GetPostIDFromBytes(iterator.Key())); found {
+			proposals = append(proposals, proposal)
+		}
+	}
+	return proposals
+}
+
+// removePostChangeProposals drops every pending proposal of a post
+func (k Keeper) removePostChangeProposals(ctx sdk.Context, postID uint64) {
+	for _, proposal := range k.GetPostChangeProposals(ctx, postID) {
+		k.RemovePostChangeProposal(ctx, proposal.Id)
+	}
+}
+
+// updatePostChangeIndex drops the proposals of a removed post.
+func (k Keeper) updatePostChangeIndex(ctx sdk.Context, prev *types.Post, next *types.Post) {
+	if prev != nil && next == nil {
+		k.removePostChangeProposals(ctx, prev.Id)
+	}
+}
+
+// ExpirePostChangeProposals removes the proposals whose expiry time is not
+// after the block time
+func (k Keeper) ExpirePostChangeProposals(ctx sdk.Context) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	expiryStore := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PostChangeExpiryKey))
+	iterator := expiryStore.Iterator(nil, storetypes.PrefixEndBytes(sdk.FormatTimeBytes(ctx.BlockTime())))
+	var expired []uint64
+	for ; iterator.Valid(); iterator.Next() {
+		expired = append(expired, postIDFromIndexKey(iterator.Key()))
+	}
+	iterator.Close()
+
+	for _, id := range expired {
+		proposal, found := k.GetPostChangeProposal(ctx, id)
+		if !found {
+			continue
+		}
+		k.RemovePostChangeProposal(ctx, id)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeExpirePostChange,
+				sdk.NewAttribute(types.AttributeKeyProposalID, strconv.FormatUint(id, 10)),
+				sdk.NewAttribute(types.AttributeKeyPostID, strconv.FormatUint(proposal.PostId, 10)),
+			),
+		)
+	}
+}
+
+// postChangePostKey returns the key of an index entry relative to
+// PostChangePostKey
+func postChangePostKey(postID uint64, proposalID uint64) []byte {
+	return append(GetPostIDBytes(postID), GetPostIDBytes(proposalID)...)
+}
+
+// secondsToDuration converts a number of seconds taken from Params to a
+// duration, saturating instead of overflowing
+func secondsToDuration(seconds uint64) time.Duration {
+	if seconds > uint64(math.MaxInt64/int64(time.Second)) {
+		return math.MaxInt64
+	}
+	return time.Duration(seconds) * time.Second
+}
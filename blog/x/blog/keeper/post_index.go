@@ -0,0 +1,173 @@
+package keeper
+
+import (
+	"time"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+
+	"blog/x/blog/types"
+)
+
+// updatePostIndexes brings the secondary indexes in line with a write of the
+// primary post record. prev is nil when the post is new and next is nil when
+// the post is removed.
+func (k Keeper) updatePostIndexes(ctx sdk.Context, prev *types.Post, next *types.Post) {
+	k.updateSearchIndex(ctx, prev, next)
+	k.updateTimeIndexes(ctx, prev, next)
+	k.updateTagIndex(ctx, prev, next)
+	k.updateSlugIndex(ctx, prev, next)
+	k.updateStats(ctx, prev, next)
+	k.updateSeriesIndex(ctx, prev, next)
+	k.updatePostChangeIndex(ctx, prev, next)
+}
+
+// updateTimeIndexes maintains the creator and last update indexes used to
+// filter and order ListPost.
+func (k Keeper) updateTimeIndexes(ctx sdk.Context, prev *types.Post, next *types.Post) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	creatorStore := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PostCreatorKey))
+	updatedStore := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PostUpdatedKey))
+
+	if prev != nil {
+		creatorStore.Delete(creatorIndexKey(prev.Creator, prev.CreatedAt, prev.Id))
+		updatedStore.Delete(timeIndexKey(prev.LastUpdatedAt, prev.Id))
+	}
+	if next != nil {
+		creatorStore.Set(creatorIndexKey(next.Creator, next.CreatedAt, next.Id), []byte{})
+		updatedStore.Set(timeIndexKey(next.LastUpdatedAt, next.Id), []byte{})
+	}
+}
+
+// updateTagIndex maintains the index of posts by tag used to filter ListPost.
+func (k Keeper) updateTagIndex(ctx sdk.Context, prev *types.Post, next *types.Post) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PostTagKey))
+
+	if prev != nil {
+		for _, tag := range prev.Tags {
+			store.Delete(tagIndexKey(tag, prev.Id))
+		}
+	}
+	if next != nil {
+		for _, tag := range next.Tags {
+			store.Set(tagIndexKey(tag, next.Id), []byte{})
+		}
+	}
+}
+
+// updateSearchIndex adds the terms next gained and removes the terms it lost
+// compared to prev.
+func (k Keeper) updateSearchIndex(ctx sdk.Context, prev *types.Post, next *types.Post) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PostSearchKey))
+
+	prevTerms := make(map[string]struct{})
+	if prev != nil {
+		for _, term := range types.PostTerms(*prev) {
+			prevTerms[term] = struct{}{}
+		}
+	}
+
+	nextTerms := make(map[string]struct{})
+	if next != nil {
+		for _, term := range types.PostTerms(*next) {
+			nextTerms[term] = struct{}{}
+			if _, ok := prevTerms[term]; !ok {
+				store.Set(searchIndexKey(term, next.Id), []byte{})
+			}
+		}
+	}
+
+	if prev != nil {
+		for _, term := range types.PostTerms(*prev) {
+			if _, ok := nextTerms[term]; !ok {
+				store.Delete(searchIndexKey(term, prev.Id))
+			}
+		}
+	}
+}
+
+// searchPostIDs returns the sorted IDs of the posts matching every term.
+func (k Keeper) searchPostIDs(ctx sdk.Context, terms []types.SearchTerm) []uint64 {
+	var matches map[uint64]struct{}
+	for _, term := range terms {
+		termMatches := k.postIDsForTerm(ctx, term)
+		if matches == nil {
+			matches = termMatches
+		} else {
+			for id := range matches {
+				if _, ok := termMatches[id]; !ok {
+					delete(matches, id)
+				}
+			}
+		}
+		if len(matches) == 0 {
+			return nil
+		}
+	}
+
+	return sortedIDs(matches)
+}
+
+// postIDsForTerm returns the IDs of the posts containing term, or any word
+// starting with term if it is a prefix term.
+func (k Keeper) postIDsForTerm(ctx sdk.Context, term types.SearchTerm) map[uint64]struct{} {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	keyPrefix := []byte(term.Term)
+	if !term.Prefix {
+		keyPrefix = append(keyPrefix, 0)
+	}
+	store := prefix.NewStore(storeAdapter, append(types.KeyPrefix(types.PostSearchKey), keyPrefix...))
+
+	ids := make(map[uint64]struct{})
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		ids[postIDFromIndexKey(iterator.Key())] = struct{}{}
+	}
+	return ids
+}
+
+// searchIndexKey returns the key of a term entry relative to PostSearchKey.
+func searchIndexKey(term string, id uint64) []byte {
+	key := append([]byte(term), 0)
+	return append(key, GetPostIDBytes(id)...)
+}
+
+// creatorIndexPrefix returns the prefix, relative to PostCreatorKey, of the
+// index entries of the posts created by creator.
+func creatorIndexPrefix(creator string) []byte {
+	return address.MustLengthPrefix([]byte(creator))
+}
+
+// creatorIndexKey returns the key of a post entry relative to PostCreatorKey.
+func creatorIndexKey(creator string, createdAt time.Time, id uint64) []byte {
+	return append(creatorIndexPrefix(creator), timeIndexKey(createdAt, id)...)
+}
+
+// tagIndexPrefix returns the prefix, relative to PostTagKey, of the index
+// entries of the posts with tag.
+func tagIndexPrefix(tag string) []byte {
+	return address.MustLengthPrefix([]byte(tag))
+}
+
+// tagIndexKey returns the key of a post entry relative to PostTagKey.
+func tagIndexKey(tag string, id uint64) []byte {
+	return append(tagIndexPrefix(tag), GetPostIDBytes(id)...)
+}
+
+// timeIndexKeyLength is the length of every key returned by timeIndexKey.
+var timeIndexKeyLength = len(timeIndexKey(time.Time{}, 0))
+
+// timeIndexKey returns an index key that sorts by time, then by post ID.
+func timeIndexKey(t time.Time, id uint64) []byte {
+	return append(sdk.FormatTimeBytes(t), GetPostIDBytes(id)...)
+}
+
+// postIDFromIndexKey returns the post ID stored at the end of an index key.
+func postIDFromIndexKey(key []byte) uint64 {
+	return GetPostIDFromBytes(key[len(key)-8:])
+}
@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"blog/x/blog/types"
+)
+
+// AppendPostLogEntry records a change of post id made by actor at the current
+// height. It is called once the change is stored, so that the entry carries
+// the version of the stored post, or of the post in the trash once deleted.
+func (k Keeper) AppendPostLogEntry(ctx sdk.Context, id uint64, action types.PostLogAction, actor string) {
+	var version uint64
+	if post, found := k.GetPost(ctx, id); found {
+		version = post.Version
+	} else if deleted, found := k.GetDeletedPost(ctx, id); found {
+		version = deleted.Post.Version
+	}
+
+	k.AddPostLogEntry(ctx, types.PostLogEntry{
+		PostId:  id,
+		Height:  ctx.BlockHeight(),
+		Time:    ctx.BlockTime(),
+		Action:  action,
+		Actor:   actor,
+		Version: version,
+	})
+}
+
+// AddPostLogEntry stores entry at the end of the log of its post
+func (k Keeper) AddPostLogEntry(ctx sdk.Context, entry types.PostLogEntry) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, postLogPrefix(entry.PostId))
+
+	// the log of a post is only appended to, so the next sequence number
+	// follows the last key
+	seq := uint64(0)
+	iterator := store.ReverseIterator(nil, nil)
+	if iterator.Valid() {
+		seq = binary.BigEndian.Uint64(iterator.Key()) + 1
+	}
+	iterator.Close()
+
+	store.Set(GetPostIDBytes(seq), k.cdc.MustMarshal(&entry))
+}
+
+// GetPostLog returns the change log of a post in order
+func (k Keeper) GetPostLog(ctx sdk.Context, id uint64) (list []types.PostLogEntry) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, postLogPrefix(id))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var entry types.PostLogEntry
+		k.cdc.MustUnmarshal(iterator.Value(), &entry)
+		list = append(list, entry)
+	}
+	return
+}
+
+// GetAllPostLog returns the change logs of every post, ordered by post ID and
+// then by change
+func (k Keeper) GetAllPostLog(ctx sdk.Context) (list []types.PostLogEntry) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PostLogKey))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var entry types.PostLogEntry
+		k.cdc.MustUnmarshal(iterator.Value(), &entry)
+		list = append(list, entry)
+	}
+	return
+}
+
+// postLogPrefix returns the store prefix of the change log of a post
+func postLogPrefix(id uint64) []byte {
+	return append(types.KeyPrefix(types.PostLogKey), GetPostIDBytes(id)...)
+}
@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+
+	"blog/x/blog/types"
+)
+
+// GetPostQuotaUsage returns the number of posts creator created within the
+// current quota window
+func (k Keeper) GetPostQuotaUsage(ctx sdk.Context, creator string) uint64 {
+	return k.getCounter(ctx, postQuotaCountKey(creator))
+}
+
+// consumePostQuota records a post created by creator at the current height,
+// failing if creator already used up the quota of the window. Accounts on the
+// allowlist are exempt.
+func (k Keeper) consumePostQuota(ctx sdk.Context, creator string) error {
+	params := k.GetParams(ctx)
+	if params.PostQuota == 0 || params.PostQuotaWindow == 0 || params.IsPostQuotaExempt(creator) {
+		return nil
+	}
+
+	if used := k.GetPostQuotaUsage(ctx, creator); used >= params.PostQuota {
+		return errorsmod.Wrapf(types.ErrPostQuota, "%s created %d posts in the last %d blocks", creator, used, params.PostQuotaWindow)
+	}
+
+	k.addToCounter(ctx, postQuotaEntryKey(uint64(ctx.BlockHeight()), creator), 1)
+	k.addToCounter(ctx, postQuotaCountKey(creator), 1)
+	return nil
+}
+
+// SetPostQuotaEntry records the posts creator created at a height, adding
+// them to the usage of creator
+func (k Keeper) SetPostQuotaEntry(ctx sdk.Context, entry types.PostQuotaEntry) {
+	key := postQuotaEntryKey(entry.Height, entry.Creator)
+	delta := int64(entry.Count) - int64(k.getCounter(ctx, key))
+	k.setCounter(ctx, key, entry.Count)
+	k.addToCounter(ctx, postQuotaCountKey(entry.Creator), delta)
+}
+
+// GetAllPostQuotaEntry returns the post creations still counted against the
+// quota, in height order
+func (k Keeper) GetAllPostQuotaEntry(ctx sdk.Context) (list []types.PostQuotaEntry) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PostQuotaEntryKey))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		list = append(list, types.PostQuotaEntry{
+			Height:  binary.BigEndian.Uint64(key[:8]),
+			Creator: string(key[9:]),
+			Count:   binary.BigEndian.Uint64(iterator.Value()),
+		})
+	}
+	return
+}
+
+// PrunePostQuota drops the post creations that fall out of the quota window
+// at the next height. Every creation is dropped once the window is disabled.
+func (k Keeper) PrunePostQuota(ctx sdk.Context) {
+	height := uint64(ctx.BlockHeight())
+	cutoff := height
+	if window := k.GetParams(ctx).PostQuotaWindow; window != 0 {
+		if height+1 < window {
+			return
+		}
+		cutoff = height + 1 - window
+	}
+
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PostQuotaEntryKey))
+	iterator := store.Iterator(nil, storetypes.PrefixEndBytes(GetPostIDBytes(cutoff)))
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		count := binary.BigEndian.Uint64(store.Get(key))
+		creator := string(key[9:])
+		store.Delete(key)
+		k.addToCounter(ctx, postQuotaCountKey(creator), -int64(count))
+	}
+}
+
+// postQuotaEntryKey returns the key of the creations of creator at height
+func postQuotaEntryKey(height uint64, creator string) []byte {
+	key := append(types.KeyPrefix(types.PostQuotaEntryKey), GetPostIDBytes(height)...)
+	return append(key, address.MustLengthPrefix([]byte(creator))...)
+}
+
+func postQuotaCountKey(creator string) []byte {
+	return append(types.KeyPrefix(types.PostQuotaCountKey), creator...)
+}
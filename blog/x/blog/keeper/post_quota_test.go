@@ -0,0 +1,76 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"blog/x/blog/types"
+)
+
+func TestPostQuota(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	wctx := sdk.UnwrapSDKContext(ctx)
+	params := types.DefaultParams()
+	params.PostQuota = 2
+	params.PostQuotaWindow = 3
+	params.PostQuotaAllowlist = []string{creator2.String()}
+	require.NoError(t, k.SetParams(wctx, params))
+
+	at := func(height int64) sdk.Context { return wctx.WithBlockHeight(height) }
+	create := func(ctx sdk.Context, creator string) error {
+		_, err := ms.CreatePost(ctx, &types.MsgCreatePost{Creator: creator, Title: "title", Body: "body"})
+		return err
+	}
+	endBlock := func(ctx sdk.Context) { require.NoError(t, k.EndBlocker(ctx)) }
+
+	// The quota covers creations within the last three blocks.
+	require.NoError(t, create(at(10), creator1.String()))
+	endBlock(at(10))
+	require.NoError(t, create(at(11), creator1.String()))
+	require.ErrorIs(t, create(at(11), creator1.String()), types.ErrPostQuota)
+	endBlock(at(11))
+	require.ErrorIs(t, create(at(12), creator1.String()), types.ErrPostQuota)
+	endBlock(at(12))
+	require.Equal(t, uint64(1), k.GetPostQuotaUsage(at(13), creator1.String()))
+	require.NoError(t, create(at(13), creator1.String()))
+	require.ErrorIs(t, create(at(13), creator1.String()), types.ErrPostQuota)
+
+	// Accounts on the allowlist are not limited.
+	for i := 0; i < 5; i++ {
+		require.NoError(t, create(at(13), creator2.String()))
+	}
+	require.Zero(t, k.GetPostQuotaUsage(at(13), creator2.String()))
+
+	// Each operation of a batch counts against the quota.
+	endBlock(at(13))
+	endBlock(at(14))
+	_, err := ms.BatchPostOps(at(15), &types.MsgBatchPostOps{
+		Creator: creator1.String(),
+		Ops: []types.PostOp{
+			{OpType: types.PostOpType_POST_OP_TYPE_CREATE, Title: "a", Body: "a"},
+			{OpType: types.PostOpType_POST_OP_TYPE_CREATE, Title: "b", Body: "b"},
+			{OpType: types.PostOpType_POST_OP_TYPE_CREATE, Title: "c", Body: "c"},
+		},
+	})
+	require.ErrorIs(t, err, types.ErrPostQuota)
+
+	// Disabling the window drops every recorded creation.
+	params.PostQuotaWindow = 0
+	require.NoError(t, k.SetParams(wctx, params))
+	endBlock(at(15))
+	require.Zero(t, k.GetPostQuotaUsage(at(16), creator1.String()))
+}
+
+func TestParamsValidatePostQuotaAllowlist(t *testing.T) {
+	params := types.DefaultParams()
+	params.PostQuotaAllowlist = []string{creator1.String()}
+	require.NoError(t, params.Validate())
+
+	params.PostQuotaAllowlist = []string{creator1.String(), creator1.String()}
+	require.Error(t, params.Validate())
+
+	params.PostQuotaAllowlist = []string{"invalid"}
+	require.Error(t, params.Validate())
+}
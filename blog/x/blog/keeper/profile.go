@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"blog/x/blog/types"
+)
+
+// GetProfile returns the profile of an address
+func (k Keeper) GetProfile(ctx sdk.Context, address string) (types.Profile, bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ProfileKey))
+	bz := store.Get([]byte(address))
+	if bz == nil {
+		return types.Profile{}, false
+	}
+	var profile types.Profile
+	k.cdc.MustUnmarshal(bz, &profile)
+	return profile, true
+}
+
+// GetProfileAddressByName returns the address owning a display name, compared
+// case-insensitively
+func (k Keeper) GetProfileAddressByName(ctx sdk.Context, displayName string) (string, bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ProfileNameKey))
+	bz := store.Get([]byte(types.NormalizeDisplayName(displayName)))
+	if bz == nil {
+		return "", false
+	}
+	return string(bz), true
+}
+
+// SetProfile stores a profile and moves its display name in the name index.
+// Callers must check that the display name is not owned by another address.
+func (k Keeper) SetProfile(ctx sdk.Context, profile types.Profile) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ProfileKey))
+	nameStore := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ProfileNameKey))
+
+	if prev, found := k.GetProfile(ctx, profile.Address); found && prev.DisplayName != "" {
+		nameStore.Delete([]byte(types.NormalizeDisplayName(prev.DisplayName)))
+	}
+	if profile.DisplayName != "" {
+		nameStore.Set([]byte(types.NormalizeDisplayName(profile.DisplayName)), []byte(profile.Address))
+	}
+	store.Set([]byte(profile.Address), k.cdc.MustMarshal(&profile))
+}
+
+// GetAllProfile returns every profile in address order
+func (k Keeper) GetAllProfile(ctx sdk.Context) (list []types.Profile) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ProfileKey))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var profile types.Profile
+		k.cdc.MustUnmarshal(iterator.Value(), &profile)
+		list = append(list, profile)
+	}
+	return
+}
+
+// getProfiles returns the profiles of the distinct creators of posts, in the
+// order the creators first appear. Creators without a profile are skipped.
+func (k Keeper) getProfiles(ctx sdk.Context, posts []types.Post) []types.Profile {
+	seen := make(map[string]bool)
+	var profiles []types.Profile
+	for _, post := range posts {
+		if seen[post.Creator] {
+			continue
+		}
+		seen[post.Creator] = true
+		if profile, found := k.GetProfile(ctx, post.Creator); found {
+			profiles = append(profiles, profile)
+		}
+	}
+	return profiles
+}
@@ -1,7 +1,24 @@
 package keeper
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"blog/x/blog/types"
 )
 
 var _ types.QueryServer = Keeper{}
+
+// validateQueryAddress returns an InvalidArgument error unless addr, the value
+// of the request field, is an account address. Addresses are length-prefixed
+// in index keys, which only holds for valid addresses.
+func validateQueryAddress(field string, addr string) error {
+	if addr == "" {
+		return status.Errorf(codes.InvalidArgument, "missing %s", field)
+	}
+	if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid %s address: %s", field, err)
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"blog/x/blog/types"
+)
+
+func (k Keeper) DeletedPosts(goCtx context.Context, req *types.QueryDeletedPostsRequest) (*types.QueryDeletedPostsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := validateQueryAddress("creator", req.Creator); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, append(types.KeyPrefix(types.TrashCreatorKey), address.MustLengthPrefix([]byte(req.Creator))...))
+
+	var posts []types.DeletedPost
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		deleted, found := k.GetDeletedPost(ctx, GetPostIDFromBytes(key))
+		if !found {
+			return status.Errorf(codes.Internal, "trash index references missing post %d", GetPostIDFromBytes(key))
+		}
+		posts = append(posts, deleted)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDeletedPostsResponse{Posts: posts, Pagination: pageRes}, nil
+}
@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"blog/x/blog/types"
+)
+
+func (k Keeper) Feed(goCtx context.Context, req *types.QueryFeedRequest) (*types.QueryFeedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := validateQueryAddress("address", req.Address); err != nil {
+		return nil, err
+	}
+
+	pageRequest := req.Pagination
+	if pageRequest == nil {
+		pageRequest = &query.PageRequest{}
+	}
+	if pageRequest.Offset > 0 || pageRequest.Reverse {
+		return nil, status.Error(codes.InvalidArgument, "feed only supports key based pagination in newest first order")
+	}
+	// Page keys are creator index keys without the creator: the creation time
+	// followed by the post ID.
+	if len(pageRequest.Key) > 0 && len(pageRequest.Key) != timeIndexKeyLength {
+		return nil, status.Error(codes.InvalidArgument, "invalid page key")
+	}
+	limit := pageRequest.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+
+	// Walk the creator index of every followee from the page key backwards and
+	// repeatedly take the newest head, which merges the followees' posts by
+	// creation time.
+	var end []byte
+	if len(pageRequest.Key) > 0 {
+		end = append(append([]byte{}, pageRequest.Key...), 0)
+	}
+	var iterators []storetypes.Iterator
+	defer func() {
+		for _, iterator := range iterators {
+			iterator.Close()
+		}
+	}()
+	for _, followee := range k.getFollowing(ctx, req.Address) {
+		store := prefix.NewStore(storeAdapter, append(types.KeyPrefix(types.PostCreatorKey), creatorIndexPrefix(followee)...))
+		iterators = append(iterators, store.ReverseIterator(nil, end))
+	}
+
+	var posts []types.Post
+	pageRes := &query.PageResponse{}
+	for {
+		var newest storetypes.Iterator
+		for _, iterator := range iterators {
+			if iterator.Valid() && (newest == nil || bytes.Compare(iterator.Key(), newest.Key()) > 0) {
+				newest = iterator
+			}
+		}
+		if newest == nil {
+			break
+		}
+		if uint64(len(posts)) == limit {
+			pageRes.NextKey = append([]byte{}, newest.Key()...)
+			break
+		}
+
+		id := postIDFromIndexKey(newest.Key())
+		post, found := k.GetPost(ctx, id)
+		if !found {
+			return nil, status.Errorf(codes.Internal, "index references missing post %d", id)
+		}
+		posts = append(posts, post)
+		newest.Next()
+	}
+
+	return &types.QueryFeedResponse{Posts: posts, Pagination: pageRes}, nil
+}
@@ -0,0 +1,118 @@
+package keeper_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cometbft/cometbft/crypto"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"blog/x/blog/types"
+)
+
+func TestFollowQueries(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	wctx := sdk.UnwrapSDKContext(ctx)
+	reader := sdk.AccAddress(crypto.AddressHash([]byte("reader"))).String()
+
+	// Test: Follow both creators
+	for _, followee := range []string{creator1.String(), creator2.String()} {
+		_, err := ms.Follow(wctx, &types.MsgFollow{Creator: reader, Followee: followee})
+		require.NoError(t, err)
+	}
+	_, err := ms.Follow(wctx, &types.MsgFollow{Creator: reader, Followee: creator1.String()})
+	require.ErrorIs(t, err, types.ErrAlreadyFollowing)
+
+	following, err := k.Following(wctx, &types.QueryFollowingRequest{Address: reader})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{creator1.String(), creator2.String()}, following.Following)
+	followers, err := k.Followers(wctx, &types.QueryFollowersRequest{Address: creator1.String()})
+	require.NoError(t, err)
+	require.Equal(t, []string{reader}, followers.Followers)
+
+	// Test: Unfollow removes both directions of the edge
+	_, err = ms.Unfollow(wctx, &types.MsgUnfollow{Creator: reader, Followee: creator1.String()})
+	require.NoError(t, err)
+	_, err = ms.Unfollow(wctx, &types.MsgUnfollow{Creator: reader, Followee: creator1.String()})
+	require.ErrorIs(t, err, types.ErrNotFollowing)
+	followers, err = k.Followers(wctx, &types.QueryFollowersRequest{Address: creator1.String()})
+	require.NoError(t, err)
+	require.Empty(t, followers.Followers)
+	require.Equal(t, uint64(1), k.GetFollowingCount(wctx, reader))
+
+	// Test: The follow limit is enforced
+	params := types.DefaultParams()
+	params.MaxFollowing = 1
+	require.NoError(t, k.SetParams(wctx, params))
+	_, err = ms.Follow(wctx, &types.MsgFollow{Creator: reader, Followee: creator1.String()})
+	require.ErrorIs(t, err, types.ErrFollowLimit)
+
+	// Test: Addresses that are not accounts are rejected
+	for _, address := range []string{"", "not-an-address", strings.Repeat("a", 300)} {
+		_, err = k.Following(wctx, &types.QueryFollowingRequest{Address: address})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = k.Followers(wctx, &types.QueryFollowersRequest{Address: address})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = k.Feed(wctx, &types.QueryFeedRequest{Address: address})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	}
+}
+
+func TestFeedQuery(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	at := func(hours int) sdk.Context {
+		return sdk.UnwrapSDKContext(ctx).WithBlockTime(start.Add(time.Duration(hours) * time.Hour))
+	}
+	reader := sdk.AccAddress(crypto.AddressHash([]byte("reader"))).String()
+	stranger := sdk.AccAddress(crypto.AddressHash([]byte("stranger"))).String()
+
+	// Posts 1..5 alternate between the two followed creators; post 6 is from an
+	// account the reader does not follow.
+	for i, creator := range []string{creator1.String(), creator2.String(), creator1.String(), creator2.String(), creator1.String(), stranger} {
+		_, err := ms.CreatePost(at(i), &types.MsgCreatePost{Creator: creator, Title: "title", Body: "body"})
+		require.NoError(t, err)
+	}
+	for _, followee := range []string{creator1.String(), creator2.String()} {
+		_, err := ms.Follow(at(10), &types.MsgFollow{Creator: reader, Followee: followee})
+		require.NoError(t, err)
+	}
+
+	feed := func(pageRequest *query.PageRequest) ([]uint64, []byte) {
+		res, err := k.Feed(at(20), &types.QueryFeedRequest{Address: reader, Pagination: pageRequest})
+		require.NoError(t, err)
+		ids := make([]uint64, 0, len(res.Posts))
+		for _, post := range res.Posts {
+			ids = append(ids, post.Id)
+		}
+		return ids, res.Pagination.NextKey
+	}
+
+	// Test: The feed merges the followees' posts newest first
+	ids, nextKey := feed(nil)
+	require.Equal(t, []uint64{5, 4, 3, 2, 1}, ids)
+	require.Nil(t, nextKey)
+
+	// Test: Key based pagination
+	ids, nextKey = feed(&query.PageRequest{Limit: 2})
+	require.Equal(t, []uint64{5, 4}, ids)
+	ids, nextKey = feed(&query.PageRequest{Limit: 2, Key: nextKey})
+	require.Equal(t, []uint64{3, 2}, ids)
+	ids, nextKey = feed(&query.PageRequest{Limit: 2, Key: nextKey})
+	require.Equal(t, []uint64{1}, ids)
+	require.Nil(t, nextKey)
+
+	// Test: Offsets are rejected
+	_, err := k.Feed(at(20), &types.QueryFeedRequest{Address: reader, Pagination: &query.PageRequest{Offset: 1}})
+	require.Error(t, err)
+
+	// Test: Accounts following nobody get an empty feed
+	res, err := k.Feed(at(20), &types.QueryFeedRequest{Address: stranger})
+	require.NoError(t, err)
+	require.Empty(t, res.Posts)
+}
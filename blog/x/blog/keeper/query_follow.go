@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"blog/x/blog/types"
+)
+
+func (k Keeper) Followers(goCtx context.Context, req *types.QueryFollowersRequest) (*types.QueryFollowersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	followers, pageRes, err := k.paginateFollowEdges(ctx, types.FollowerKey, req.Address, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryFollowersResponse{Followers: followers, Pagination: pageRes}, nil
+}
+
+func (k Keeper) Following(goCtx context.Context, req *types.QueryFollowingRequest) (*types.QueryFollowingResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	following, pageRes, err := k.paginateFollowEdges(ctx, types.FollowingKey, req.Address, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryFollowingResponse{Following: following, Pagination: pageRes}, nil
+}
+
+// paginateFollowEdges returns a page of the addresses at the other end of the
+// edges stored under keyPrefix for addr.
+func (k Keeper) paginateFollowEdges(ctx sdk.Context, keyPrefix string, addr string, pageRequest *query.PageRequest) ([]string, *query.PageResponse, error) {
+	if err := validateQueryAddress("address", addr); err != nil {
+		return nil, nil, err
+	}
+
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, append(types.KeyPrefix(keyPrefix), address.MustLengthPrefix([]byte(addr))...))
+
+	var addrs []string
+	pageRes, err := query.Paginate(store, pageRequest, func(key []byte, value []byte) error {
+		addrs = append(addrs, string(key))
+		return nil
+	})
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return addrs, pageRes, nil
+}
@@ -1,10 +1,16 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
+	"slices"
+	"strings"
+	"time"
 
 	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -12,27 +18,195 @@ import (
 	"blog/x/blog/types"
 )
 
-func (k Keeper) ListPost(ctx context.Context, req *types.QueryListPostRequest) (*types.QueryListPostResponse, error) {
+func (k Keeper) ListPost(goCtx context.Context, req *types.QueryListPostRequest) (*types.QueryListPostResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
+	if req.Creator != "" {
+		if err := validateQueryAddress("creator", req.Creator); err != nil {
+			return nil, err
+		}
+	}
+	if req.Tag != "" {
+		if err := types.ValidateTag(req.Tag); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
 	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PostKey))
+
+	// Iterate the narrowest index that yields the requested order, between the
+	// bounds of the time range it is sorted by. Entries of the secondary
+	// indexes only carry the post ID in their key.
+	var (
+		store       storetypes.KVStore
+		fromIndex   bool
+		pageRequest = req.Pagination
+	)
+	switch {
+	case req.Order == types.ListPostOrder_LIST_POST_ORDER_LAST_UPDATED_DESC:
+		store = boundedStore{
+			KVStore: prefix.NewStore(storeAdapter, types.KeyPrefix(types.PostUpdatedKey)),
+			start:   timeBound(req.UpdatedAfter),
+			end:     timeBound(req.UpdatedBefore),
+		}
+		fromIndex = true
+		pageRequest = reversePageRequest(pageRequest)
+	case req.Creator != "":
+		store = boundedStore{
+			KVStore: prefix.NewStore(storeAdapter, append(types.KeyPrefix(types.PostCreatorKey), creatorIndexPrefix(req.Creator)...)),
+			start:   timeBound(req.CreatedAfter),
+			end:     timeBound(req.CreatedBefore),
+		}
+		fromIndex = true
+	case req.Tag != "":
+		store = prefix.NewStore(storeAdapter, append(types.KeyPrefix(types.PostTagKey), tagIndexPrefix(req.Tag)...))
+		fromIndex = true
+	default:
+		postStore := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PostKey))
+		store = boundedStore{
+			KVStore: postStore,
+			start:   k.postCreatedBound(ctx, postStore, req.CreatedAfter),
+			end:     k.postCreatedBound(ctx, postStore, req.CreatedBefore),
+		}
+	}
 
 	var posts []types.Post
-	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+	pageRes, err := query.FilteredPaginate(store, pageRequest, func(key []byte, value []byte, accumulate bool) (bool, error) {
 		var post types.Post
-		if err := k.cdc.Unmarshal(value, &post); err != nil {
-			return err
+		if fromIndex {
+			var found bool
+			post, found = k.GetPost(ctx, postIDFromIndexKey(key))
+			if !found {
+				return false, status.Errorf(codes.Internal, "index references missing post %d", postIDFromIndexKey(key))
+			}
+		} else if err := k.cdc.Unmarshal(value, &post); err != nil {
+			return false, err
 		}
 
-		posts = append(posts, post)
-		return nil
+		if !matchesListPostFilters(req, post) {
+			return false, nil
+		}
+		if accumulate {
+			posts = append(posts, post)
+		}
+		return true, nil
 	})
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	return &types.QueryListPostResponse{Post: posts, Pagination: pageRes}, nil
+	res := &types.QueryListPostResponse{Post: posts, Pagination: pageRes}
+	if req.IncludeProfiles {
+		res.Profiles = k.getProfiles(ctx, posts)
+	}
+
+	return res, nil
+}
+
+// matchesListPostFilters reports whether a post satisfies every filter set on
+// the request.
+func matchesListPostFilters(req *types.QueryListPostRequest, post types.Post) bool {
+	switch {
+	case req.Creator != "" && post.Creator != req.Creator:
+		return false
+	case req.CreatedAfter != nil && post.CreatedAt.Before(*req.CreatedAfter):
+		return false
+	case req.CreatedBefore != nil && !post.CreatedAt.Before(*req.CreatedBefore):
+		return false
+	case req.UpdatedAfter != nil && post.LastUpdatedAt.Before(*req.UpdatedAfter):
+		return false
+	case req.UpdatedBefore != nil && !post.LastUpdatedAt.Before(*req.UpdatedBefore):
+		return false
+	case !strings.HasPrefix(post.Title, req.TitlePrefix):
+		return false
+	case req.Tag != "" && !slices.Contains(post.Tags, req.Tag):
+		return false
+	}
+	return true
+}
+
+// timeBound returns the time index key bounding a range at t: the first key
+// at or after t. A nil t leaves the range unbounded.
+func timeBound(t *time.Time) []byte {
+	if t == nil {
+		return nil
+	}
+	return sdk.FormatTimeBytes(*t)
+}
+
+// postCreatedBound returns the post store key bounding a creation time range at
+// t: the key of the first post created at or after t. Posts are appended with
+// the block time and genesis validation rejects creation times decreasing with
+// the ID, so the bound is found by binary search. A nil t leaves the range
+// unbounded.
+func (k Keeper) postCreatedBound(ctx sdk.Context, store storetypes.KVStore, t *time.Time) []byte {
+	if t == nil {
+		return nil
+	}
+	createdBefore := func(id uint64) bool {
+		iterator := store.Iterator(GetPostIDBytes(id), nil)
+		defer iterator.Close()
+		if !iterator.Valid() {
+			return false
+		}
+		var post types.Post
+		k.cdc.MustUnmarshal(iterator.Value(), &post)
+		return post.CreatedAt.Before(*t)
+	}
+	lo, hi := uint64(0), k.GetPostCount(ctx)+1
+	for lo < hi {
+		mid := lo + (hi-lo)/2
+		if createdBefore(mid) {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+	return GetPostIDBytes(lo)
+}
+
+// boundedStore restricts the iterators of a store to the keys in [start, end),
+// so that pagination walks an index between bounds instead of filtering
+// every entry. A nil bound leaves that side open.
+type boundedStore struct {
+	storetypes.KVStore
+	start, end []byte
+}
+
+func (s boundedStore) Iterator(start, end []byte) storetypes.Iterator {
+	start, end = s.clamp(start, end)
+	return s.KVStore.Iterator(start, end)
+}
+
+func (s boundedStore) ReverseIterator(start, end []byte) storetypes.Iterator {
+	start, end = s.clamp(start, end)
+	return s.KVStore.ReverseIterator(start, end)
+}
+
+func (s boundedStore) clamp(start, end []byte) ([]byte, []byte) {
+	if s.start != nil && (start == nil || bytes.Compare(start, s.start) < 0) {
+		start = s.start
+	}
+	if s.end != nil && (end == nil || bytes.Compare(end, s.end) > 0) {
+		end = s.end
+	}
+	// an empty range still needs a valid iterator
+	if start != nil && end != nil && bytes.Compare(start, end) > 0 {
+		end = start
+	}
+	return start, end
+}
+
+// reversePageRequest returns a copy of pageRequest iterating in the opposite
+// direction, for indexes stored in ascending order but listed descending.
+func reversePageRequest(pageRequest *query.PageRequest) *query.PageRequest {
+	reversed := query.PageRequest{}
+	if pageRequest != nil {
+		reversed = *pageRequest
+	}
+	reversed.Reverse = !reversed.Reverse
+	return &reversed
 }
@@ -0,0 +1,104 @@
+package keeper_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"blog/x/blog/types"
+)
+
+func TestListPostQuery(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	at := func(hours int) sdk.Context {
+		return sdk.UnwrapSDKContext(ctx).WithBlockTime(start.Add(time.Duration(hours) * time.Hour))
+	}
+
+	for i, msg := range []*types.MsgCreatePost{
+		{Creator: creator1.String(), Title: "Cosmos 101", Body: "body"},
+		{Creator: creator2.String(), Title: "Cosmos 102", Body: "body"},
+		{Creator: creator1.String(), Title: "Gaia", Body: "body"},
+		{Creator: creator1.String(), Title: "Cosmos 103", Body: "body"},
+	} {
+		_, err := ms.CreatePost(at(i), msg)
+		require.NoError(t, err)
+	}
+	_, err := ms.UpdatePost(at(10), &types.MsgUpdatePost{Creator: creator1.String(), Id: 1, Title: "Cosmos 101", Body: "edited"})
+	require.NoError(t, err)
+
+	list := func(req *types.QueryListPostRequest) []uint64 {
+		res, err := k.ListPost(at(20), req)
+		require.NoError(t, err)
+		ids := make([]uint64, 0, len(res.Post))
+		for _, post := range res.Post {
+			ids = append(ids, post.Id)
+		}
+		return ids
+	}
+	timeAt := func(hours int) *time.Time {
+		ts := start.Add(time.Duration(hours) * time.Hour)
+		return &ts
+	}
+
+	require.Equal(t, []uint64{1, 2, 3, 4}, list(&types.QueryListPostRequest{}))
+	require.Equal(t, []uint64{1, 3, 4}, list(&types.QueryListPostRequest{Creator: creator1.String()}))
+	require.Equal(t, []uint64{1, 4}, list(&types.QueryListPostRequest{Creator: creator1.String(), TitlePrefix: "Cosmos"}))
+	require.Equal(t, []uint64{2, 3}, list(&types.QueryListPostRequest{CreatedAfter: timeAt(1), CreatedBefore: timeAt(3)}))
+	require.Equal(t, []uint64{4}, list(&types.QueryListPostRequest{CreatedAfter: timeAt(3)}))
+	require.Empty(t, list(&types.QueryListPostRequest{CreatedAfter: timeAt(4)}))
+	require.Empty(t, list(&types.QueryListPostRequest{CreatedAfter: timeAt(3), CreatedBefore: timeAt(1)}))
+	require.Equal(t, []uint64{3, 4}, list(&types.QueryListPostRequest{Creator: creator1.String(), CreatedAfter: timeAt(1)}))
+	require.Equal(t, []uint64{1}, list(&types.QueryListPostRequest{Creator: creator1.String(), CreatedBefore: timeAt(2)}))
+	require.Equal(t, []uint64{4, 3}, list(&types.QueryListPostRequest{UpdatedBefore: timeAt(5), UpdatedAfter: timeAt(2), Order: types.ListPostOrder_LIST_POST_ORDER_LAST_UPDATED_DESC}))
+	require.Equal(t, []uint64{1}, list(&types.QueryListPostRequest{UpdatedAfter: timeAt(5)}))
+	require.Equal(t, []uint64{2, 3, 4}, list(&types.QueryListPostRequest{UpdatedBefore: timeAt(5)}))
+	require.Equal(t, []uint64{1, 4, 3, 2}, list(&types.QueryListPostRequest{Order: types.ListPostOrder_LIST_POST_ORDER_LAST_UPDATED_DESC}))
+	require.Equal(t, []uint64{1, 4, 3}, list(&types.QueryListPostRequest{Creator: creator1.String(), Order: types.ListPostOrder_LIST_POST_ORDER_LAST_UPDATED_DESC}))
+
+	// Test: Pagination follows the requested order
+	res, err := k.ListPost(at(20), &types.QueryListPostRequest{
+		Order:      types.ListPostOrder_LIST_POST_ORDER_LAST_UPDATED_DESC,
+		Pagination: &query.PageRequest{Limit: 2},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Post, 2)
+	require.Equal(t, uint64(4), res.Post[1].Id)
+	require.Equal(t, []uint64{3, 2}, list(&types.QueryListPostRequest{
+		Order:      types.ListPostOrder_LIST_POST_ORDER_LAST_UPDATED_DESC,
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	}))
+
+	// Test: Pagination stays within the bounds of a range
+	res, err = k.ListPost(at(20), &types.QueryListPostRequest{
+		Creator:      creator1.String(),
+		CreatedAfter: timeAt(1),
+		Pagination:   &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), res.Post[0].Id)
+	require.Equal(t, uint64(2), res.Pagination.Total)
+	require.Equal(t, []uint64{4}, list(&types.QueryListPostRequest{
+		Creator:      creator1.String(),
+		CreatedAfter: timeAt(1),
+		Pagination:   &query.PageRequest{Key: res.Pagination.NextKey},
+	}))
+
+	// Test: Creators that are not addresses are rejected
+	for _, creator := range []string{"not-an-address", strings.Repeat("a", 300)} {
+		_, err = k.ListPost(at(20), &types.QueryListPostRequest{Creator: creator})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	}
+
+	// Test: Deleted posts leave the indexes
+	_, err = ms.DeletePost(at(21), &types.MsgDeletePost{Creator: creator1.String(), Id: 4})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 3}, list(&types.QueryListPostRequest{Creator: creator1.String()}))
+	require.Equal(t, []uint64{1, 3, 2}, list(&types.QueryListPostRequest{Order: types.ListPostOrder_LIST_POST_ORDER_LAST_UPDATED_DESC}))
+}
@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"blog/x/blog/types"
+)
+
+func (k Keeper) HasAccess(goCtx context.Context, req *types.QueryHasAccessRequest) (*types.QueryHasAccessResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := validateQueryAddress("address", req.Address); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	post, found := k.GetPost(ctx, req.Id)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	res := &types.QueryHasAccessResponse{
+		Purchased: k.HasEntitlement(ctx, req.Address, req.Id),
+	}
+	_, res.HasEnvelope = types.KeyEnvelopeOf(post.KeyEnvelopes, req.Address)
+	// the body of other posts is public
+	res.HasAccess = post.BodyFormat != types.BodyFormat_BODY_FORMAT_ENCRYPTED || res.Purchased || res.HasEnvelope
+
+	return res, nil
+}
+
+func (k Keeper) PurchasedPosts(goCtx context.Context, req *types.QueryPurchasedPostsRequest) (*types.QueryPurchasedPostsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := validateQueryAddress("address", req.Address); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, append(types.KeyPrefix(types.EntitlementKey), address.MustLengthPrefix([]byte(req.Address))...))
+
+	var entitlements []types.Entitlement
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var entitlement types.Entitlement
+		if err := k.cdc.Unmarshal(value, &entitlement); err != nil {
+			return err
+		}
+		entitlements = append(entitlements, entitlement)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPurchasedPostsResponse{Entitlements: entitlements, Pagination: pageRes}, nil
+}
+
+func (k Keeper) PostPurchasers(goCtx context.Context, req *types.QueryPostPurchasersRequest) (*types.QueryPostPurchasersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, append(types.KeyPrefix(types.EntitlementPostKey), GetPostIDBytes(req.Id)...))
+
+	var purchasers []string
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		if req.Escrowed {
+			entitlement, _ := k.GetEntitlement(ctx, string(key), req.Id)
+			if !entitlement.Escrowed {
+				return false, nil
+			}
+		}
+		if accumulate {
+			purchasers = append(purchasers, string(key))
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPostPurchasersResponse{Purchasers: purchasers, Pagination: pageRes}, nil
+}
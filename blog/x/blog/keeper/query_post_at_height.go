@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"blog/x/blog/types"
+)
+
+// PostAtHeight reads the post from the store the query context was created
+// with. The node only versions the store of a query by the block height
+// header, so the requested height must match the height of the context;
+// otherwise the post would silently be read at another height.
+func (k Keeper) PostAtHeight(goCtx context.Context, req *types.QueryPostAtHeightRequest) (*types.QueryPostAtHeightResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Height <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "height must be positive")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if ctx.BlockHeight() != req.Height {
+		return nil, status.Errorf(codes.InvalidArgument, "query is at height %d instead of %d, set the %s header to the requested height",
+			ctx.BlockHeight(), req.Height, grpctypes.GRPCBlockHeightHeader)
+	}
+
+	post, found := k.GetPost(ctx, req.Id)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	return &types.QueryPostAtHeightResponse{Post: post}, nil
+}
@@ -0,0 +1,21 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"blog/x/blog/types"
+)
+
+func (k Keeper) PostChangeProposals(goCtx context.Context, req *types.QueryPostChangeProposalsRequest) (*types.QueryPostChangeProposalsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryPostChangeProposalsResponse{Proposals: k.GetPostChangeProposals(ctx, req.PostId)}, nil
+}
@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"blog/x/blog/types"
+)
+
+func (k Keeper) PostTimeline(goCtx context.Context, req *types.QueryPostTimelineRequest) (*types.QueryPostTimelineResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, postLogPrefix(req.Id))
+
+	// Purged posts keep their log, so only posts that never existed are
+	// not found
+	if !k.hasPostOrLog(ctx, store, req.Id) {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	var entries []types.PostLogEntry
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var entry types.PostLogEntry
+		if err := k.cdc.Unmarshal(value, &entry); err != nil {
+			return err
+		}
+		entries = append(entries, entry)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPostTimelineResponse{Entries: entries, Pagination: pageRes}, nil
+}
+
+// hasPostOrLog reports whether a post exists, is in the trash or has a change
+// log in store
+func (k Keeper) hasPostOrLog(ctx sdk.Context, store storetypes.KVStore, id uint64) bool {
+	if _, found := k.GetPost(ctx, id); found {
+		return true
+	}
+	if _, found := k.GetDeletedPost(ctx, id); found {
+		return true
+	}
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	return iterator.Valid()
+}
@@ -0,0 +1,106 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	"blog/x/blog/types"
+)
+
+func TestPostTimelineQuery(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	at := func(height int64) sdk.Context {
+		return sdk.UnwrapSDKContext(ctx).WithBlockHeight(height).WithBlockTime(start.Add(time.Duration(height) * time.Second))
+	}
+
+	params := types.DefaultParams()
+	params.TrashRetention = 100
+	require.NoError(t, k.SetParams(at(1), params))
+
+	_, err := ms.CreatePost(at(1), &types.MsgCreatePost{Creator: creator1.String(), Title: "first", Body: "body"})
+	require.NoError(t, err)
+	_, err = ms.CreatePost(at(1), &types.MsgCreatePost{Creator: creator1.String(), Title: "second", Body: "body"})
+	require.NoError(t, err)
+	_, err = ms.AddEditor(at(2), &types.MsgAddEditor{Creator: creator1.String(), Id: 1, Editor: creator2.String()})
+	require.NoError(t, err)
+	_, err = ms.UpdatePost(at(3), &types.MsgUpdatePost{Creator: creator2.String(), Id: 1, Title: "first", Body: "edited"})
+	require.NoError(t, err)
+	_, err = ms.DeletePost(at(4), &types.MsgDeletePost{Creator: creator1.String(), Id: 1})
+	require.NoError(t, err)
+	_, err = ms.RestorePost(at(5), &types.MsgRestorePost{Creator: creator2.String(), Id: 1})
+	require.NoError(t, err)
+
+	// Test: Every change is logged with its height, actor and resulting version
+	res, err := k.PostTimeline(at(5), &types.QueryPostTimelineRequest{Id: 1})
+	require.NoError(t, err)
+	type change struct {
+		height  int64
+		action  types.PostLogAction
+		actor   string
+		version uint64
+	}
+	var changes []change
+	for _, entry := range res.Entries {
+		require.Equal(t, uint64(1), entry.PostId)
+		require.Equal(t, start.Add(time.Duration(entry.Height)*time.Second), entry.Time)
+		changes = append(changes, change{entry.Height, entry.Action, entry.Actor, entry.Version})
+	}
+	require.Equal(t, []change{
+		{1, types.PostLogAction_POST_LOG_ACTION_CREATE, creator1.String(), 1},
+		{2, types.PostLogAction_POST_LOG_ACTION_ADD_EDITOR, creator1.String(), 2},
+		{3, types.PostLogAction_POST_LOG_ACTION_UPDATE, creator2.String(), 3},
+		{4, types.PostLogAction_POST_LOG_ACTION_DELETE, creator1.String(), 3},
+		{5, types.PostLogAction_POST_LOG_ACTION_RESTORE, creator2.String(), 4},
+	}, changes)
+
+	// Test: The log is paginated
+	res, err = k.PostTimeline(at(5), &types.QueryPostTimelineRequest{Id: 1, Pagination: &query.PageRequest{Offset: 3, Limit: 1}})
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 1)
+	require.Equal(t, types.PostLogAction_POST_LOG_ACTION_DELETE, res.Entries[0].Action)
+
+	// Test: The log outlives the post once purged from the trash
+	_, err = ms.DeletePost(at(6), &types.MsgDeletePost{Creator: creator1.String(), Id: 2})
+	require.NoError(t, err)
+	require.NoError(t, k.EndBlocker(at(106)))
+	_, found := k.GetDeletedPost(at(106), 2)
+	require.False(t, found)
+	res, err = k.PostTimeline(at(106), &types.QueryPostTimelineRequest{Id: 2})
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 3)
+	require.Equal(t, types.PostLogAction_POST_LOG_ACTION_PURGE, res.Entries[2].Action)
+	require.Equal(t, int64(106), res.Entries[2].Height)
+	require.Empty(t, res.Entries[2].Actor)
+	require.Equal(t, uint64(1), res.Entries[2].Version)
+
+	// Test: Posts that never existed are not found
+	_, err = k.PostTimeline(at(106), &types.QueryPostTimelineRequest{Id: 42})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+}
+
+func TestPostAtHeightQuery(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	wctx := sdk.UnwrapSDKContext(ctx).WithBlockHeight(7)
+
+	_, err := ms.CreatePost(wctx, &types.MsgCreatePost{Creator: creator1.String(), Title: "first", Body: "body"})
+	require.NoError(t, err)
+
+	// Test: The post is read when the query context is at the requested height
+	res, err := k.PostAtHeight(wctx, &types.QueryPostAtHeightRequest{Id: 1, Height: 7})
+	require.NoError(t, err)
+	require.Equal(t, "first", res.Post.Title)
+	_, err = k.PostAtHeight(wctx, &types.QueryPostAtHeightRequest{Id: 2, Height: 7})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+
+	// Test: Queries whose context is at another height are refused
+	_, err = k.PostAtHeight(wctx, &types.QueryPostAtHeightRequest{Id: 1, Height: 6})
+	require.ErrorContains(t, err, "x-cosmos-block-height")
+	_, err = k.PostAtHeight(wctx, &types.QueryPostAtHeightRequest{Id: 1})
+	require.Error(t, err)
+}
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"blog/x/blog/types"
+)
+
+func (k Keeper) Profile(goCtx context.Context, req *types.QueryProfileRequest) (*types.QueryProfileResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := validateQueryAddress("address", req.Address); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	profile, found := k.GetProfile(ctx, req.Address)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	return &types.QueryProfileResponse{Profile: profile}, nil
+}
+
+func (k Keeper) ProfileByName(goCtx context.Context, req *types.QueryProfileByNameRequest) (*types.QueryProfileByNameResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.DisplayName == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing display name")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	address, found := k.GetProfileAddressByName(ctx, req.DisplayName)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+	profile, found := k.GetProfile(ctx, address)
+	if !found {
+		return nil, status.Errorf(codes.Internal, "name index references missing profile %s", address)
+	}
+
+	return &types.QueryProfileByNameResponse{Profile: profile}, nil
+}
@@ -0,0 +1,94 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"blog/x/blog/types"
+)
+
+func TestProfileQueries(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	wctx := sdk.UnwrapSDKContext(ctx)
+
+	// Test: Profile of an address without one
+	_, err := k.Profile(wctx, &types.QueryProfileRequest{Address: creator1.String()})
+	require.Error(t, err)
+
+	// Test: The address and the display name are required
+	for _, address := range []string{"", "not-an-address"} {
+		_, err = k.Profile(wctx, &types.QueryProfileRequest{Address: address})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	}
+	_, err = k.ProfileByName(wctx, &types.QueryProfileByNameRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Test: Set a profile and look it up by address and by name
+	_, err = ms.SetProfile(wctx, &types.MsgSetProfile{
+		Creator:     creator1.String(),
+		DisplayName: "Alice",
+		Bio:         "bio",
+		Links:       []string{"https://example.com"},
+	})
+	require.NoError(t, err)
+	res, err := k.Profile(wctx, &types.QueryProfileRequest{Address: creator1.String()})
+	require.NoError(t, err)
+	require.Equal(t, "Alice", res.Profile.DisplayName)
+	require.Equal(t, []string{"https://example.com"}, res.Profile.Links)
+	byName, err := k.ProfileByName(wctx, &types.QueryProfileByNameRequest{DisplayName: "alice"})
+	require.NoError(t, err)
+	require.Equal(t, creator1.String(), byName.Profile.Address)
+
+	// Test: Display names are unique regardless of case
+	_, err = ms.SetProfile(wctx, &types.MsgSetProfile{Creator: creator2.String(), DisplayName: "ALICE"})
+	require.ErrorIs(t, err, types.ErrDisplayNameTaken)
+
+	// Test: The owner can change the case of its name
+	_, err = ms.SetProfile(wctx, &types.MsgSetProfile{Creator: creator1.String(), DisplayName: "ALICE"})
+	require.NoError(t, err)
+
+	// Test: Renaming releases the previous name
+	_, err = ms.SetProfile(wctx, &types.MsgSetProfile{Creator: creator1.String(), DisplayName: "alice2"})
+	require.NoError(t, err)
+	_, err = k.ProfileByName(wctx, &types.QueryProfileByNameRequest{DisplayName: "alice"})
+	require.Error(t, err)
+	_, err = ms.SetProfile(wctx, &types.MsgSetProfile{Creator: creator2.String(), DisplayName: "alice"})
+	require.NoError(t, err)
+}
+
+func TestPostQueriesIncludeProfile(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	wctx := sdk.UnwrapSDKContext(ctx)
+
+	for _, creator := range []string{creator1.String(), creator2.String(), creator1.String()} {
+		_, err := ms.CreatePost(wctx, &types.MsgCreatePost{Creator: creator, Title: "title", Body: "body"})
+		require.NoError(t, err)
+	}
+	_, err := ms.SetProfile(wctx, &types.MsgSetProfile{Creator: creator1.String(), DisplayName: "alice"})
+	require.NoError(t, err)
+
+	// Test: Profiles are only embedded on request
+	show, err := k.ShowPost(wctx, &types.QueryShowPostRequest{Id: 1})
+	require.NoError(t, err)
+	require.Nil(t, show.AuthorProfile)
+	show, err = k.ShowPost(wctx, &types.QueryShowPostRequest{Id: 1, IncludeProfile: true})
+	require.NoError(t, err)
+	require.NotNil(t, show.AuthorProfile)
+	require.Equal(t, "alice", show.AuthorProfile.DisplayName)
+
+	// Test: Creators without a profile are skipped
+	show, err = k.ShowPost(wctx, &types.QueryShowPostRequest{Id: 2, IncludeProfile: true})
+	require.NoError(t, err)
+	require.Nil(t, show.AuthorProfile)
+
+	// Test: ListPost embeds one profile per creator
+	list, err := k.ListPost(wctx, &types.QueryListPostRequest{IncludeProfiles: true})
+	require.NoError(t, err)
+	require.Len(t, list.Post, 3)
+	require.Len(t, list.Profiles, 1)
+	require.Equal(t, creator1.String(), list.Profiles[0].Address)
+}
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"blog/x/blog/types"
+)
+
+// RenderPost returns the body of a post rendered as sanitized HTML
+func (k Keeper) RenderPost(goCtx context.Context, req *types.QueryRenderPostRequest) (*types.QueryRenderPostResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	post, found := k.GetPost(ctx, req.Id)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	return &types.QueryRenderPostResponse{
+		Html:       types.RenderBody(post.BodyFormat, post.Body),
+		BodyFormat: post.BodyFormat,
+		Version:    post.Version,
+	}, nil
+}
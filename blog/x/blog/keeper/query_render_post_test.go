@@ -0,0 +1,74 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"blog/x/blog/types"
+)
+
+func TestRenderPostQuery(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	wctx := sdk.UnwrapSDKContext(ctx)
+
+	// Test: Markdown bodies are rendered and sanitized
+	_, err := ms.CreatePost(wctx, &types.MsgCreatePost{
+		Creator:    creator1.String(),
+		Title:      "Markdown",
+		Body:       "# Hi\n\n*there* <script>alert(1)</script>",
+		BodyFormat: types.BodyFormat_BODY_FORMAT_MARKDOWN,
+	})
+	require.NoError(t, err)
+	res, err := k.RenderPost(wctx, &types.QueryRenderPostRequest{Id: 1})
+	require.NoError(t, err)
+	require.Equal(t, "<h1>Hi</h1>\n\n<p><em>there</em> </p>\n", res.Html)
+	require.Equal(t, types.BodyFormat_BODY_FORMAT_MARKDOWN, res.BodyFormat)
+	require.Equal(t, uint64(1), res.Version)
+
+	// Test: Plain bodies are escaped
+	_, err = ms.CreatePost(wctx, &types.MsgCreatePost{Creator: creator1.String(), Title: "Plain", Body: "a <b>"})
+	require.NoError(t, err)
+	res, err = k.RenderPost(wctx, &types.QueryRenderPostRequest{Id: 2})
+	require.NoError(t, err)
+	require.Equal(t, "<p>a &lt;b&gt;</p>\n", res.Html)
+
+	// Test: HTML bodies must already be sanitized, on create and on update
+	_, err = ms.CreatePost(wctx, &types.MsgCreatePost{
+		Creator:    creator1.String(),
+		Title:      "HTML",
+		Body:       "<p onclick=\"x\">hi</p>",
+		BodyFormat: types.BodyFormat_BODY_FORMAT_HTML_SANITIZED,
+	})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	_, err = ms.UpdatePost(wctx, &types.MsgUpdatePost{
+		Creator:    creator1.String(),
+		Id:         2,
+		Title:      "Plain",
+		Body:       "<p>hi</p><script>x</script>",
+		BodyFormat: types.BodyFormat_BODY_FORMAT_HTML_SANITIZED,
+	})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// Test: Updates can change the format of a post
+	_, err = ms.UpdatePost(wctx, &types.MsgUpdatePost{
+		Creator:    creator1.String(),
+		Id:         2,
+		Title:      "Plain",
+		Body:       "<p>hi</p>",
+		BodyFormat: types.BodyFormat_BODY_FORMAT_HTML_SANITIZED,
+	})
+	require.NoError(t, err)
+	res, err = k.RenderPost(wctx, &types.QueryRenderPostRequest{Id: 2})
+	require.NoError(t, err)
+	require.Equal(t, "<p>hi</p>", res.Html)
+	require.Equal(t, uint64(2), res.Version)
+
+	// Test: Unknown posts
+	_, err = k.RenderPost(wctx, &types.QueryRenderPostRequest{Id: 42})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+	_, err = k.RenderPost(wctx, nil)
+	require.Error(t, err)
+}
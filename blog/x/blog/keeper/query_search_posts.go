@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"blog/x/blog/types"
+)
+
+func (k Keeper) SearchPosts(goCtx context.Context, req *types.QuerySearchPostsRequest) (*types.QuerySearchPostsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	terms, err := types.ParseSearchQuery(req.Query)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ids, pageRes, err := paginateIDs(k.searchPostIDs(ctx, terms), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	posts := make([]types.Post, 0, len(ids))
+	for _, id := range ids {
+		post, found := k.GetPost(ctx, id)
+		if !found {
+			return nil, status.Errorf(codes.Internal, "search index references missing post %d", id)
+		}
+		posts = append(posts, post)
+	}
+
+	return &types.QuerySearchPostsResponse{Posts: posts, Pagination: pageRes}, nil
+}
@@ -0,0 +1,77 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"blog/x/blog/types"
+)
+
+func TestSearchPostsQuery(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	wctx := sdk.UnwrapSDKContext(ctx)
+
+	for _, msg := range []*types.MsgCreatePost{
+		{Creator: creator1.String(), Title: "Hello Cosmos", Body: "Building blockchains with the SDK"},
+		{Creator: creator1.String(), Title: "Cosmos blogging", Body: "Posts stored on chain"},
+		{Creator: creator2.String(), Title: "Unrelated", Body: "Nothing to see here"},
+	} {
+		_, err := ms.CreatePost(wctx, msg)
+		require.NoError(t, err)
+	}
+
+	search := func(q string) []uint64 {
+		res, err := k.SearchPosts(wctx, &types.QuerySearchPostsRequest{Query: q})
+		require.NoError(t, err)
+		ids := make([]uint64, 0, len(res.Posts))
+		for _, post := range res.Posts {
+			ids = append(ids, post.Id)
+		}
+		return ids
+	}
+
+	require.Equal(t, []uint64{1, 2}, search("cosmos"))
+	require.Equal(t, []uint64{1}, search("COSMOS sdk"))
+	require.Equal(t, []uint64{1, 2}, search("blo*"))
+	require.Equal(t, []uint64{2}, search("blo* chain"))
+	require.Empty(t, search("blo"))
+
+	// Test: Updates and deletes keep the index in sync
+	_, err := ms.UpdatePost(wctx, &types.MsgUpdatePost{Creator: creator1.String(), Id: 1, Title: "Hello Gaia", Body: "Building blockchains"})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{2}, search("cosmos"))
+	require.Equal(t, []uint64{1}, search("gaia"))
+
+	_, err = ms.DeletePost(wctx, &types.MsgDeletePost{Creator: creator1.String(), Id: 2})
+	require.NoError(t, err)
+	require.Empty(t, search("cosmos"))
+
+	// Test: Pagination over the matching posts
+	_, err = ms.CreatePost(wctx, &types.MsgCreatePost{Creator: creator2.String(), Title: "Hello again", Body: "Building more"})
+	require.NoError(t, err)
+	res, err := k.SearchPosts(wctx, &types.QuerySearchPostsRequest{Query: "building", Pagination: &query.PageRequest{Limit: 1, CountTotal: true}})
+	require.NoError(t, err)
+	require.Len(t, res.Posts, 1)
+	require.Equal(t, uint64(1), res.Posts[0].Id)
+	require.Equal(t, uint64(2), res.Pagination.Total)
+	res, err = k.SearchPosts(wctx, &types.QuerySearchPostsRequest{Query: "building", Pagination: &query.PageRequest{Key: res.Pagination.NextKey}})
+	require.NoError(t, err)
+	require.Len(t, res.Posts, 1)
+	require.Equal(t, uint64(4), res.Posts[0].Id)
+	require.Nil(t, res.Pagination.NextKey)
+
+	// Test: Page keys that are not post IDs are rejected
+	_, err = k.SearchPosts(wctx, &types.QuerySearchPostsRequest{Query: "building", Pagination: &query.PageRequest{Key: []byte{1, 2, 3}}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Test: Invalid queries are rejected
+	_, err = k.SearchPosts(wctx, &types.QuerySearchPostsRequest{Query: ""})
+	require.Error(t, err)
+	_, err = k.SearchPosts(wctx, nil)
+	require.Error(t, err)
+}
@@ -22,5 +22,17 @@ func (k Keeper) ShowPost(goCtx context.Context, req *types.QueryShowPostRequest)
 		return nil, sdkerrors.ErrKeyNotFound
 	}
 
-	return &types.QueryShowPostResponse{Post: post}, nil
+	res := &types.QueryShowPostResponse{
+		Post:      post,
+		EditCount: k.GetPostEditCount(ctx, post.Id),
+		SeriesIds: k.GetPostSeriesIDs(ctx, post.Id),
+		Revision:  types.PostRevision(post),
+	}
+	if req.IncludeProfile {
+		if profile, found := k.GetProfile(ctx, post.Creator); found {
+			res.AuthorProfile = &profile
+		}
+	}
+
+	return res, nil
 }
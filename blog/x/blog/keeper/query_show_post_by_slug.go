@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"blog/x/blog/types"
+)
+
+func (k Keeper) ShowPostBySlug(goCtx context.Context, req *types.QueryShowPostBySlugRequest) (*types.QueryShowPostBySlugResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	id, found := k.GetPostIDBySlug(ctx, req.Slug)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+	post, found := k.GetPost(ctx, id)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	return &types.QueryShowPostBySlugResponse{Post: post, Redirect: post.Slug != req.Slug}, nil
+}
@@ -0,0 +1,64 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"blog/x/blog/types"
+)
+
+func TestShowPostBySlugQuery(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	wctx := sdk.UnwrapSDKContext(ctx)
+
+	// Test: Slugs are derived from the title and made unique
+	for _, msg := range []*types.MsgCreatePost{
+		{Creator: creator1.String(), Title: "Hello World", Body: "body"},
+		{Creator: creator2.String(), Title: "Hello, world!", Body: "body"},
+		{Creator: creator2.String(), Title: "Custom", Body: "body", Slug: "my-slug"},
+	} {
+		_, err := ms.CreatePost(wctx, msg)
+		require.NoError(t, err)
+	}
+	for id, slug := range map[uint64]string{1: "hello-world", 2: "hello-world-2", 3: "my-slug"} {
+		res, err := k.ShowPostBySlug(wctx, &types.QueryShowPostBySlugRequest{Slug: slug})
+		require.NoError(t, err)
+		require.Equal(t, id, res.Post.Id)
+		require.False(t, res.Redirect)
+	}
+
+	// Test: Explicit slugs must be free
+	_, err := ms.CreatePost(wctx, &types.MsgCreatePost{Creator: creator1.String(), Title: "Other", Slug: "my-slug"})
+	require.ErrorIs(t, err, types.ErrSlugTaken)
+	_, err = ms.UpdatePost(wctx, &types.MsgUpdatePost{Creator: creator1.String(), Id: 1, Title: "Hello World", Slug: "my-slug"})
+	require.ErrorIs(t, err, types.ErrSlugTaken)
+
+	// Test: Renaming keeps the old slug as a redirect
+	_, err = ms.UpdatePost(wctx, &types.MsgUpdatePost{Creator: creator1.String(), Id: 1, Title: "Hello World", Slug: "hello-cosmos"})
+	require.NoError(t, err)
+	res, err := k.ShowPostBySlug(wctx, &types.QueryShowPostBySlugRequest{Slug: "hello-world"})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.Post.Id)
+	require.Equal(t, "hello-cosmos", res.Post.Slug)
+	require.True(t, res.Redirect)
+
+	// Test: A post can move back to one of its previous slugs
+	_, err = ms.UpdatePost(wctx, &types.MsgUpdatePost{Creator: creator1.String(), Id: 1, Title: "Hello World", Slug: "hello-world"})
+	require.NoError(t, err)
+	res, err = k.ShowPostBySlug(wctx, &types.QueryShowPostBySlugRequest{Slug: "hello-cosmos"})
+	require.NoError(t, err)
+	require.Equal(t, "hello-world", res.Post.Slug)
+	require.True(t, res.Redirect)
+
+	// Test: Deleting a post releases all its slugs
+	_, err = ms.DeletePost(wctx, &types.MsgDeletePost{Creator: creator1.String(), Id: 1})
+	require.NoError(t, err)
+	for _, slug := range []string{"hello-world", "hello-cosmos"} {
+		_, err = k.ShowPostBySlug(wctx, &types.QueryShowPostBySlugRequest{Slug: slug})
+		require.Error(t, err)
+	}
+	_, err = ms.CreatePost(wctx, &types.MsgCreatePost{Creator: creator1.String(), Title: "Reuse", Slug: "hello-cosmos"})
+	require.NoError(t, err)
+}
@@ -0,0 +1,86 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cometbft/cometbft/crypto"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"blog/x/blog/types"
+)
+
+func TestSeries(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	wctx := sdk.UnwrapSDKContext(ctx)
+	stranger := sdk.AccAddress(crypto.AddressHash([]byte("stranger"))).String()
+
+	// Posts 1 and 2 by creator1, post 3 by creator2
+	for _, creator := range []string{creator1.String(), creator1.String(), creator2.String()} {
+		_, err := ms.CreatePost(wctx, &types.MsgCreatePost{Creator: creator, Title: "part", Body: "body"})
+		require.NoError(t, err)
+	}
+	series := func() types.Series {
+		res, err := k.ShowSeries(wctx, &types.QueryShowSeriesRequest{Id: 1})
+		require.NoError(t, err)
+		return res.Series
+	}
+
+	// Test: Create a series seeded with posts
+	_, err := ms.CreateSeries(wctx, &types.MsgCreateSeries{Creator: creator1.String(), Title: "Cosmos", PostIds: []uint64{4}})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+	res, err := ms.CreateSeries(wctx, &types.MsgCreateSeries{Creator: creator1.String(), Title: "Cosmos", PostIds: []uint64{1}})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.Id)
+
+	// Test: Only the owner may append, and only posts they edit
+	_, err = ms.AppendSeriesPost(wctx, &types.MsgAppendSeriesPost{Creator: stranger, SeriesId: 1, PostId: 2})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = ms.AppendSeriesPost(wctx, &types.MsgAppendSeriesPost{Creator: creator2.String(), SeriesId: 1, PostId: 3})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = ms.AppendSeriesPost(wctx, &types.MsgAppendSeriesPost{Creator: creator1.String(), SeriesId: 1, PostId: 3})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = ms.CreateSeries(wctx, &types.MsgCreateSeries{Creator: creator1.String(), Title: "Borrowed", PostIds: []uint64{3}})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = ms.AppendSeriesPost(wctx, &types.MsgAppendSeriesPost{Creator: creator1.String(), SeriesId: 1, PostId: 2})
+	require.NoError(t, err)
+	_, err = ms.AddEditor(wctx, &types.MsgAddEditor{Creator: creator2.String(), Id: 3, Editor: creator1.String()})
+	require.NoError(t, err)
+	_, err = ms.AppendSeriesPost(wctx, &types.MsgAppendSeriesPost{Creator: creator1.String(), SeriesId: 1, PostId: 3})
+	require.NoError(t, err)
+	_, err = ms.AppendSeriesPost(wctx, &types.MsgAppendSeriesPost{Creator: creator1.String(), SeriesId: 1, PostId: 3})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	require.Equal(t, []uint64{1, 2, 3}, series().PostIds)
+
+	// Test: Only the owner may reorder, and only into a permutation
+	_, err = ms.ReorderSeries(wctx, &types.MsgReorderSeries{Creator: creator2.String(), SeriesId: 1, PostIds: []uint64{3, 2, 1}})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = ms.ReorderSeries(wctx, &types.MsgReorderSeries{Creator: creator1.String(), SeriesId: 1, PostIds: []uint64{3, 2}})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	_, err = ms.ReorderSeries(wctx, &types.MsgReorderSeries{Creator: creator1.String(), SeriesId: 1, PostIds: []uint64{3, 2, 4}})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	_, err = ms.ReorderSeries(wctx, &types.MsgReorderSeries{Creator: creator1.String(), SeriesId: 1, PostIds: []uint64{3, 1, 2}})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{3, 1, 2}, series().PostIds)
+
+	// Test: ShowPost lists the series of a post
+	show, err := k.ShowPost(wctx, &types.QueryShowPostRequest{Id: 3})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1}, show.SeriesIds)
+
+	// Test: Only the owner may remove a post
+	_, err = ms.RemoveSeriesPost(wctx, &types.MsgRemoveSeriesPost{Creator: creator2.String(), SeriesId: 1, PostId: 3})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = ms.RemoveSeriesPost(wctx, &types.MsgRemoveSeriesPost{Creator: creator1.String(), SeriesId: 1, PostId: 3})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 2}, series().PostIds)
+	show, err = k.ShowPost(wctx, &types.QueryShowPostRequest{Id: 3})
+	require.NoError(t, err)
+	require.Empty(t, show.SeriesIds)
+
+	// Test: Deleting a post drops it from its series
+	_, err = ms.DeletePost(wctx, &types.MsgDeletePost{Creator: creator1.String(), Id: 1})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{2}, series().PostIds)
+}
@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"blog/x/blog/types"
+)
+
+func (k Keeper) BlogStats(goCtx context.Context, req *types.QueryBlogStatsRequest) (*types.QueryBlogStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryBlogStatsResponse{
+		TotalPosts:    k.GetTotalPosts(ctx),
+		TotalCreators: k.GetTotalCreators(ctx),
+		TotalEdits:    k.GetTotalEdits(ctx),
+		LastPostId:    k.GetPostCount(ctx),
+	}, nil
+}
+
+func (k Keeper) CreatorStats(goCtx context.Context, req *types.QueryCreatorStatsRequest) (*types.QueryCreatorStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if _, err := sdk.AccAddressFromBech32(req.Address); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err)
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryCreatorStatsResponse{
+		PostCount: k.GetCreatorPostCount(ctx, req.Address),
+		EditCount: k.GetCreatorEditCount(ctx, req.Address),
+	}, nil
+}
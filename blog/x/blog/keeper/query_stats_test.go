@@ -0,0 +1,57 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"blog/x/blog/types"
+)
+
+func TestStatsQueries(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	wctx := sdk.UnwrapSDKContext(ctx)
+
+	for _, msg := range []*types.MsgCreatePost{
+		{Creator: creator1.String(), Title: "Post 1", Body: "body"},
+		{Creator: creator1.String(), Title: "Post 2", Body: "body"},
+		{Creator: creator2.String(), Title: "Post 3", Body: "body"},
+	} {
+		_, err := ms.CreatePost(wctx, msg)
+		require.NoError(t, err)
+	}
+	for i := 0; i < 2; i++ {
+		_, err := ms.UpdatePost(wctx, &types.MsgUpdatePost{Creator: creator1.String(), Id: 1, Title: "Post 1", Body: "edited"})
+		require.NoError(t, err)
+	}
+
+	stats, err := k.BlogStats(wctx, &types.QueryBlogStatsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryBlogStatsResponse{TotalPosts: 3, TotalCreators: 2, TotalEdits: 2, LastPostId: 3}, stats)
+
+	creatorStats, err := k.CreatorStats(wctx, &types.QueryCreatorStatsRequest{Address: creator1.String()})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryCreatorStatsResponse{PostCount: 2, EditCount: 2}, creatorStats)
+
+	post, err := k.ShowPost(wctx, &types.QueryShowPostRequest{Id: 1})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), post.EditCount)
+
+	// Test: Deletions are reflected in the live counters
+	_, err = ms.DeletePost(wctx, &types.MsgDeletePost{Creator: creator1.String(), Id: 1})
+	require.NoError(t, err)
+	_, err = ms.DeletePost(wctx, &types.MsgDeletePost{Creator: creator2.String(), Id: 3})
+	require.NoError(t, err)
+
+	stats, err = k.BlogStats(wctx, &types.QueryBlogStatsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryBlogStatsResponse{TotalPosts: 1, TotalCreators: 1, TotalEdits: 2, LastPostId: 3}, stats)
+
+	creatorStats, err = k.CreatorStats(wctx, &types.QueryCreatorStatsRequest{Address: creator1.String()})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryCreatorStatsResponse{PostCount: 1}, creatorStats)
+
+	_, err = k.CreatorStats(wctx, &types.QueryCreatorStatsRequest{Address: "invalid"})
+	require.Error(t, err)
+}
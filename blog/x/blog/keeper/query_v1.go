@@ -0,0 +1,279 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"blog/x/blog/types"
+	v1 "blog/x/blog/types/v1"
+)
+
+// v1QueryServer implements the blog.blog.v1 Query service by delegating to
+// the queries of the Keeper, so that both services always serve the same
+// state.
+type v1QueryServer struct {
+	Keeper
+}
+
+// NewV1QueryServerImpl returns an implementation of the v1 QueryServer
+// interface for the provided Keeper.
+func NewV1QueryServerImpl(keeper Keeper) v1.QueryServer {
+	return &v1QueryServer{Keeper: keeper}
+}
+
+var _ v1.QueryServer = v1QueryServer{}
+
+func (q v1QueryServer) Params(ctx context.Context, req *v1.QueryParamsRequest) (*v1.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	res, err := q.Keeper.Params(ctx, &types.QueryParamsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QueryParamsResponse{Params: res.Params}, nil
+}
+
+func (q v1QueryServer) Posts(ctx context.Context, req *v1.QueryPostsRequest) (*v1.QueryPostsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	res, err := q.ListPost(ctx, &types.QueryListPostRequest{
+		Pagination:      req.Pagination,
+		Creator:         req.Creator,
+		CreatedAfter:    req.CreatedAfter,
+		CreatedBefore:   req.CreatedBefore,
+		UpdatedAfter:    req.UpdatedAfter,
+		UpdatedBefore:   req.UpdatedBefore,
+		TitlePrefix:     req.TitlePrefix,
+		Order:           req.Order,
+		IncludeProfiles: req.IncludeProfiles,
+		Tag:             req.Tag,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QueryPostsResponse{Posts: res.Post, Pagination: res.Pagination, Profiles: res.Profiles}, nil
+}
+
+func (q v1QueryServer) Post(ctx context.Context, req *v1.QueryPostRequest) (*v1.QueryPostResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	res, err := q.ShowPost(ctx, &types.QueryShowPostRequest{Id: req.Id, IncludeProfile: req.IncludeProfile})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QueryPostResponse{
+		Post:          res.Post,
+		EditCount:     res.EditCount,
+		AuthorProfile: res.AuthorProfile,
+		SeriesIds:     res.SeriesIds,
+		Revision:      res.Revision,
+	}, nil
+}
+
+func (q v1QueryServer) RenderedPost(ctx context.Context, req *v1.QueryRenderedPostRequest) (*v1.QueryRenderedPostResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	res, err := q.RenderPost(ctx, &types.QueryRenderPostRequest{Id: req.Id})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QueryRenderedPostResponse{Html: res.Html, BodyFormat: res.BodyFormat, Version: res.Version}, nil
+}
+
+func (q v1QueryServer) PostChangeProposals(ctx context.Context, req *v1.QueryPostChangeProposalsRequest) (*v1.QueryPostChangeProposalsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	res, err := q.Keeper.PostChangeProposals(ctx, &types.QueryPostChangeProposalsRequest{PostId: req.PostId})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QueryPostChangeProposalsResponse{Proposals: res.Proposals}, nil
+}
+
+func (q v1QueryServer) PostAtHeight(ctx context.Context, req *v1.QueryPostAtHeightRequest) (*v1.QueryPostAtHeightResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	res, err := q.Keeper.PostAtHeight(ctx, &types.QueryPostAtHeightRequest{Id: req.Id, Height: req.Height})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QueryPostAtHeightResponse{Post: res.Post}, nil
+}
+
+func (q v1QueryServer) PostTimeline(ctx context.Context, req *v1.QueryPostTimelineRequest) (*v1.QueryPostTimelineResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	res, err := q.Keeper.PostTimeline(ctx, &types.QueryPostTimelineRequest{Id: req.Id, Pagination: req.Pagination})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QueryPostTimelineResponse{Entries: res.Entries, Pagination: res.Pagination}, nil
+}
+
+func (q v1QueryServer) PostPurchasers(ctx context.Context, req *v1.QueryPostPurchasersRequest) (*v1.QueryPostPurchasersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	res, err := q.Keeper.PostPurchasers(ctx, &types.QueryPostPurchasersRequest{Id: req.Id, Escrowed: req.Escrowed, Pagination: req.Pagination})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QueryPostPurchasersResponse{Purchasers: res.Purchasers, Pagination: res.Pagination}, nil
+}
+
+func (q v1QueryServer) PostBySlug(ctx context.Context, req *v1.QueryPostBySlugRequest) (*v1.QueryPostBySlugResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	res, err := q.ShowPostBySlug(ctx, &types.QueryShowPostBySlugRequest{Slug: req.Slug})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QueryPostBySlugResponse{Post: res.Post, Redirect: res.Redirect}, nil
+}
+
+func (q v1QueryServer) SearchPosts(ctx context.Context, req *v1.QuerySearchPostsRequest) (*v1.QuerySearchPostsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	res, err := q.Keeper.SearchPosts(ctx, &types.QuerySearchPostsRequest{Query: req.Query, Pagination: req.Pagination})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QuerySearchPostsResponse{Posts: res.Posts, Pagination: res.Pagination}, nil
+}
+
+func (q v1QueryServer) Series(ctx context.Context, req *v1.QuerySeriesRequest) (*v1.QuerySeriesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	res, err := q.ShowSeries(ctx, &types.QueryShowSeriesRequest{Id: req.Id})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QuerySeriesResponse{Series: res.Series}, nil
+}
+
+func (q v1QueryServer) Stats(ctx context.Context, req *v1.QueryStatsRequest) (*v1.QueryStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	res, err := q.BlogStats(ctx, &types.QueryBlogStatsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QueryStatsResponse{
+		TotalPosts:    res.TotalPosts,
+		TotalCreators: res.TotalCreators,
+		TotalEdits:    res.TotalEdits,
+		LastPostId:    res.LastPostId,
+	}, nil
+}
+
+func (q v1QueryServer) AccountStats(ctx context.Context, req *v1.QueryAccountStatsRequest) (*v1.QueryAccountStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	res, err := q.CreatorStats(ctx, &types.QueryCreatorStatsRequest{Address: req.Address})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QueryAccountStatsResponse{PostCount: res.PostCount, EditCount: res.EditCount}, nil
+}
+
+func (q v1QueryServer) AccountProfile(ctx context.Context, req *v1.QueryAccountProfileRequest) (*v1.QueryAccountProfileResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	res, err := q.Profile(ctx, &types.QueryProfileRequest{Address: req.Address})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QueryAccountProfileResponse{Profile: res.Profile}, nil
+}
+
+func (q v1QueryServer) AccountFollowers(ctx context.Context, req *v1.QueryAccountFollowersRequest) (*v1.QueryAccountFollowersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	res, err := q.Followers(ctx, &types.QueryFollowersRequest{Address: req.Address, Pagination: req.Pagination})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QueryAccountFollowersResponse{Followers: res.Followers, Pagination: res.Pagination}, nil
+}
+
+func (q v1QueryServer) AccountFollowing(ctx context.Context, req *v1.QueryAccountFollowingRequest) (*v1.QueryAccountFollowingResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	res, err := q.Following(ctx, &types.QueryFollowingRequest{Address: req.Address, Pagination: req.Pagination})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QueryAccountFollowingResponse{Following: res.Following, Pagination: res.Pagination}, nil
+}
+
+func (q v1QueryServer) AccountFeed(ctx context.Context, req *v1.QueryAccountFeedRequest) (*v1.QueryAccountFeedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	res, err := q.Feed(ctx, &types.QueryFeedRequest{Address: req.Address, Pagination: req.Pagination})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QueryAccountFeedResponse{Posts: res.Posts, Pagination: res.Pagination}, nil
+}
+
+func (q v1QueryServer) AccountDeletedPosts(ctx context.Context, req *v1.QueryAccountDeletedPostsRequest) (*v1.QueryAccountDeletedPostsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	res, err := q.DeletedPosts(ctx, &types.QueryDeletedPostsRequest{Creator: req.Address, Pagination: req.Pagination})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QueryAccountDeletedPostsResponse{Posts: res.Posts, Pagination: res.Pagination}, nil
+}
+
+func (q v1QueryServer) HasAccess(ctx context.Context, req *v1.QueryHasAccessRequest) (*v1.QueryHasAccessResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	res, err := q.Keeper.HasAccess(ctx, &types.QueryHasAccessRequest{Address: req.Address, Id: req.Id})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QueryHasAccessResponse{HasAccess: res.HasAccess, Purchased: res.Purchased, HasEnvelope: res.HasEnvelope}, nil
+}
+
+func (q v1QueryServer) PurchasedPosts(ctx context.Context, req *v1.QueryPurchasedPostsRequest) (*v1.QueryPurchasedPostsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	res, err := q.Keeper.PurchasedPosts(ctx, &types.QueryPurchasedPostsRequest{Address: req.Address, Pagination: req.Pagination})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QueryPurchasedPostsResponse{Entitlements: res.Entitlements, Pagination: res.Pagination}, nil
+}
+
+func (q v1QueryServer) ProfileByName(ctx context.Context, req *v1.QueryProfileByNameRequest) (*v1.QueryProfileByNameResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	res, err := q.Keeper.ProfileByName(ctx, &types.QueryProfileByNameRequest{DisplayName: req.DisplayName})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QueryProfileByNameResponse{Profile: res.Profile}, nil
+}
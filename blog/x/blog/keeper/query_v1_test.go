@@ -0,0 +1,97 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	"blog/x/blog/keeper"
+	"blog/x/blog/types"
+	v1 "blog/x/blog/types/v1"
+)
+
+func TestV1Query(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	wctx := sdk.UnwrapSDKContext(ctx)
+	qs := keeper.NewV1QueryServerImpl(k)
+
+	for _, msg := range []*types.MsgCreatePost{
+		{Creator: creator1.String(), Title: "First", Body: "first body"},
+		{Creator: creator2.String(), Title: "Second", Body: "second body"},
+	} {
+		_, err := ms.CreatePost(wctx, msg)
+		require.NoError(t, err)
+	}
+	wctx = wctx.WithBlockTime(wctx.BlockTime().Add(time.Hour))
+	_, err := ms.UpdatePost(wctx, &types.MsgUpdatePost{Creator: creator1.String(), Id: 1, Title: "First", Body: "edited"})
+	require.NoError(t, err)
+
+	// Test: Posts lists the same posts as ListPost under a plural field
+	legacyList, err := k.ListPost(wctx, &types.QueryListPostRequest{Creator: creator2.String()})
+	require.NoError(t, err)
+	list, err := qs.Posts(wctx, &v1.QueryPostsRequest{Creator: creator2.String()})
+	require.NoError(t, err)
+	require.Equal(t, legacyList.Post, list.Posts)
+	list, err = qs.Posts(wctx, &v1.QueryPostsRequest{Pagination: &query.PageRequest{Limit: 1}, Order: types.ListPostOrder_LIST_POST_ORDER_LAST_UPDATED_DESC})
+	require.NoError(t, err)
+	require.Len(t, list.Posts, 1)
+	require.Equal(t, uint64(1), list.Posts[0].Id)
+
+	// Test: Post matches ShowPost
+	legacyPost, err := k.ShowPost(wctx, &types.QueryShowPostRequest{Id: 1})
+	require.NoError(t, err)
+	post, err := qs.Post(wctx, &v1.QueryPostRequest{Id: 1})
+	require.NoError(t, err)
+	require.Equal(t, legacyPost.Post, post.Post)
+	require.Equal(t, uint64(1), post.EditCount)
+	require.Equal(t, legacyPost.Revision, post.Revision)
+
+	// Test: Errors of the delegated queries are returned unchanged
+	_, err = qs.Post(wctx, &v1.QueryPostRequest{Id: 42})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+	_, err = qs.Post(wctx, nil)
+	require.Error(t, err)
+
+	// Test: Counters are served under the account and blog stats
+	stats, err := qs.Stats(wctx, &v1.QueryStatsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), stats.TotalPosts)
+	require.Equal(t, uint64(1), stats.TotalEdits)
+	accountStats, err := qs.AccountStats(wctx, &v1.QueryAccountStatsRequest{Address: creator1.String()})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), accountStats.PostCount)
+	require.Equal(t, uint64(1), accountStats.EditCount)
+
+	// Test: Deleted posts are listed by account
+	_, err = ms.DeletePost(wctx, &types.MsgDeletePost{Creator: creator2.String(), Id: 2})
+	require.NoError(t, err)
+	deleted, err := qs.AccountDeletedPosts(wctx, &v1.QueryAccountDeletedPostsRequest{Address: creator2.String()})
+	require.NoError(t, err)
+	require.Len(t, deleted.Posts, 1)
+	require.Equal(t, uint64(2), deleted.Posts[0].Post.Id)
+
+	// Test: The history of a post matches PostTimeline and PostAtHeight
+	legacyTimeline, err := k.PostTimeline(wctx, &types.QueryPostTimelineRequest{Id: 2})
+	require.NoError(t, err)
+	timeline, err := qs.PostTimeline(wctx, &v1.QueryPostTimelineRequest{Id: 2})
+	require.NoError(t, err)
+	require.Equal(t, legacyTimeline.Entries, timeline.Entries)
+	atHeight, err := qs.PostAtHeight(wctx.WithBlockHeight(3), &v1.QueryPostAtHeightRequest{Id: 1, Height: 3})
+	require.NoError(t, err)
+	require.Equal(t, "edited", atHeight.Post.Body)
+
+	// Test: Access is served under the account and the post
+	access, err := qs.HasAccess(wctx, &v1.QueryHasAccessRequest{Address: creator1.String(), Id: 1})
+	require.NoError(t, err)
+	require.True(t, access.HasAccess)
+	purchases, err := qs.PurchasedPosts(wctx, &v1.QueryPurchasedPostsRequest{Address: creator1.String()})
+	require.NoError(t, err)
+	require.Empty(t, purchases.Entitlements)
+	purchasers, err := qs.PostPurchasers(wctx, &v1.QueryPostPurchasersRequest{Id: 1})
+	require.NoError(t, err)
+	require.Empty(t, purchasers.Purchasers)
+}
@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"blog/x/blog/types"
+)
+
+// AppendSeries stores a new series and returns its ID
+func (k Keeper) AppendSeries(ctx sdk.Context, series types.Series) uint64 {
+	series.Id = k.GetSeriesCount(ctx) + 1
+	k.SetSeries(ctx, series)
+	k.SetSeriesCount(ctx, series.Id)
+	return series.Id
+}
+
+func (k Keeper) GetSeriesCount(ctx sdk.Context) uint64 {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := store.Get(types.KeyPrefix(types.SeriesCountKey))
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) SetSeriesCount(ctx sdk.Context, count uint64) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Set(types.KeyPrefix(types.SeriesCountKey), GetPostIDBytes(count))
+}
+
+func (k Keeper) GetSeries(ctx sdk.Context, id uint64) (val types.Series, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.SeriesKey))
+	b := store.Get(GetPostIDBytes(id))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// SetSeries stores a series and updates the post to series index
+func (k Keeper) SetSeries(ctx sdk.Context, series types.Series) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.SeriesKey))
+	indexStore := prefix.NewStore(storeAdapter, types.KeyPrefix(types.SeriesPostKey))
+
+	if prev, found := k.GetSeries(ctx, series.Id); found {
+		for _, postID := range prev.PostIds {
+			indexStore.Delete(seriesPostKey(postID, series.Id))
+		}
+	}
+	for _, postID := range series.PostIds {
+		indexStore.Set(seriesPostKey(postID, series.Id), []byte{})
+	}
+	store.Set(GetPostIDBytes(series.Id), k.cdc.MustMarshal(&series))
+}
+
+// GetAllSeries returns every series in ID order
+func (k Keeper) GetAllSeries(ctx sdk.Context) (list []types.Series) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.SeriesKey))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Series
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+	return
+}
+
+// GetPostSeriesIDs returns the IDs of the series a post belongs to
+func (k Keeper) GetPostSeriesIDs(ctx sdk.Context, postID uint64) []uint64 {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, append(types.KeyPrefix(types.SeriesPostKey), GetPostIDBytes(postID)...))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var ids []uint64
+	for ; iterator.Valid(); iterator.Next() {
+		ids = append(ids, GetPostIDFromBytes(iterator.Key()))
+	}
+	return ids
+}
+
+// updateSeriesIndex drops a removed post from every series it belongs to.
+func (k Keeper) updateSeriesIndex(ctx sdk.Context, prev *types.Post, next *types.Post) {
+	if prev == nil || next != nil {
+		return
+	}
+	for _, seriesID := range k.GetPostSeriesIDs(ctx, prev.Id) {
+		series, found := k.GetSeries(ctx, seriesID)
+		if !found {
+			continue
+		}
+		series.PostIds = removeSeriesPostID(series.PostIds, prev.Id)
+		k.SetSeries(ctx, series)
+	}
+}
+
+// removeSeriesPostID returns ids without id, preserving the order
+func removeSeriesPostID(ids []uint64, id uint64) []uint64 {
+	kept := make([]uint64, 0, len(ids))
+	for _, postID := range ids {
+		if postID != id {
+			kept = append(kept, postID)
+		}
+	}
+	return kept
+}
+
+// seriesPostKey returns the key of an index entry relative to SeriesPostKey
+func seriesPostKey(postID uint64, seriesID uint64) []byte {
+	return append(GetPostIDBytes(postID), GetPostIDBytes(seriesID)...)
+}
@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"blog/x/blog/types"
+)
+
+// GetPostIDBySlug returns the ID of the post a current or previous slug points to
+func (k Keeper) GetPostIDBySlug(ctx sdk.Context, slug string) (uint64, bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PostSlugKey))
+	bz := store.Get([]byte(slug))
+	if bz == nil {
+		return 0, false
+	}
+	return GetPostIDFromBytes(bz), true
+}
+
+// SetSlugAlias points slug at a stored post, so that it keeps resolving once
+// the post moves to another slug
+func (k Keeper) SetSlugAlias(ctx sdk.Context, slug string, id uint64) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	prefix.NewStore(storeAdapter, types.KeyPrefix(types.PostSlugKey)).Set([]byte(slug), GetPostIDBytes(id))
+	prefix.NewStore(storeAdapter, types.KeyPrefix(types.PostSlugAliasKey)).Set(append(GetPostIDBytes(id), slug...), []byte{})
+}
+
+// GetAllSlugAlias returns the previous slugs of every post, in post ID order.
+// The current slugs of the posts are left out.
+func (k Keeper) GetAllSlugAlias(ctx sdk.Context) (list []types.SlugAlias) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PostSlugAliasKey))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		id, slug := GetPostIDFromBytes(iterator.Key()[:8]), string(iterator.Key()[8:])
+		if post, found := k.GetPost(ctx, id); found && post.Slug == slug {
+			continue
+		}
+		list = append(list, types.SlugAlias{Slug: slug, PostId: id})
+	}
+	return
+}
+
+// availableSlug returns base if no post uses it, otherwise the first free
+// variant of base suffixed with a counter
+func (k Keeper) availableSlug(ctx sdk.Context, base string) string {
+	return types.AvailableSlug(base, func(slug string) bool {
+		_, taken := k.GetPostIDBySlug(ctx, slug)
+		return taken
+	})
+}
+
+// updateSlugIndex points a post's new slug at it and releases every slug of a
+// removed post. Slugs a post is renamed away from keep pointing at it.
+func (k Keeper) updateSlugIndex(ctx sdk.Context, prev *types.Post, next *types.Post) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	slugStore := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PostSlugKey))
+	aliasStore := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PostSlugAliasKey))
+
+	if next != nil {
+		if next.Slug != "" && (prev == nil || prev.Slug != next.Slug) {
+			slugStore.Set([]byte(next.Slug), GetPostIDBytes(next.Id))
+			aliasStore.Set(append(GetPostIDBytes(next.Id), next.Slug...), []byte{})
+		}
+		return
+	}
+
+	if prev != nil {
+		postAliasStore := prefix.NewStore(aliasStore, GetPostIDBytes(prev.Id))
+		iterator := postAliasStore.Iterator(nil, nil)
+		var slugs [][]byte
+		for ; iterator.Valid(); iterator.Next() {
+			slugs = append(slugs, iterator.Key())
+		}
+		iterator.Close()
+
+		for _, slug := range slugs {
+			slugStore.Delete(slug)
+			postAliasStore.Delete(slug)
+		}
+	}
+}
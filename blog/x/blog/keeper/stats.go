@@ -0,0 +1,148 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"blog/x/blog/types"
+)
+
+// GetTotalPosts returns the number of posts currently stored
+func (k Keeper) GetTotalPosts(ctx sdk.Context) uint64 {
+	return k.getCounter(ctx, types.KeyPrefix(types.StatsTotalPostsKey))
+}
+
+// GetTotalCreators returns the number of addresses with at least one post
+func (k Keeper) GetTotalCreators(ctx sdk.Context) uint64 {
+	return k.getCounter(ctx, types.KeyPrefix(types.StatsTotalCreatorsKey))
+}
+
+// GetTotalEdits returns the number of post updates ever applied
+func (k Keeper) GetTotalEdits(ctx sdk.Context) uint64 {
+	return k.getCounter(ctx, types.KeyPrefix(types.StatsTotalEditsKey))
+}
+
+// GetCreatorPostCount returns the number of posts an address currently has
+func (k Keeper) GetCreatorPostCount(ctx sdk.Context, creator string) uint64 {
+	return k.getCounter(ctx, creatorPostsCounterKey(creator))
+}
+
+// GetCreatorEditCount returns the number of updates applied to the current
+// posts of an address
+func (k Keeper) GetCreatorEditCount(ctx sdk.Context, creator string) uint64 {
+	return k.getCounter(ctx, creatorEditsCounterKey(creator))
+}
+
+// GetPostEditCount returns the number of times a post has been updated
+func (k Keeper) GetPostEditCount(ctx sdk.Context, id uint64) uint64 {
+	return k.getCounter(ctx, postEditsCounterKey(id))
+}
+
+// SetPostEditCount sets the number of updates of a stored post, keeping the
+// edit counter of its creator in line
+func (k Keeper) SetPostEditCount(ctx sdk.Context, id uint64, edits uint64) {
+	post, found := k.GetPost(ctx, id)
+	if !found {
+		return
+	}
+	delta := int64(edits) - int64(k.GetPostEditCount(ctx, id))
+	k.addToCounter(ctx, creatorEditsCounterKey(post.Creator), delta)
+	k.setCounter(ctx, postEditsCounterKey(id), edits)
+}
+
+// GetAllPostEditCount returns the edit counters of every post with any, in
+// ID order
+func (k Keeper) GetAllPostEditCount(ctx sdk.Context) (list []types.PostEditCount) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.StatsPostEditsKey))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		list = append(list, types.PostEditCount{
+			PostId: GetPostIDFromBytes(iterator.Key()),
+			Edits:  binary.BigEndian.Uint64(iterator.Value()),
+		})
+	}
+	return
+}
+
+// SetTotalEdits sets the number of post updates ever applied
+func (k Keeper) SetTotalEdits(ctx sdk.Context, edits uint64) {
+	k.setCounter(ctx, types.KeyPrefix(types.StatsTotalEditsKey), edits)
+}
+
+// RecordPostEdit counts an update of a post
+func (k Keeper) RecordPostEdit(ctx sdk.Context, post types.Post) {
+	k.addToCounter(ctx, types.KeyPrefix(types.StatsTotalEditsKey), 1)
+	k.addToCounter(ctx, creatorEditsCounterKey(post.Creator), 1)
+	k.addToCounter(ctx, postEditsCounterKey(post.Id), 1)
+}
+
+// updateStats keeps the post counters in line with a post being added or
+// removed. Updates of an existing post are counted by RecordPostEdit.
+func (k Keeper) updateStats(ctx sdk.Context, prev *types.Post, next *types.Post) {
+	switch {
+	case prev == nil && next != nil:
+		k.addToCounter(ctx, types.KeyPrefix(types.StatsTotalPostsKey), 1)
+		if k.addToCounter(ctx, creatorPostsCounterKey(next.Creator), 1) == 1 {
+			k.addToCounter(ctx, types.KeyPrefix(types.StatsTotalCreatorsKey), 1)
+		}
+	case prev != nil && next == nil:
+		k.addToCounter(ctx, types.KeyPrefix(types.StatsTotalPostsKey), -1)
+		if k.addToCounter(ctx, creatorPostsCounterKey(prev.Creator), -1) == 0 {
+			k.addToCounter(ctx, types.KeyPrefix(types.StatsTotalCreatorsKey), -1)
+		}
+		edits := k.GetPostEditCount(ctx, prev.Id)
+		k.addToCounter(ctx, creatorEditsCounterKey(prev.Creator), -int64(edits))
+		k.setCounter(ctx, postEditsCounterKey(prev.Id), 0)
+	}
+}
+
+func (k Keeper) getCounter(ctx sdk.Context, key []byte) uint64 {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := store.Get(key)
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// setCounter stores a counter, deleting it when it drops to zero
+func (k Keeper) setCounter(ctx sdk.Context, key []byte, value uint64) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	if value == 0 {
+		store.Delete(key)
+		return
+	}
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, value)
+	store.Set(key, bz)
+}
+
+// addToCounter adds delta to a counter, clamping at zero, and returns the new value
+func (k Keeper) addToCounter(ctx sdk.Context, key []byte, delta int64) uint64 {
+	value := k.getCounter(ctx, key)
+	if delta < 0 && uint64(-delta) > value {
+		value = 0
+	} else {
+		value = uint64(int64(value) + delta)
+	}
+	k.setCounter(ctx, key, value)
+	return value
+}
+
+func creatorPostsCounterKey(creator string) []byte {
+	return append(types.KeyPrefix(types.StatsCreatorPostsKey), creator...)
+}
+
+func creatorEditsCounterKey(creator string) []byte {
+	return append(types.KeyPrefix(types.StatsCreatorEditsKey), creator...)
+}
+
+func postEditsCounterKey(id uint64) []byte {
+	return append(types.KeyPrefix(types.StatsPostEditsKey), GetPostIDBytes(id)...)
+}
@@ -0,0 +1,137 @@
+package keeper
+
+import (
+	"slices"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+
+	"blog/x/blog/types"
+)
+
+// TrashPost removes a post and keeps it in the trash, recording who deleted
+// it and when, along with its series positions and edit count
+func (k Keeper) TrashPost(ctx sdk.Context, id uint64, deleter string) {
+	post, found := k.GetPost(ctx, id)
+	if !found {
+		return
+	}
+	var positions []types.SeriesPosition
+	for _, seriesID := range k.GetPostSeriesIDs(ctx, id) {
+		series, found := k.GetSeries(ctx, seriesID)
+		if !found {
+			continue
+		}
+		if i := slices.Index(series.PostIds, id); i >= 0 {
+			positions = append(positions, types.SeriesPosition{SeriesId: seriesID, Position: uint32(i)})
+		}
+	}
+	edits := k.GetPostEditCount(ctx, id)
+
+	k.RemovePost(ctx, id)
+	k.SetDeletedPost(ctx, types.DeletedPost{
+		Post:      post,
+		DeletedBy: deleter,
+		DeletedAt: ctx.BlockTime(),
+		Series:    positions,
+		Edits:     edits,
+	})
+}
+
+func (k Keeper) GetDeletedPost(ctx sdk.Context, id uint64) (val types.DeletedPost, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.TrashKey))
+	b := store.Get(GetPostIDBytes(id))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// SetDeletedPost stores a deleted post along with its index entries
+func (k Keeper) SetDeletedPost(ctx sdk.Context, deleted types.DeletedPost) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	id := deleted.Post.Id
+	prefix.NewStore(storeAdapter, types.KeyPrefix(types.TrashKey)).Set(GetPostIDBytes(id), k.cdc.MustMarshal(&deleted))
+	prefix.NewStore(storeAdapter, types.KeyPrefix(types.TrashDeletedAtKey)).Set(timeIndexKey(deleted.DeletedAt, id), []byte{})
+	prefix.NewStore(storeAdapter, types.KeyPrefix(types.TrashCreatorKey)).Set(trashCreatorKey(deleted.Post.Creator, id), []byte{})
+}
+
+// GetAllDeletedPost returns every post in the trash in ID order
+func (k Keeper) GetAllDeletedPost(ctx sdk.Context) (list []types.DeletedPost) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.TrashKey))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.DeletedPost
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+	return
+}
+
+// RemoveDeletedPost deletes a post from the trash for good
+func (k Keeper) RemoveDeletedPost(ctx sdk.Context, id uint64) {
+	deleted, found := k.GetDeletedPost(ctx, id)
+	if !found {
+		return
+	}
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	prefix.NewStore(storeAdapter, types.KeyPrefix(types.TrashKey)).Delete(GetPostIDBytes(id))
+	prefix.NewStore(storeAdapter, types.KeyPrefix(types.TrashDeletedAtKey)).Delete(timeIndexKey(deleted.DeletedAt, id))
+	prefix.NewStore(storeAdapter, types.KeyPrefix(types.TrashCreatorKey)).Delete(trashCreatorKey(deleted.Post.Creator, id))
+}
+
+// trashExpiry returns when a deleted post stops being restorable, or false if
+// the retention period is disabled
+func (k Keeper) trashExpiry(ctx sdk.Context, deleted types.DeletedPost) (time.Time, bool) {
+	retention := k.GetParams(ctx).TrashRetention
+	if retention == 0 {
+		return time.Time{}, false
+	}
+	return deleted.DeletedAt.Add(secondsToDuration(retention)), true
+}
+
+// PurgeExpiredTrash removes the deleted posts whose retention period has
+// elapsed at the block time
+func (k Keeper) PurgeExpiredTrash(ctx sdk.Context) {
+	retention := k.GetParams(ctx).TrashRetention
+	if retention == 0 {
+		return
+	}
+	cutoff := ctx.BlockTime().Add(-secondsToDuration(retention))
+
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.TrashDeletedAtKey))
+	iterator := store.Iterator(nil, storetypes.PrefixEndBytes(sdk.FormatTimeBytes(cutoff)))
+	var expired []uint64
+	for ; iterator.Valid(); iterator.Next() {
+		expired = append(expired, postIDFromIndexKey(iterator.Key()))
+	}
+	iterator.Close()
+
+	for _, id := range expired {
+		k.AppendPostLogEntry(ctx, id, types.PostLogAction_POST_LOG_ACTION_PURGE, "")
+		k.RemoveDeletedPost(ctx, id)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypePurgePost,
+				sdk.NewAttribute(types.AttributeKeyPostID, strconv.FormatUint(id, 10)),
+			),
+		)
+	}
+}
+
+// trashCreatorKey returns the key of an index entry relative to
+// TrashCreatorKey
+func trashCreatorKey(creator string, id uint64) []byte {
+	return append(address.MustLengthPrefix([]byte(creator)), GetPostIDBytes(id)...)
+}
@@ -10,7 +10,8 @@ import (
 func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 	return &autocliv1.ModuleOptions{
 		Query: &autocliv1.ServiceCommandDescriptor{
-			Service: modulev1.Query_ServiceDesc.ServiceName,
+			Service:              modulev1.Query_ServiceDesc.ServiceName,
+			EnhanceCustomCommand: true, // adds the generated commands to the custom decrypt-post command
 			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
 				{
 					RpcMethod: "Params",
@@ -31,6 +32,128 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{},
 				},
 
+				{
+					RpcMethod:      "SearchPosts",
+					Use:            "search-posts [query]",
+					Short:          "Search posts whose title or body contain every term of the query",
+					Long:           "Search posts whose title or body contain every term of the query. A term ending in '*' matches any word starting with it.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "query"}},
+				},
+
+				{
+					RpcMethod:      "ShowPostBySlug",
+					Use:            "show-post-by-slug [slug]",
+					Short:          "Query a post by its current or a previous slug",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "slug"}},
+				},
+				{
+					RpcMethod: "BlogStats",
+					Use:       "stats",
+					Short:     "Show chain-wide post counters",
+				},
+				{
+					RpcMethod:      "CreatorStats",
+					Use:            "creator-stats [address]",
+					Short:          "Show the post counters of an address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+
+				{
+					RpcMethod:      "Profile",
+					Use:            "profile [address]",
+					Short:          "Show the profile of an address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+
+				{
+					RpcMethod:      "ProfileByName",
+					Use:            "profile-by-name [display-name]",
+					Short:          "Show the profile owning a display name",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "display_name"}},
+				},
+
+				{
+					RpcMethod:      "ShowSeries",
+					Use:            "show-series [id]",
+					Short:          "Show a series and its posts in reading order",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+
+				{
+					RpcMethod:      "PostChangeProposals",
+					Use:            "post-change-proposals [post-id]",
+					Short:          "List the pending change proposals of a co-authored post",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "post_id"}},
+				},
+
+				{
+					RpcMethod:      "DeletedPosts",
+					Use:            "deleted-posts [creator]",
+					Short:          "List the posts of a creator that are in the trash",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "creator"}},
+				},
+
+				{
+					RpcMethod:      "Followers",
+					Use:            "followers [address]",
+					Short:          "List the accounts following an address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+
+				{
+					RpcMethod:      "Following",
+					Use:            "following [address]",
+					Short:          "List the accounts an address follows",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+
+				{
+					RpcMethod:      "Feed",
+					Use:            "feed [address]",
+					Short:          "List the posts of the accounts an address follows, newest first",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+
+				{
+					RpcMethod:      "RenderPost",
+					Use:            "render-post [id]",
+					Short:          "Render the body of a post as sanitized HTML",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+
+				{
+					RpcMethod: "PostAtHeight",
+					Skip:      true, // served by blogd blog post-at-height, which sets the query height
+				},
+
+				{
+					RpcMethod:      "PostTimeline",
+					Use:            "post-timeline [id]",
+					Short:          "List the changes of a post with the heights they were made at",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+
+				{
+					RpcMethod:      "HasAccess",
+					Use:            "has-access [address] [id]",
+					Short:          "Show whether an address may read a post, as a reader or a purchaser",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}, {ProtoField: "id"}},
+				},
+
+				{
+					RpcMethod:      "PurchasedPosts",
+					Use:            "purchased-posts [address]",
+					Short:          "List the posts an address purchased access to",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+
+				{
+					RpcMethod:      "PostPurchasers",
+					Use:            "post-purchasers [id]",
+					Short:          "List the addresses that purchased access to a post",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
@@ -72,6 +195,119 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Send delete editor tx",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}, {ProtoField: "editor"}},
 				},
+				{
+					RpcMethod: "BatchPostOps",
+					Use:       "batch-post-ops",
+					Short:     "Send a batch of create, update and delete operations in one tx",
+					Example:   `blogd tx blog batch-post-ops --ops '{"op_type":"POST_OP_TYPE_CREATE","title":"hello","body":"world"}' --ops '{"op_type":"POST_OP_TYPE_DELETE","id":"1"}'`,
+				},
+				{
+					RpcMethod:      "SetProfile",
+					Use:            "set-profile [display-name]",
+					Short:          "Send a set-profile tx replacing the profile of the sender",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "display_name"}},
+					Example:        `blogd tx blog set-profile jane_doe --bio "Writes about validators" --avatar-uri https://example.com/jane.png --links https://example.com`,
+				},
+				{
+					RpcMethod:      "Follow",
+					Use:            "follow [followee]",
+					Short:          "Send a follow tx adding an account to the sender's feed",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "followee"}},
+				},
+				{
+					RpcMethod:      "Unfollow",
+					Use:            "unfollow [followee]",
+					Short:          "Send an unfollow tx removing an account from the sender's feed",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "followee"}},
+				},
+				{
+					RpcMethod:      "CreateSeries",
+					Use:            "create-series [title]",
+					Short:          "Send a create-series tx",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "title"}},
+					Example:        "blogd tx blog create-series \"Cosmos in depth\" --post-ids 1,2",
+				},
+				{
+					RpcMethod:      "AppendSeriesPost",
+					Use:            "append-series-post [series-id] [post-id]",
+					Short:          "Send an append-series-post tx adding a post at the end of a series",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "series_id"}, {ProtoField: "post_id"}},
+				},
+				{
+					RpcMethod:      "ReorderSeries",
+					Use:            "reorder-series [series-id] [post-ids]",
+					Short:          "Send a reorder-series tx listing every post of the series in the new order",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "series_id"}, {ProtoField: "post_ids", Varargs: true}},
+				},
+				{
+					RpcMethod:      "RemoveSeriesPost",
+					Use:            "remove-series-post [series-id] [post-id]",
+					Short:          "Send a remove-series-post tx",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "series_id"}, {ProtoField: "post_id"}},
+				},
+				{
+					RpcMethod:      "SetCoAuthors",
+					Use:            "set-co-authors [id] [approval-threshold] [co-authors]",
+					Short:          "Send a set-co-authors tx requiring co-author approval for changes to a post",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}, {ProtoField: "approval_threshold"}, {ProtoField: "co_authors", Varargs: true}},
+				},
+				{
+					RpcMethod:      "ProposePostChange",
+					Use:            "propose-post-change [post-id] [title] [body]",
+					Short:          "Send a propose-post-change tx for a co-authored post",
+					Long:           "Send a propose-post-change tx for a co-authored post. By default it proposes a new title and body; --kind co-authors proposes the --co-authors and --approval-threshold instead, and --kind delete proposes deleting the post. Pass an empty title for these kinds.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "post_id"}, {ProtoField: "title"}, {ProtoField: "body", Optional: true}},
+				},
+				{
+					RpcMethod:      "ApprovePostChange",
+					Use:            "approve-post-change [proposal-id]",
+					Short:          "Send an approve-post-change tx",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "proposal_id"}},
+				},
+				{
+					RpcMethod:      "PatchPost",
+					Use:            "patch-post [id] [expected-revision]",
+					Short:          "Send a patch-post tx replacing byte ranges of the body of a post",
+					Long:           "Send a patch-post tx replacing byte ranges of the body of a post. Offsets refer to the current body, and expected-revision is the revision reported by show-post.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}, {ProtoField: "expected_revision"}},
+					Example:        `blogd tx blog patch-post 1 $(blogd q blog show-post 1 -o json | jq -r .revision) --ops '{"start":"6","end":"11","text":"cosmos"}'`,
+				},
+				{
+					RpcMethod:      "RestorePost",
+					Use:            "restore-post [id]",
+					Short:          "Send a restore-post tx moving a deleted post out of the trash",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod: "CreatePrivatePost",
+					Skip:      true, // served by the custom create-private-post command, which encrypts the body
+				},
+				{
+					RpcMethod: "GrantReadAccess",
+					Skip:      true, // served by the custom grant-read-access command, which wraps the content key
+				},
+				{
+					RpcMethod: "RevokeReadAccess",
+					Skip:      true, // served by the custom revoke-read-access command, which rotates the content key
+				},
+				{
+					RpcMethod:      "SetPostPrice",
+					Use:            "set-post-price [id] [price]...",
+					Short:          "Send a set-post-price tx putting an encrypted post up for sale, or off sale without a price",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}, {ProtoField: "price", Varargs: true}},
+				},
+				{
+					RpcMethod:      "PurchaseAccess",
+					Use:            "purchase-access [id] [price]...",
+					Short:          "Send a purchase-access tx paying the current price of a post to its creator",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}, {ProtoField: "price", Varargs: true}},
+				},
+				{
+					RpcMethod:      "SetPostTags",
+					Use:            "set-post-tags [id] [tags]...",
+					Short:          "Send a set-post-tags tx replacing the tags of a post, or clearing them without tags",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}, {ProtoField: "tags", Varargs: true}},
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
@@ -0,0 +1,261 @@
+package blog
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/gorilla/mux"
+
+	"blog/x/blog/types"
+)
+
+const (
+	feedFormatAtom = "atom"
+	feedFormatRSS  = "rss"
+
+	// DefaultFeedLimit is the number of posts of a feed when the request has
+	// no limit parameter, MaxFeedLimit the largest limit served
+	DefaultFeedLimit = 20
+	MaxFeedLimit     = 100
+)
+
+// registerFeedRoutes registers the Atom and RSS feeds of all posts, of the
+// posts of one creator and of the posts with one tag. The feeds list the most
+// recently updated posts first.
+func registerFeedRoutes(clientCtx client.Context, router *mux.Router) {
+	queryClient := types.NewQueryClient(clientCtx)
+	for _, format := range []string{feedFormatAtom, feedFormatRSS} {
+		handler := feedHandler(queryClient, format)
+		router.HandleFunc("/blog/feed."+format, handler).Methods(http.MethodGet)
+		router.HandleFunc("/blog/creators/{creator}/feed."+format, handler).Methods(http.MethodGet)
+		router.HandleFunc("/blog/tags/{tag}/feed."+format, handler).Methods(http.MethodGet)
+	}
+}
+
+// feedHandler serves the feed of the posts returned by ListPost in format
+func feedHandler(queryClient types.QueryClient, format string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		limit := uint64(DefaultFeedLimit)
+		if s := r.URL.Query().Get("limit"); s != "" {
+			n, err := strconv.ParseUint(s, 10, 64)
+			if err != nil || n == 0 || n > MaxFeedLimit {
+				http.Error(w, fmt.Sprintf("limit must be between 1 and %d", MaxFeedLimit), http.StatusBadRequest)
+				return
+			}
+			limit = n
+		}
+
+		title := "Blog posts"
+		creator := mux.Vars(r)["creator"]
+		if creator != "" {
+			if _, err := sdk.AccAddressFromBech32(creator); err != nil {
+				http.Error(w, fmt.Sprintf("invalid creator address: %s", err), http.StatusBadRequest)
+				return
+			}
+			title = "Blog posts by " + creator
+		}
+		tag := mux.Vars(r)["tag"]
+		if tag != "" {
+			if err := types.ValidateTag(tag); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			title = "Blog posts tagged " + tag
+		}
+
+		res, err := queryClient.ListPost(r.Context(), &types.QueryListPostRequest{
+			Pagination: &query.PageRequest{Limit: limit},
+			Creator:    creator,
+			Tag:        tag,
+			Order:      types.ListPostOrder_LIST_POST_ORDER_LAST_UPDATED_DESC,
+		})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		baseURL := requestBaseURL(r)
+		var (
+			feed        any
+			contentType string
+		)
+		switch format {
+		case feedFormatAtom:
+			feed = newAtomFeed(title, baseURL, baseURL+r.URL.Path, res.Post)
+			contentType = "application/atom+xml; charset=utf-8"
+		default:
+			feed = newRSSFeed(title, baseURL, res.Post)
+			contentType = "application/rss+xml; charset=utf-8"
+		}
+		bz, err := xml.MarshalIndent(feed, "", "  ")
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", contentType)
+		_, _ = w.Write([]byte(xml.Header))
+		_, _ = w.Write(bz)
+	}
+}
+
+// requestBaseURL returns the scheme and host the request was sent to, taking
+// the headers of a reverse proxy into account
+func requestBaseURL(r *http.Request) string {
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	if proto := r.Header.Get("X-Forwarded-Proto"); proto != "" {
+		scheme = proto
+	}
+	host := r.Host
+	if fwd := r.Header.Get("X-Forwarded-Host"); fwd != "" {
+		host = fwd
+	}
+	return scheme + "://" + host
+}
+
+// postURL returns the REST URL of a post
+func postURL(baseURL string, id uint64) string {
+	return fmt.Sprintf("%s/blog/blog/show_post/%d", baseURL, id)
+}
+
+// feedUpdated returns the most recent LastUpdatedAt of posts, or the Unix
+// epoch when there are none so that empty feeds are stable
+func feedUpdated(posts []types.Post) time.Time {
+	updated := time.Unix(0, 0).UTC()
+	for _, post := range posts {
+		if post.LastUpdatedAt.After(updated) {
+			updated = post.LastUpdatedAt.UTC()
+		}
+	}
+	return updated
+}
+
+type atomFeed struct {
+	XMLName xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	Title   string      `xml:"title"`
+	ID      string      `xml:"id"`
+	Updated string      `xml:"updated"`
+	Links   []atomLink  `xml:"link"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Rel  string `xml:"rel,attr,omitempty"`
+	Href string `xml:"href,attr"`
+}
+
+type atomEntry struct {
+	Title      string         `xml:"title"`
+	ID         string         `xml:"id"`
+	Link       atomLink       `xml:"link"`
+	Published  string         `xml:"published"`
+	Updated    string         `xml:"updated"`
+	Author     atomAuthor     `xml:"author"`
+	Categories []atomCategory `xml:"category"`
+	Content    atomContent    `xml:"content"`
+}
+
+type atomCategory struct {
+	Term string `xml:"term,attr"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+type atomContent struct {
+	Type string `xml:"type,attr"`
+	Body string `xml:",chardata"`
+}
+
+// newAtomFeed returns the Atom feed of posts, whose entries are timestamped
+// with the last update of the posts
+func newAtomFeed(title, baseURL, selfURL string, posts []types.Post) atomFeed {
+	feed := atomFeed{
+		Title:   title,
+		ID:      selfURL,
+		Updated: feedUpdated(posts).Format(time.RFC3339),
+		Links:   []atomLink{{Rel: "self", Href: selfURL}, {Href: baseURL}},
+	}
+	for _, post := range posts {
+		url := postURL(baseURL, post.Id)
+		var categories []atomCategory
+		for _, tag := range post.Tags {
+			categories = append(categories, atomCategory{Term: tag})
+		}
+		feed.Entries = append(feed.Entries, atomEntry{
+			Title:      post.Title,
+			ID:         url,
+			Link:       atomLink{Rel: "alternate", Href: url},
+			Published:  post.CreatedAt.UTC().Format(time.RFC3339),
+			Updated:    post.LastUpdatedAt.UTC().Format(time.RFC3339),
+			Author:     atomAuthor{Name: post.Creator},
+			Categories: categories,
+			Content:    atomContent{Type: "html", Body: types.RenderBody(post.BodyFormat, post.Body)},
+		})
+	}
+	return feed
+}
+
+type rssFeed struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	LastBuildDate string    `xml:"lastBuildDate"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssItem struct {
+	Title       string   `xml:"title"`
+	Link        string   `xml:"link"`
+	GUID        rssGUID  `xml:"guid"`
+	PubDate     string   `xml:"pubDate"`
+	Categories  []string `xml:"category"`
+	Description string   `xml:"description"`
+}
+
+type rssGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+// newRSSFeed returns the RSS 2.0 feed of posts, whose items are dated with
+// the last update of the posts
+func newRSSFeed(title, baseURL string, posts []types.Post) rssFeed {
+	feed := rssFeed{
+		Version: "2.0",
+		Channel: rssChannel{
+			Title:         title,
+			Link:          baseURL,
+			Description:   title,
+			LastBuildDate: feedUpdated(posts).Format(time.RFC1123Z),
+		},
+	}
+	for _, post := range posts {
+		url := postURL(baseURL, post.Id)
+		feed.Channel.Items = append(feed.Channel.Items, rssItem{
+			Title:       post.Title,
+			Link:        url,
+			GUID:        rssGUID{IsPermaLink: true, Value: url},
+			PubDate:     post.LastUpdatedAt.UTC().Format(time.RFC1123Z),
+			Categories:  post.Tags,
+			Description: types.RenderBody(post.BodyFormat, post.Body),
+		})
+	}
+	return feed
+}
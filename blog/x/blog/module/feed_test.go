@@ -0,0 +1,128 @@
+package blog
+
+import (
+	"context"
+	"encoding/xml"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"blog/testutil/sample"
+	"blog/x/blog/types"
+)
+
+// listPostClient serves ListPost from posts and records the last request
+type listPostClient struct {
+	types.QueryClient
+	posts []types.Post
+	req   *types.QueryListPostRequest
+}
+
+func (c *listPostClient) ListPost(_ context.Context, req *types.QueryListPostRequest, _ ...grpc.CallOption) (*types.QueryListPostResponse, error) {
+	c.req = req
+	return &types.QueryListPostResponse{Post: c.posts}, nil
+}
+
+func TestFeedRoutes(t *testing.T) {
+	creator := sample.AccAddress()
+	at := time.Date(2024, 5, 1, 12, 30, 0, 0, time.UTC)
+	client := &listPostClient{posts: []types.Post{
+		{
+			Id:            2,
+			Creator:       creator,
+			Title:         "Second <post>",
+			Body:          "*hi*",
+			BodyFormat:    types.BodyFormat_BODY_FORMAT_MARKDOWN,
+			CreatedAt:     at,
+			LastUpdatedAt: at.Add(2 * time.Hour),
+			Tags:          []string{"cosmos", "go"},
+		},
+		{
+			Id:            1,
+			Creator:       creator,
+			Title:         "First",
+			Body:          "a & b",
+			CreatedAt:     at,
+			LastUpdatedAt: at.Add(time.Hour),
+		},
+	}}
+	router := mux.NewRouter()
+	for _, format := range []string{feedFormatAtom, feedFormatRSS} {
+		handler := feedHandler(client, format)
+		router.HandleFunc("/blog/feed."+format, handler)
+		router.HandleFunc("/blog/creators/{creator}/feed."+format, handler)
+		router.HandleFunc("/blog/tags/{tag}/feed."+format, handler)
+	}
+	get := func(url string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "http://node:1317"+url, nil))
+		return rec
+	}
+
+	// Test: Atom feed of all posts
+	rec := get("/blog/feed.atom")
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	require.Equal(t, "application/atom+xml; charset=utf-8", rec.Header().Get("Content-Type"))
+	require.Equal(t, "", client.req.Creator)
+	require.Equal(t, uint64(DefaultFeedLimit), client.req.Pagination.Limit)
+	require.Equal(t, types.ListPostOrder_LIST_POST_ORDER_LAST_UPDATED_DESC, client.req.Order)
+	var atom atomFeed
+	require.NoError(t, xml.Unmarshal(rec.Body.Bytes(), &atom))
+	require.Equal(t, "Blog posts", atom.Title)
+	require.Equal(t, "http://node:1317/blog/feed.atom", atom.ID)
+	require.Equal(t, "2024-05-01T14:30:00Z", atom.Updated)
+	require.Len(t, atom.Entries, 2)
+	require.Equal(t, "Second <post>", atom.Entries[0].Title)
+	require.Equal(t, "http://node:1317/blog/blog/show_post/2", atom.Entries[0].Link.Href)
+	require.Equal(t, "2024-05-01T14:30:00Z", atom.Entries[0].Updated)
+	require.Equal(t, "2024-05-01T12:30:00Z", atom.Entries[0].Published)
+	require.Equal(t, creator, atom.Entries[0].Author.Name)
+	require.Equal(t, "<p><em>hi</em></p>\n", atom.Entries[0].Content.Body)
+	require.Equal(t, "<p>a &amp; b</p>\n", atom.Entries[1].Content.Body)
+	require.Equal(t, []atomCategory{{Term: "cosmos"}, {Term: "go"}}, atom.Entries[0].Categories)
+	require.Empty(t, atom.Entries[1].Categories)
+
+	// Test: RSS feed of a creator
+	rec = get("/blog/creators/" + creator + "/feed.rss?limit=5")
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	require.Equal(t, "application/rss+xml; charset=utf-8", rec.Header().Get("Content-Type"))
+	require.Equal(t, creator, client.req.Creator)
+	require.Equal(t, uint64(5), client.req.Pagination.Limit)
+	var rss rssFeed
+	require.NoError(t, xml.Unmarshal(rec.Body.Bytes(), &rss))
+	require.Equal(t, "2.0", rss.Version)
+	require.Equal(t, "Blog posts by "+creator, rss.Channel.Title)
+	require.Equal(t, "Wed, 01 May 2024 14:30:00 +0000", rss.Channel.LastBuildDate)
+	require.Len(t, rss.Channel.Items, 2)
+	require.Equal(t, "http://node:1317/blog/blog/show_post/1", rss.Channel.Items[1].GUID.Value)
+	require.Equal(t, "Wed, 01 May 2024 13:30:00 +0000", rss.Channel.Items[1].PubDate)
+
+	// Test: Feeds of a tag
+	rec = get("/blog/tags/cosmos/feed.rss")
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	require.Equal(t, "cosmos", client.req.Tag)
+	require.Empty(t, client.req.Creator)
+	require.NoError(t, xml.Unmarshal(rec.Body.Bytes(), &rss))
+	require.Equal(t, "Blog posts tagged cosmos", rss.Channel.Title)
+	require.Equal(t, []string{"cosmos", "go"}, rss.Channel.Items[0].Categories)
+	rec = get("/blog/tags/cosmos/feed.atom")
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	require.Equal(t, "cosmos", client.req.Tag)
+
+	// Test: Invalid parameters
+	require.Equal(t, http.StatusBadRequest, get("/blog/creators/nope/feed.atom").Code)
+	require.Equal(t, http.StatusBadRequest, get("/blog/tags/Not_A_Tag/feed.atom").Code)
+	require.Equal(t, http.StatusBadRequest, get("/blog/feed.rss?limit=0").Code)
+	require.Equal(t, http.StatusBadRequest, get("/blog/feed.rss?limit=1000").Code)
+}
+
+func TestEmptyFeed(t *testing.T) {
+	feed := newAtomFeed("Blog posts", "http://node", "http://node/blog/feed.atom", nil)
+	require.Equal(t, "1970-01-01T00:00:00Z", feed.Updated)
+	require.Empty(t, feed.Entries)
+}
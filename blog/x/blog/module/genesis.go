@@ -9,6 +9,66 @@ import (
 
 // InitGenesis initializes the module's state from a provided genesis state.
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	// Set all the posts, building their indexes
+	for _, elem := range genState.PostList {
+		k.SetPost(ctx, elem)
+	}
+
+	// Set post count
+	k.SetPostCount(ctx, genState.PostCount)
+
+	// Set the edit counters, which also rebuilds those of the creators
+	for _, elem := range genState.PostEditCounts {
+		k.SetPostEditCount(ctx, elem.PostId, elem.Edits)
+	}
+	k.SetTotalEdits(ctx, genState.TotalEdits)
+
+	// Set the previous slugs of the posts
+	for _, elem := range genState.SlugAliases {
+		k.SetSlugAlias(ctx, elem.Slug, elem.PostId)
+	}
+
+	// Set the profiles, building the display name index
+	for _, elem := range genState.Profiles {
+		k.SetProfile(ctx, elem)
+	}
+
+	// Set the follow graph, building the follower index and counts
+	for _, elem := range genState.Follows {
+		k.SetFollow(ctx, elem.Follower, elem.Followee)
+	}
+
+	// Set the series, building the index of the series of each post
+	for _, elem := range genState.SeriesList {
+		k.SetSeries(ctx, elem)
+	}
+	k.SetSeriesCount(ctx, genState.SeriesCount)
+
+	// Set the pending change proposals, building their expiry and post indexes
+	for _, elem := range genState.PostChangeProposals {
+		k.SetPostChangeProposal(ctx, elem)
+	}
+	k.SetPostChangeProposalCount(ctx, genState.PostChangeProposalCount)
+
+	// Set the trash, building its deletion time and creator indexes
+	for _, elem := range genState.DeletedPosts {
+		k.SetDeletedPost(ctx, elem)
+	}
+
+	// Set the post creations of the quota window, building the usage counters
+	for _, elem := range genState.PostQuotaEntries {
+		k.SetPostQuotaEntry(ctx, elem)
+	}
+
+	// Set the change logs of the posts, keeping their order
+	for _, elem := range genState.PostLog {
+		k.AddPostLogEntry(ctx, elem)
+	}
+
+	// Set the entitlements, building the index of purchasers
+	for _, elem := range genState.Entitlements {
+		k.SetEntitlement(ctx, elem)
+	}
 	// this line is used by starport scaffolding # genesis/module/init
 	if err := k.SetParams(ctx, genState.Params); err != nil {
 		panic(err)
@@ -20,6 +80,22 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.Params = k.GetParams(ctx)
 
+	genesis.PostList = k.GetAllPost(ctx)
+	genesis.PostCount = k.GetPostCount(ctx)
+	genesis.PostEditCounts = k.GetAllPostEditCount(ctx)
+	genesis.TotalEdits = k.GetTotalEdits(ctx)
+	genesis.SlugAliases = k.GetAllSlugAlias(ctx)
+	genesis.Profiles = k.GetAllProfile(ctx)
+	genesis.Follows = k.GetAllFollow(ctx)
+	genesis.SeriesList = k.GetAllSeries(ctx)
+	genesis.SeriesCount = k.GetSeriesCount(ctx)
+	genesis.PostChangeProposals = k.GetAllPostChangeProposal(ctx)
+	genesis.PostChangeProposalCount = k.GetPostChangeProposalCount(ctx)
+	genesis.DeletedPosts = k.GetAllDeletedPost(ctx)
+	genesis.PostQuotaEntries = k.GetAllPostQuotaEntry(ctx)
+	genesis.PostLog = k.GetAllPostLog(ctx)
+	genesis.Entitlements = k.GetAllEntitlement(ctx)
+
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
@@ -2,19 +2,86 @@ package blog_test
 
 import (
 	"testing"
+	"time"
 
 	keepertest "blog/testutil/keeper"
 	"blog/testutil/nullify"
+	"blog/testutil/sample"
 	blog "blog/x/blog/module"
 	"blog/x/blog/types"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
 func TestGenesis(t *testing.T) {
+	creator, reader := sample.AccAddress(), sample.AccAddress()
+	createdAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	refundAt := createdAt.Add(time.Hour)
+	expiresAt := createdAt.Add(time.Hour)
 	genesisState := types.GenesisState{
 		Params: types.DefaultParams(),
 
+		PostList: []types.Post{
+			{
+				Id:            1,
+				Creator:       creator,
+				Title:         "Hello",
+				Body:          "hello cosmos",
+				Slug:          "hello",
+				Editors:       []string{creator},
+				CreatedAt:     createdAt,
+				LastUpdatedAt: createdAt,
+				Version:       3,
+			},
+			{
+				Id:                3,
+				Creator:           creator,
+				Title:             "World",
+				Body:              "world",
+				Slug:              "world",
+				Editors:           []string{creator},
+				CreatedAt:         createdAt,
+				LastUpdatedAt:     createdAt,
+				Version:           1,
+				CoAuthors:         []string{creator, reader},
+				ApprovalThreshold: 2,
+			},
+		},
+		PostCount:      4,
+		PostEditCounts: []types.PostEditCount{{PostId: 1, Edits: 2}, {PostId: 3, Edits: 1}},
+		TotalEdits:     5,
+		SlugAliases:    []types.SlugAlias{{Slug: "hi", PostId: 1}},
+		Profiles:       []types.Profile{{Address: creator, DisplayName: "Jane", Bio: "writes"}},
+		Follows:        []types.Follow{{Follower: reader, Followee: creator}},
+		SeriesList:     []types.Series{{Id: 2, Owner: creator, Title: "Hello world", PostIds: []uint64{3, 1}}},
+		SeriesCount:    2,
+		PostChangeProposals: []types.PostChangeProposal{{
+			Id: 4, PostId: 3, Proposer: creator, Title: "Worlds", Body: "worlds", Approvals: []string{creator},
+			CreatedAt: createdAt, ExpiresAt: &expiresAt, BaseVersion: 1,
+		}},
+		PostChangeProposalCount: 4,
+		DeletedPosts: []types.DeletedPost{{
+			Post: types.Post{
+				Id: 4, Creator: creator, Title: "Gone", Body: "gone", Editors: []string{creator},
+				CreatedAt: createdAt, LastUpdatedAt: createdAt, Version: 2,
+			},
+			DeletedBy: creator,
+			DeletedAt: createdAt,
+		}},
+		PostQuotaEntries: []types.PostQuotaEntry{{Height: 7, Creator: creator, Count: 2}, {Height: 9, Creator: creator, Count: 1}},
+		PostLog: []types.PostLogEntry{
+			{PostId: 1, Height: 2, Time: createdAt, Action: types.PostLogAction_POST_LOG_ACTION_CREATE, Actor: creator, Version: 1},
+			{PostId: 1, Height: 4, Time: createdAt, Action: types.PostLogAction_POST_LOG_ACTION_UPDATE, Actor: creator, Version: 3},
+			{PostId: 2, Height: 3, Time: createdAt, Action: types.PostLogAction_POST_LOG_ACTION_CREATE, Actor: creator, Version: 1},
+			{PostId: 2, Height: 8, Time: createdAt, Action: types.PostLogAction_POST_LOG_ACTION_PURGE, Version: 1},
+		},
+		Entitlements: []types.Entitlement{{
+			Address: reader, PostId: 1, Price: sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), Height: 7, PurchasedAt: createdAt,
+			Escrowed: true, RefundAt: &refundAt,
+		}, {
+			Address: reader, PostId: 3, Price: sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), Height: 6, PurchasedAt: createdAt,
+		}},
 		// this line is used by starport scaffolding # genesis/test/state
 	}
 
@@ -26,5 +93,60 @@ func TestGenesis(t *testing.T) {
 	nullify.Fill(&genesisState)
 	nullify.Fill(got)
 
+	require.ElementsMatch(t, genesisState.PostList, got.PostList)
+	require.Equal(t, genesisState.PostCount, got.PostCount)
+	require.Equal(t, genesisState.PostEditCounts, got.PostEditCounts)
+	require.Equal(t, genesisState.TotalEdits, got.TotalEdits)
+	require.Equal(t, genesisState.SlugAliases, got.SlugAliases)
+	require.Equal(t, genesisState.Profiles, got.Profiles)
+	require.Equal(t, genesisState.Follows, got.Follows)
+	require.Equal(t, genesisState.SeriesList, got.SeriesList)
+	require.Equal(t, genesisState.SeriesCount, got.SeriesCount)
+	require.Equal(t, genesisState.PostChangeProposals, got.PostChangeProposals)
+	require.Equal(t, genesisState.PostChangeProposalCount, got.PostChangeProposalCount)
+	require.Equal(t, genesisState.DeletedPosts, got.DeletedPosts)
+	require.Equal(t, genesisState.PostQuotaEntries, got.PostQuotaEntries)
+	require.Equal(t, genesisState.PostLog, got.PostLog)
+	require.Equal(t, genesisState.Entitlements, got.Entitlements)
 	// this line is used by starport scaffolding # genesis/test/assert
+
+	// the indexes are rebuilt from the posts
+	id, found := k.GetPostIDBySlug(ctx, "world")
+	require.True(t, found)
+	require.Equal(t, uint64(3), id)
+	id, found = k.GetPostIDBySlug(ctx, "hi")
+	require.True(t, found)
+	require.Equal(t, uint64(1), id)
+	owner, found := k.GetProfileAddressByName(ctx, "jane")
+	require.True(t, found)
+	require.Equal(t, creator, owner)
+	require.Equal(t, uint64(1), k.GetFollowingCount(ctx, reader))
+	followers, err := k.Followers(ctx, &types.QueryFollowersRequest{Address: creator})
+	require.NoError(t, err)
+	require.Equal(t, []string{reader}, followers.Followers)
+	escrowed, err := k.PostPurchasers(ctx, &types.QueryPostPurchasersRequest{Id: 1, Escrowed: true})
+	require.NoError(t, err)
+	require.Equal(t, []string{reader}, escrowed.Purchasers)
+	require.Equal(t, []uint64{2}, k.GetPostSeriesIDs(ctx, 1))
+	trash, err := k.DeletedPosts(ctx, &types.QueryDeletedPostsRequest{Creator: creator})
+	require.NoError(t, err)
+	require.Len(t, trash.Posts, 1)
+	require.Equal(t, uint64(3), k.GetPostQuotaUsage(ctx, creator))
+	timeline, err := k.PostTimeline(ctx, &types.QueryPostTimelineRequest{Id: 2})
+	require.NoError(t, err)
+	require.Len(t, timeline.Entries, 2)
+	purchasers, err := k.PostPurchasers(ctx, &types.QueryPostPurchasersRequest{Id: 3})
+	require.NoError(t, err)
+	require.Equal(t, []string{reader}, purchasers.Purchasers)
+	require.Equal(t, uint64(2), k.GetCreatorPostCount(ctx, creator))
+	require.Equal(t, uint64(3), k.GetCreatorEditCount(ctx, creator))
+	res, err := k.SearchPosts(ctx, &types.QuerySearchPostsRequest{Query: "cosmos"})
+	require.NoError(t, err)
+	require.Len(t, res.Posts, 1)
+	require.Equal(t, uint64(1), res.Posts[0].Id)
+
+	// the proposals keep their post and expiry indexes
+	require.Len(t, k.GetPostChangeProposals(ctx, 3), 1)
+	k.ExpirePostChangeProposals(ctx.WithBlockTime(expiresAt))
+	require.Empty(t, k.GetAllPostChangeProposal(ctx))
 }
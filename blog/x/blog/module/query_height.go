@@ -0,0 +1,43 @@
+package blog
+
+import (
+	"context"
+	"strconv"
+
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"blog/x/blog/types"
+	v1 "blog/x/blog/types/v1"
+)
+
+// heightQueryClient is the QueryClient of the gRPC gateway. It sends the
+// PostAtHeight queries with the block height header of the requested height so
+// that the node reads the store versioned at that height.
+type heightQueryClient struct {
+	types.QueryClient
+}
+
+func (c heightQueryClient) PostAtHeight(ctx context.Context, req *types.QueryPostAtHeightRequest, opts ...grpc.CallOption) (*types.QueryPostAtHeightResponse, error) {
+	return c.QueryClient.PostAtHeight(WithQueryHeight(ctx, req.Height), req, opts...)
+}
+
+// v1HeightQueryClient is the v1 QueryClient of the gRPC gateway, setting the
+// block height header like heightQueryClient.
+type v1HeightQueryClient struct {
+	v1.QueryClient
+}
+
+func (c v1HeightQueryClient) PostAtHeight(ctx context.Context, req *v1.QueryPostAtHeightRequest, opts ...grpc.CallOption) (*v1.QueryPostAtHeightResponse, error) {
+	return c.QueryClient.PostAtHeight(WithQueryHeight(ctx, req.Height), req, opts...)
+}
+
+// WithQueryHeight returns a context sending gRPC queries with the block height
+// header set to height, replacing any height already set
+func WithQueryHeight(ctx context.Context, height int64) context.Context {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	md = md.Copy()
+	md.Set(grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(height, 10))
+	return metadata.NewOutgoingContext(ctx, md)
+}
@@ -32,6 +32,9 @@ const (
 	opWeightMsgDeletePost          = "op_weight_msg_delete_post"
 	defaultWeightMsgDeletePost int = 200
 
+	opWeightMsgCreateLargePost          = "op_weight_msg_create_large_post"
+	defaultWeightMsgCreateLargePost int = 10
+
 	// this line is used by starport scaffolding # simapp/module/const
 )
 
@@ -88,6 +91,17 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 		blogsimulation.SimulateMsgDeletePost(simState.TxConfig, am.accountKeeper, am.bankKeeper, am.keeper),
 	))
 
+	var weightMsgCreateLargePost int
+	simState.AppParams.GetOrGenerate(opWeightMsgCreateLargePost, &weightMsgCreateLargePost, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateLargePost = defaultWeightMsgCreateLargePost
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgCreateLargePost,
+		blogsimulation.SimulateMsgCreateLargePost(simState.TxConfig, am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
 	// this line is used by starport scaffolding # simapp/module/operation
 
 	return operations
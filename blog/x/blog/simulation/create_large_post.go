@@ -0,0 +1,69 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"blog/x/blog/keeper"
+	"blog/x/blog/types"
+)
+
+// Bounds of the body size of the posts created by SimulateMsgCreateLargePost.
+const (
+	minLargePostBytes = 1 << 10
+	maxLargePostBytes = 64 << 10
+)
+
+// SimulateMsgCreateLargePost creates posts with bodies between 1KB and 64KB
+// and records the body size and gas used in the operation comment, which shows
+// how the per-byte gas params scale with post size.
+func SimulateMsgCreateLargePost(
+	txGen client.TxConfig,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgCreatePost{})
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		account := ak.GetAccount(ctx, simAccount.Address)
+
+		fees, err := simtypes.RandomFees(r, ctx, bk.SpendableCoins(ctx, account.GetAddress()))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to generate fees"), nil, err
+		}
+
+		size := minLargePostBytes + r.Intn(maxLargePostBytes-minLargePostBytes)
+		msg := types.NewMsgCreatePost(simAccount.Address.String(), fmt.Sprintf("large-%d", r.Intn(1000)), simtypes.RandStringOfLength(r, size))
+
+		tx, err := simtestutil.GenSignedMockTx(
+			r,
+			txGen,
+			[]sdk.Msg{msg},
+			fees,
+			simtestutil.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			simAccount.PrivKey,
+		)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to generate mock tx"), nil, err
+		}
+
+		gasInfo, _, err := app.SimDeliver(txGen.TxEncoder(), tx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to deliver tx"), nil, err
+		}
+
+		return simtypes.NewOperationMsg(msg, true, fmt.Sprintf("body_bytes=%d gas_used=%d", size, gasInfo.GasUsed)), nil, nil
+	}
+}
@@ -0,0 +1,224 @@
+package streaming
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"sync"
+
+	storetypes "cosmossdk.io/store/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"blog/x/blog/types"
+)
+
+// DefaultRetainedBlocks is the number of blocks changing the blog store whose
+// changes are kept for subscribers resuming from a past height
+const DefaultRetainedBlocks = 1000
+
+var (
+	_ storetypes.ABCIListener = (*Listener)(nil)
+	_ types.StreamServer      = (*Listener)(nil)
+)
+
+// Listener is an ABCIListener decoding the writes to the blog store of each
+// committed block, which it serves to SubscribePostChanges clients. The
+// changes of the last retained blocks are kept in memory so that clients can
+// resume from a past height.
+type Listener struct {
+	cdc      codec.BinaryCodec
+	retained int
+
+	mu sync.Mutex
+	// blocks holds the changes of the retained blocks in height order
+	blocks []*types.SubscribePostChangesResponse
+	// oldest is the lowest height from which every block is retained and
+	// latest the height of the last committed block, both zero until the
+	// first commit
+	oldest int64
+	latest int64
+	// notify is closed and replaced whenever a block is committed
+	notify chan struct{}
+}
+
+// NewListener returns a listener retaining the changes of the last retained
+// blocks that changed the blog store
+func NewListener(cdc codec.BinaryCodec, retained int) *Listener {
+	return &Listener{
+		cdc:      cdc,
+		retained: retained,
+		notify:   make(chan struct{}),
+	}
+}
+
+// ListenFinalizeBlock implements ABCIListener. Changes are only published
+// once committed.
+func (l *Listener) ListenFinalizeBlock(context.Context, abci.RequestFinalizeBlock, abci.ResponseFinalizeBlock) error {
+	return nil
+}
+
+// ListenCommit implements ABCIListener, publishing the blog store changes of
+// the committed block
+func (l *Listener) ListenCommit(ctx context.Context, _ abci.ResponseCommit, changeSet []*storetypes.StoreKVPair) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	block := &types.SubscribePostChangesResponse{
+		Height:    sdkCtx.BlockHeight(),
+		BlockTime: sdkCtx.BlockTime(),
+	}
+	for _, pair := range changeSet {
+		if pair.StoreKey != types.StoreKey {
+			continue
+		}
+		change, ok, err := DecodeStoreChange(l.cdc, pair)
+		if err != nil {
+			return err
+		}
+		if ok {
+			block.Changes = append(block.Changes, change)
+		}
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.oldest == 0 {
+		l.oldest = block.Height
+	}
+	l.latest = block.Height
+	if len(block.Changes) > 0 {
+		l.blocks = append(l.blocks, block)
+		if len(l.blocks) > l.retained {
+			l.oldest = l.blocks[0].Height + 1
+			l.blocks = l.blocks[1:]
+		}
+	}
+	close(l.notify)
+	l.notify = make(chan struct{})
+	return nil
+}
+
+// SubscribePostChanges implements the Stream service
+func (l *Listener) SubscribePostChanges(req *types.SubscribePostChangesRequest, stream types.Stream_SubscribePostChangesServer) error {
+	if req == nil || req.FromHeight < 0 {
+		return status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	next := req.FromHeight
+	if next == 0 {
+		next = l.nextHeight()
+	}
+	for {
+		blocks, from, notify, err := l.blocksFrom(next)
+		if err != nil {
+			return err
+		}
+		next = from
+		for _, block := range blocks {
+			if err := stream.Send(block); err != nil {
+				return err
+			}
+			next = block.Height + 1
+		}
+
+		select {
+		case <-notify:
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		}
+	}
+}
+
+// nextHeight returns the height of the next block to commit, or -1 before
+// the first commit
+func (l *Listener) nextHeight() int64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.latest == 0 {
+		return -1
+	}
+	return l.latest + 1
+}
+
+// blocksFrom returns the retained blocks at or after height, along with the
+// channel notifying the next commit. A height of -1 stands for the first
+// block committed by the node and is resolved in the returned height.
+func (l *Listener) blocksFrom(height int64) ([]*types.SubscribePostChangesResponse, int64, <-chan struct{}, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.oldest == 0 {
+		return nil, height, l.notify, nil
+	}
+	if height == -1 {
+		height = l.oldest
+	}
+	if height < l.oldest {
+		return nil, 0, nil, status.Errorf(codes.OutOfRange, "changes are only retained from height %d", l.oldest)
+	}
+	i := len(l.blocks)
+	for i > 0 && l.blocks[i-1].Height >= height {
+		i--
+	}
+	return l.blocks[i:], height, l.notify, nil
+}
+
+// counterPrefixes maps the prefixes of the counters reported as changes to
+// their names. Counters keyed by creator or post ID have the key suffix.
+var counterPrefixes = []struct {
+	prefix []byte
+	name   string
+}{
+	{types.KeyPrefix(types.PostCountKey), "post_count"},
+	{types.KeyPrefix(types.StatsTotalPostsKey), "total_posts"},
+	{types.KeyPrefix(types.StatsTotalCreatorsKey), "total_creators"},
+	{types.KeyPrefix(types.StatsTotalEditsKey), "total_edits"},
+	{types.KeyPrefix(types.StatsCreatorPostsKey), "creator_posts"},
+	{types.KeyPrefix(types.StatsCreatorEditsKey), "creator_edits"},
+	{types.KeyPrefix(types.StatsPostEditsKey), "post_edits"},
+}
+
+// DecodeStoreChange decodes a write to the blog store. Writes to the indexes
+// and to the other records of the module are not reported, in which case ok
+// is false.
+func DecodeStoreChange(cdc codec.BinaryCodec, pair *storetypes.StoreKVPair) (change types.StoreChange, ok bool, err error) {
+	if id, found := bytes.CutPrefix(pair.Key, types.KeyPrefix(types.PostKey)); found && len(id) == 8 {
+		change.PostId = binary.BigEndian.Uint64(id)
+		if pair.Delete {
+			change.Kind = types.StoreChangeKind_STORE_CHANGE_KIND_POST_DELETE
+			return change, true, nil
+		}
+		var post types.Post
+		if err := cdc.Unmarshal(pair.Value, &post); err != nil {
+			return change, false, err
+		}
+		change.Kind = types.StoreChangeKind_STORE_CHANGE_KIND_POST_SET
+		change.Post = &post
+		return change, true, nil
+	}
+
+	for _, counter := range counterPrefixes {
+		suffix, found := bytes.CutPrefix(pair.Key, counter.prefix)
+		if !found {
+			continue
+		}
+		change.Kind = types.StoreChangeKind_STORE_CHANGE_KIND_COUNTER_SET
+		change.Counter = counter.name
+		switch counter.name {
+		case "creator_posts", "creator_edits":
+			change.Creator = string(suffix)
+		case "post_edits":
+			if len(suffix) != 8 {
+				return change, false, nil
+			}
+			change.PostId = binary.BigEndian.Uint64(suffix)
+		}
+		if !pair.Delete && len(pair.Value) == 8 {
+			change.Value = binary.BigEndian.Uint64(pair.Value)
+		}
+		return change, true, nil
+	}
+	return change, false, nil
+}
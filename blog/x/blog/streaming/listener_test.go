@@ -0,0 +1,183 @@
+package streaming_test
+
+import (
+	"context"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"blog/testutil/sample"
+	"blog/x/blog/keeper"
+	"blog/x/blog/streaming"
+	"blog/x/blog/types"
+)
+
+// subscription is a SubscribePostChanges stream collecting the sent blocks
+type subscription struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan *types.SubscribePostChangesResponse
+}
+
+func (s *subscription) Context() context.Context { return s.ctx }
+
+func (s *subscription) Send(res *types.SubscribePostChangesResponse) error {
+	s.sent <- res
+	return nil
+}
+
+func subscribe(t *testing.T, l *streaming.Listener, fromHeight int64) (*subscription, chan error) {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	sub := &subscription{ctx: ctx, sent: make(chan *types.SubscribePostChangesResponse, 16)}
+	done := make(chan error, 1)
+	go func() {
+		done <- l.SubscribePostChanges(&types.SubscribePostChangesRequest{FromHeight: fromHeight}, sub)
+	}()
+	// let the subscription resolve its starting height before blocks are committed
+	time.Sleep(50 * time.Millisecond)
+	return sub, done
+}
+
+func (s *subscription) next(t *testing.T) *types.SubscribePostChangesResponse {
+	t.Helper()
+	select {
+	case res := <-s.sent:
+		return res
+	case <-time.After(5 * time.Second):
+		t.Fatal("no block received")
+		return nil
+	}
+}
+
+func commit(t *testing.T, l *streaming.Listener, height int64, changeSet ...*storetypes.StoreKVPair) {
+	t.Helper()
+	sdkCtx := sdk.Context{}.WithBlockHeight(height).WithBlockTime(time.Unix(height, 0).UTC())
+	ctx := context.WithValue(context.Background(), sdk.SdkContextKey, sdkCtx)
+	require.NoError(t, l.ListenCommit(ctx, abci.ResponseCommit{}, changeSet))
+}
+
+func uint64Bytes(v uint64) []byte {
+	return binary.BigEndian.AppendUint64(nil, v)
+}
+
+func postKey(id uint64) []byte {
+	return append(types.KeyPrefix(types.PostKey), keeper.GetPostIDBytes(id)...)
+}
+
+func TestDecodeStoreChange(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	creator := sample.AccAddress()
+	post := types.Post{Id: 3, Creator: creator, Title: "title", Body: "body", Version: 2}
+
+	tests := []struct {
+		name string
+		pair *storetypes.StoreKVPair
+		want *types.StoreChange
+	}{
+		{
+			name: "post set",
+			pair: &storetypes.StoreKVPair{Key: postKey(3), Value: cdc.MustMarshal(&post)},
+			want: &types.StoreChange{Kind: types.StoreChangeKind_STORE_CHANGE_KIND_POST_SET, PostId: 3, Post: &post},
+		}, {
+			name: "post delete",
+			pair: &storetypes.StoreKVPair{Key: postKey(3), Delete: true},
+			want: &types.StoreChange{Kind: types.StoreChangeKind_STORE_CHANGE_KIND_POST_DELETE, PostId: 3},
+		}, {
+			name: "post count",
+			pair: &storetypes.StoreKVPair{Key: types.KeyPrefix(types.PostCountKey), Value: uint64Bytes(3)},
+			want: &types.StoreChange{Kind: types.StoreChangeKind_STORE_CHANGE_KIND_COUNTER_SET, Counter: "post_count", Value: 3},
+		}, {
+			name: "creator counter",
+			pair: &storetypes.StoreKVPair{Key: append(types.KeyPrefix(types.StatsCreatorPostsKey), creator...), Value: uint64Bytes(2)},
+			want: &types.StoreChange{Kind: types.StoreChangeKind_STORE_CHANGE_KIND_COUNTER_SET, Counter: "creator_posts", Creator: creator, Value: 2},
+		}, {
+			name: "post counter dropping to zero",
+			pair: &storetypes.StoreKVPair{Key: append(types.KeyPrefix(types.StatsPostEditsKey), keeper.GetPostIDBytes(3)...), Delete: true},
+			want: &types.StoreChange{Kind: types.StoreChangeKind_STORE_CHANGE_KIND_COUNTER_SET, Counter: "post_edits", PostId: 3},
+		}, {
+			name: "index",
+			pair: &storetypes.StoreKVPair{Key: append(types.KeyPrefix(types.PostSlugKey), "slug"...), Value: uint64Bytes(3)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok, err := streaming.DecodeStoreChange(cdc, tt.pair)
+			require.NoError(t, err)
+			if tt.want == nil {
+				require.False(t, ok)
+				return
+			}
+			require.True(t, ok)
+			require.Equal(t, *tt.want, got)
+		})
+	}
+}
+
+func TestSubscribePostChanges(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	l := streaming.NewListener(cdc, 2)
+	post := types.Post{Id: 1, Creator: sample.AccAddress(), Title: "title", Version: 1}
+	postSet := &storetypes.StoreKVPair{StoreKey: types.StoreKey, Key: postKey(1), Value: cdc.MustMarshal(&post)}
+	postDelete := &storetypes.StoreKVPair{StoreKey: types.StoreKey, Key: postKey(1), Delete: true}
+	otherStore := &storetypes.StoreKVPair{StoreKey: "bank", Key: postKey(1), Delete: true}
+
+	// Test: Subscribing before the first commit from zero starts at the first commit
+	live, _ := subscribe(t, l, 0)
+	commit(t, l, 10, postSet, otherStore)
+	res := live.next(t)
+	require.Equal(t, int64(10), res.Height)
+	require.Equal(t, time.Unix(10, 0).UTC(), res.BlockTime)
+	require.Len(t, res.Changes, 1)
+	require.Equal(t, types.StoreChangeKind_STORE_CHANGE_KIND_POST_SET, res.Changes[0].Kind)
+
+	// Test: Blocks without blog changes are not sent
+	commit(t, l, 11, otherStore)
+	commit(t, l, 12, postDelete)
+	require.Equal(t, int64(12), live.next(t).Height)
+
+	// Test: Resuming replays the retained blocks, then follows new ones
+	resumed, _ := subscribe(t, l, 11)
+	require.Equal(t, int64(12), resumed.next(t).Height)
+	commit(t, l, 13, postSet)
+	require.Equal(t, int64(13), resumed.next(t).Height)
+	require.Equal(t, int64(13), live.next(t).Height)
+
+	// Test: Subscribing from zero skips the committed blocks
+	latest, _ := subscribe(t, l, 0)
+	commit(t, l, 14, postDelete)
+	require.Equal(t, int64(14), latest.next(t).Height)
+
+	// Test: Heights older than the retained blocks are rejected
+	_, done := subscribe(t, l, 12)
+	select {
+	case err := <-done:
+		require.Equal(t, codes.OutOfRange, status.Code(err))
+	case <-time.After(5 * time.Second):
+		t.Fatal("subscription not rejected")
+	}
+	_, done = subscribe(t, l, 13)
+	select {
+	case err := <-done:
+		t.Fatalf("subscription ended: %v", err)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// Test: Cancelled subscriptions end
+	ctx, cancel := context.WithCancel(context.Background())
+	sub := &subscription{ctx: ctx, sent: make(chan *types.SubscribePostChangesResponse, 16)}
+	cancel()
+	require.ErrorIs(t, l.SubscribePostChanges(&types.SubscribePostChangesRequest{FromHeight: 14}, sub), context.Canceled)
+	require.Equal(t, int64(14), sub.next(t).Height)
+}
@@ -0,0 +1,87 @@
+package types
+
+import (
+	"html"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/russross/blackfriday/v2"
+)
+
+var (
+	// bodyPolicy sanitizes rendered bodies. It is safe for concurrent use.
+	bodyPolicy = bluemonday.UGCPolicy()
+
+	paragraphSeparatorRegexp = regexp.MustCompile(`\n[ \t]*\n`)
+)
+
+// encryptedBodyNotice is the rendering of encrypted bodies
+const encryptedBodyNotice = "<p><em>This post is encrypted.</em></p>"
+
+// ValidateBody checks that body is well-formed for format. Plain bodies are
+// not checked. Markdown bodies must be UTF-8 without control characters other
+// than tabs and line breaks, and sanitized HTML bodies must moreover be left
+// unchanged by the sanitizer. Encrypted bodies must be base64 ciphertext.
+func ValidateBody(format BodyFormat, body string) error {
+	switch format {
+	case BodyFormat_BODY_FORMAT_PLAIN:
+		return nil
+	case BodyFormat_BODY_FORMAT_ENCRYPTED:
+		return validateEncryptedBody(body)
+	case BodyFormat_BODY_FORMAT_MARKDOWN, BodyFormat_BODY_FORMAT_HTML_SANITIZED:
+	default:
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "unknown body format %d", format)
+	}
+
+	if !utf8.ValidString(body) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "body is not valid UTF-8")
+	}
+	for i, r := range body {
+		if unicode.IsControl(r) && r != '\t' && r != '\n' && r != '\r' {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "body contains control character %U at byte %d", r, i)
+		}
+	}
+
+	if format == BodyFormat_BODY_FORMAT_HTML_SANITIZED && bodyPolicy.Sanitize(body) != body {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "body is not sanitized HTML")
+	}
+	return nil
+}
+
+// RenderBody renders body as sanitized HTML. The output only depends on the
+// format and the body. Encrypted bodies render to a notice.
+func RenderBody(format BodyFormat, body string) string {
+	switch format {
+	case BodyFormat_BODY_FORMAT_ENCRYPTED:
+		return encryptedBodyNotice
+	case BodyFormat_BODY_FORMAT_MARKDOWN:
+		rendered := blackfriday.Run([]byte(body), blackfriday.WithExtensions(blackfriday.CommonExtensions))
+		return string(bodyPolicy.SanitizeBytes(rendered))
+	case BodyFormat_BODY_FORMAT_HTML_SANITIZED:
+		return bodyPolicy.Sanitize(body)
+	default:
+		return renderPlainBody(body)
+	}
+}
+
+// renderPlainBody escapes body into paragraphs separated by blank lines, with
+// the remaining line breaks kept
+func renderPlainBody(body string) string {
+	body = strings.ReplaceAll(body, "\r\n", "\n")
+	var b strings.Builder
+	for _, paragraph := range paragraphSeparatorRegexp.Split(body, -1) {
+		paragraph = strings.Trim(paragraph, "\n")
+		if strings.TrimSpace(paragraph) == "" {
+			continue
+		}
+		b.WriteString("<p>")
+		b.WriteString(strings.ReplaceAll(html.EscapeString(paragraph), "\n", "<br>\n"))
+		b.WriteString("</p>\n")
+	}
+	return b.String()
+}
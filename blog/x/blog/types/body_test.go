@@ -0,0 +1,103 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateBody(t *testing.T) {
+	tests := []struct {
+		name   string
+		format BodyFormat
+		body   string
+		err    error
+	}{
+		{
+			name:   "plain is not checked",
+			format: BodyFormat_BODY_FORMAT_PLAIN,
+			body:   "\x00\xff<script>",
+		}, {
+			name:   "markdown",
+			format: BodyFormat_BODY_FORMAT_MARKDOWN,
+			body:   "# Title\r\n\n\tcode <b>bold</b>",
+		}, {
+			name:   "markdown with invalid UTF-8",
+			format: BodyFormat_BODY_FORMAT_MARKDOWN,
+			body:   "caf\xe9",
+			err:    sdkerrors.ErrInvalidRequest,
+		}, {
+			name:   "markdown with control character",
+			format: BodyFormat_BODY_FORMAT_MARKDOWN,
+			body:   "bell\a",
+			err:    sdkerrors.ErrInvalidRequest,
+		}, {
+			name:   "sanitized html",
+			format: BodyFormat_BODY_FORMAT_HTML_SANITIZED,
+			body:   `<p>a &amp; <a href="https://example.com" rel="nofollow">b</a><br/></p>`,
+		}, {
+			name:   "html with script",
+			format: BodyFormat_BODY_FORMAT_HTML_SANITIZED,
+			body:   "<p>hi</p><script>alert(1)</script>",
+			err:    sdkerrors.ErrInvalidRequest,
+		}, {
+			name:   "html with event handler",
+			format: BodyFormat_BODY_FORMAT_HTML_SANITIZED,
+			body:   `<p onclick="alert(1)">hi</p>`,
+			err:    sdkerrors.ErrInvalidRequest,
+		}, {
+			name:   "unknown format",
+			format: BodyFormat(7),
+			err:    sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateBody(tt.format, tt.body)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestRenderBody(t *testing.T) {
+	tests := []struct {
+		name   string
+		format BodyFormat
+		body   string
+		want   string
+	}{
+		{
+			name:   "plain",
+			format: BodyFormat_BODY_FORMAT_PLAIN,
+			body:   "a <b>\r\nline 2\n\n\nsecond & para\n",
+			want:   "<p>a &lt;b&gt;<br>\nline 2</p>\n<p>second &amp; para</p>\n",
+		}, {
+			name:   "markdown",
+			format: BodyFormat_BODY_FORMAT_MARKDOWN,
+			body:   "# Title\n\nSome *text* and [a link](https://example.com)",
+			want:   "<h1>Title</h1>\n\n<p>Some <em>text</em> and <a href=\"https://example.com\" rel=\"nofollow\">a link</a></p>\n",
+		}, {
+			name:   "markdown with raw html",
+			format: BodyFormat_BODY_FORMAT_MARKDOWN,
+			body:   "hi <script>alert(1)</script><img src=x onerror=alert(1)>",
+			want:   "<p>hi <img src=\"x\"></p>\n",
+		}, {
+			name:   "sanitized html",
+			format: BodyFormat_BODY_FORMAT_HTML_SANITIZED,
+			body:   "<p>hi<br/></p>",
+			want:   "<p>hi<br/></p>",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := RenderBody(tt.format, tt.body)
+			require.Equal(t, tt.want, got)
+			require.Equal(t, got, RenderBody(tt.format, tt.body))
+		})
+	}
+}
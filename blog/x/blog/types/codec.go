@@ -17,6 +17,45 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgDeletePost{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgBatchPostOps{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetProfile{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgFollow{},
+		&MsgUnfollow{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCreateSeries{},
+		&MsgAppendSeriesPost{},
+		&MsgReorderSeries{},
+		&MsgRemoveSeriesPost{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetCoAuthors{},
+		&MsgProposePostChange{},
+		&MsgApprovePostChange{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgPatchPost{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRestorePost{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCreatePrivatePost{},
+		&MsgGrantReadAccess{},
+		&MsgRevokeReadAccess{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetPostPrice{},
+		&MsgPurchaseAccess{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetPostTags{},
+	)
 	// this line is used by starport scaffolding # 3
 
 	registry.RegisterImplementations((*sdk.Msg)(nil),
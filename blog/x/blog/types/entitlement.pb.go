@@ -0,0 +1,625 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: blog/blog/entitlement.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Entitlement records the purchase of read access to a paywalled post. It
+// entitles the address to a key envelope from the creator and outlives
+// changes of the price.
+type Entitlement struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	PostId  uint64 `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	// price is what the address paid.
+	Price       github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=price,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"price"`
+	Height      int64                                    `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	PurchasedAt time.Time                                `protobuf:"bytes,5,opt,name=purchased_at,json=purchasedAt,proto3,stdtime" json:"purchased_at"`
+	// escrowed is set while the module holds the payment, until the creator
+	// grants the address a key envelope.
+	Escrowed bool `protobuf:"varint,6,opt,name=escrowed,proto3" json:"escrowed,omitempty"`
+	// refund_at is when an escrowed payment goes back to the address, dropping
+	// the entitlement, if the creator has not granted an envelope by then.
+	RefundAt *time.Time `protobuf:"bytes,7,opt,name=refund_at,json=refundAt,proto3,stdtime" json:"refund_at,omitempty"`
+}
+
+func (m *Entitlement) Reset()         { *m = Entitlement{} }
+func (m *Entitlement) String() string { return proto.CompactTextString(m) }
+func (*Entitlement) ProtoMessage()    {}
+func (*Entitlement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_94d53b665aa2b688, []int{0}
+}
+func (m *Entitlement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Entitlement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Entitlement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Entitlement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Entitlement.Merge(m, src)
+}
+func (m *Entitlement) XXX_Size() int {
+	return m.Size()
+}
+func (m *Entitlement) XXX_DiscardUnknown() {
+	xxx_messageInfo_Entitlement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Entitlement proto.InternalMessageInfo
+
+func (m *Entitlement) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Entitlement) GetPostId() uint64 {
+	if m != nil {
+		return m.PostId
+	}
+	return 0
+}
+
+func (m *Entitlement) GetPrice() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Price
+	}
+	return nil
+}
+
+func (m *Entitlement) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *Entitlement) GetPurchasedAt() time.Time {
+	if m != nil {
+		return m.PurchasedAt
+	}
+	return time.Time{}
+}
+
+func (m *Entitlement) GetEscrowed() bool {
+	if m != nil {
+		return m.Escrowed
+	}
+	return false
+}
+
+func (m *Entitlement) GetRefundAt() *time.Time {
+	if m != nil {
+		return m.RefundAt
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Entitlement)(nil), "blog.blog.Entitlement")
+}
+
+func init() { proto.RegisterFile("blog/blog/entitlement.proto", fileDescriptor_94d53b665aa2b688) }
+
+var fileDescriptor_94d53b665aa2b688 = []byte{
+	// 378 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0xcd, 0x8e, 0x9b, 0x30,
+	0x10, 0xc7, 0x71, 0x3e, 0x08, 0x31, 0xbd, 0xd4, 0xaa, 0x5a, 0x4a, 0x25, 0x40, 0x3d, 0x21, 0x55,
+	0xb5, 0x9b, 0xf4, 0xdc, 0x43, 0x52, 0x55, 0x55, 0xaf, 0xa8, 0xa7, 0xbd, 0x44, 0x7c, 0x38, 0x60,
+	0x6d, 0xc0, 0x08, 0x9b, 0xfd, 0x78, 0x8b, 0x3c, 0xc7, 0x3e, 0x49, 0x8e, 0x39, 0xee, 0x69, 0xb3,
+	0x4a, 0x5e, 0x64, 0x85, 0x4d, 0xb2, 0xc7, 0xbd, 0x0c, 0xf3, 0x9f, 0x61, 0xe6, 0x37, 0x9e, 0x81,
+	0x5f, 0x92, 0x0d, 0xcf, 0x89, 0x32, 0xb4, 0x92, 0x4c, 0x6e, 0x68, 0x49, 0x2b, 0x89, 0xeb, 0x86,
+	0x4b, 0x8e, 0xa6, 0x5d, 0x1c, 0x77, 0xc6, 0xf5, 0x73, 0xce, 0xf3, 0x0d, 0x25, 0x2a, 0x91, 0xb4,
+	0x6b, 0x22, 0x59, 0x49, 0x85, 0x8c, 0xcb, 0x5a, 0xff, 0xeb, 0x7e, 0xc8, 0x79, 0xce, 0x95, 0x4b,
+	0x3a, 0xaf, 0x8f, 0x7a, 0x29, 0x17, 0x25, 0x17, 0x24, 0x89, 0x05, 0x25, 0x37, 0xb3, 0x84, 0xca,
+	0x78, 0x46, 0x52, 0xce, 0x2a, 0x9d, 0xff, 0x7a, 0x18, 0x40, 0xfb, 0xcf, 0x2b, 0x17, 0x39, 0x70,
+	0x12, 0x67, 0x59, 0x43, 0x85, 0x70, 0x40, 0x00, 0xc2, 0x69, 0x74, 0x96, 0xe8, 0x13, 0x9c, 0xd4,
+	0x5c, 0xc8, 0x15, 0xcb, 0x9c, 0x41, 0x00, 0xc2, 0x51, 0x64, 0x76, 0xf2, 0x5f, 0x86, 0x62, 0x38,
+	0xae, 0x1b, 0x96, 0x52, 0x67, 0x18, 0x0c, 0x43, 0x7b, 0xfe, 0x19, 0x6b, 0x24, 0xee, 0x90, 0xb8,
+	0x47, 0xe2, 0xdf, 0x9c, 0x55, 0xcb, 0x1f, 0xbb, 0x27, 0xdf, 0x78, 0x38, 0xf8, 0x61, 0xce, 0x64,
+	0xd1, 0x26, 0x38, 0xe5, 0x25, 0xe9, 0xe7, 0xd3, 0x9f, 0xef, 0x22, 0xbb, 0x26, 0xf2, 0xbe, 0xa6,
+	0x42, 0x15, 0x88, 0x48, 0x77, 0x46, 0x1f, 0xa1, 0x59, 0x50, 0x96, 0x17, 0xd2, 0x19, 0x05, 0x20,
+	0x1c, 0x46, 0xbd, 0x42, 0x7f, 0xe1, 0xbb, 0xba, 0x6d, 0xd2, 0x22, 0x16, 0x34, 0x5b, 0xc5, 0xd2,
+	0x19, 0x07, 0x20, 0xb4, 0xe7, 0x2e, 0xd6, 0xbb, 0xc2, 0xe7, 0x5d, 0xe1, 0xff, 0xe7, 0x5d, 0x2d,
+	0xad, 0x6e, 0x84, 0xed, 0xc1, 0x07, 0x91, 0x7d, 0xa9, 0x5c, 0x48, 0xe4, 0x42, 0x8b, 0x8a, 0xb4,
+	0xe1, 0xb7, 0x34, 0x73, 0xcc, 0x00, 0x84, 0x56, 0x74, 0xd1, 0xe8, 0x17, 0x9c, 0x36, 0x74, 0xdd,
+	0x56, 0x8a, 0x30, 0x79, 0x93, 0x30, 0x52, 0xdd, 0x2d, 0x5d, 0xb2, 0x90, 0xcb, 0x6f, 0xbb, 0xa3,
+	0x07, 0xf6, 0x47, 0x0f, 0x3c, 0x1f, 0x3d, 0xb0, 0x3d, 0x79, 0xc6, 0xfe, 0xe4, 0x19, 0x8f, 0x27,
+	0xcf, 0xb8, 0x7a, 0xaf, 0xae, 0x7e, 0xa7, 0x8f, 0xaf, 0x5e, 0x9d, 0x98, 0xaa, 0xe1, 0xcf, 0x97,
+	0x01, 0x00, 0xc1, 0x0a, 0x38, 0x63, 0x16, 0x02, 0x00, 0x00,
+}
+
+func (m *Entitlement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Entitlement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Entitlement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RefundAt != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.RefundAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.RefundAt):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintEntitlement(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Escrowed {
+		i--
+		if m.Escrowed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PurchasedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PurchasedAt):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintEntitlement(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x2a
+	if m.Height != 0 {
+		i = encodeVarintEntitlement(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Price) > 0 {
+		for iNdEx := len(m.Price) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Price[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEntitlement(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.PostId != 0 {
+		i = encodeVarintEntitlement(dAtA, i, uint64(m.PostId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEntitlement(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEntitlement(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEntitlement(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Entitlement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEntitlement(uint64(l))
+	}
+	if m.PostId != 0 {
+		n += 1 + sovEntitlement(uint64(m.PostId))
+	}
+	if len(m.Price) > 0 {
+		for _, e := range m.Price {
+			l = e.Size()
+			n += 1 + l + sovEntitlement(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovEntitlement(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PurchasedAt)
+	n += 1 + l + sovEntitlement(uint64(l))
+	if m.Escrowed {
+		n += 2
+	}
+	if m.RefundAt != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.RefundAt)
+		n += 1 + l + sovEntitlement(uint64(l))
+	}
+	return n
+}
+
+func sovEntitlement(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEntitlement(x uint64) (n int) {
+	return sovEntitlement(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Entitlement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEntitlement
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Entitlement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Entitlement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntitlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEntitlement
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEntitlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostId", wireType)
+			}
+			m.PostId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntitlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PostId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntitlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEntitlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEntitlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = append(m.Price, types.Coin{})
+			if err := m.Price[len(m.Price)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntitlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PurchasedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntitlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEntitlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEntitlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PurchasedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrowed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntitlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Escrowed = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntitlement
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEntitlement
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEntitlement
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RefundAt == nil {
+				m.RefundAt = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.RefundAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEntitlement(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEntitlement
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEntitlement(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEntitlement
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEntitlement
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEntitlement
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEntitlement
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEntitlement
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEntitlement
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEntitlement        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEntitlement          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEntitlement = fmt.Errorf("proto: unexpected end of group")
+)
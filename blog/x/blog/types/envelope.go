@@ -0,0 +1,216 @@
+package types
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	dcrsecp256k1 "github.com/decred/dcrd/dcrec/secp256k1/v4"
+)
+
+const (
+	// ContentKeySize is the size of the AES-256 content keys of encrypted posts
+	ContentKeySize = 32
+
+	gcmNonceSize = 12
+	gcmTagSize   = 16
+
+	// SealedKeySize is the size of the sealed key of an envelope: the nonce,
+	// the content key and the tag
+	SealedKeySize = gcmNonceSize + ContentKeySize + gcmTagSize
+
+	// envelopeKDFLabel separates the key derivation of envelopes from any other
+	// use of the ECDH secret
+	envelopeKDFLabel = "blog/key-envelope/v1"
+)
+
+// NewContentKey returns a random content key
+func NewContentKey() ([]byte, error) {
+	key := make([]byte, ContentKeySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// EncryptBody encrypts body with the content key and returns the base64 body
+// of an encrypted post
+func EncryptBody(key []byte, body string) (string, error) {
+	sealed, err := seal(key, []byte(body), nil)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// DecryptBody decrypts the base64 body of an encrypted post with the content
+// key
+func DecryptBody(key []byte, body string) (string, error) {
+	sealed, err := base64.StdEncoding.DecodeString(body)
+	if err != nil {
+		return "", fmt.Errorf("invalid encrypted body: %w", err)
+	}
+	plain, err := open(key, sealed, nil)
+	if err != nil {
+		return "", fmt.Errorf("decrypting the body: %w", err)
+	}
+	return string(plain), nil
+}
+
+// SealContentKey wraps the content key for reader, whose compressed secp256k1
+// public key is readerPubKey
+func SealContentKey(reader string, readerPubKey []byte, key []byte) (KeyEnvelope, error) {
+	pub, err := dcrsecp256k1.ParsePubKey(readerPubKey)
+	if err != nil {
+		return KeyEnvelope{}, fmt.Errorf("invalid public key of %s: %w", reader, err)
+	}
+	ephemeral, err := dcrsecp256k1.GeneratePrivateKey()
+	if err != nil {
+		return KeyEnvelope{}, err
+	}
+
+	env := KeyEnvelope{
+		Reader:          reader,
+		ReaderPubKey:    readerPubKey,
+		EphemeralPubKey: ephemeral.PubKey().SerializeCompressed(),
+	}
+	kek := envelopeKey(dcrsecp256k1.GenerateSharedSecret(ephemeral, pub), env)
+	if env.SealedKey, err = seal(kek, key, []byte(reader)); err != nil {
+		return KeyEnvelope{}, err
+	}
+	return env, nil
+}
+
+// OpenContentKey unwraps the content key of an envelope with the secp256k1
+// private key of its reader
+func OpenContentKey(env KeyEnvelope, privKey []byte) ([]byte, error) {
+	pub, err := dcrsecp256k1.ParsePubKey(env.EphemeralPubKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ephemeral public key: %w", err)
+	}
+	priv := dcrsecp256k1.PrivKeyFromBytes(privKey)
+	kek := envelopeKey(dcrsecp256k1.GenerateSharedSecret(priv, pub), env)
+	key, err := open(kek, env.SealedKey, []byte(env.Reader))
+	if err != nil {
+		return nil, fmt.Errorf("opening the key envelope of %s: %w", env.Reader, err)
+	}
+	return key, nil
+}
+
+// envelopeKey derives the key sealing the content key of an envelope from the
+// ECDH secret, bound to both public keys
+func envelopeKey(secret []byte, env KeyEnvelope) []byte {
+	h := sha256.New()
+	h.Write([]byte(envelopeKDFLabel))
+	h.Write(secret)
+	h.Write(env.EphemeralPubKey)
+	h.Write(env.ReaderPubKey)
+	return h.Sum(nil)
+}
+
+// seal encrypts plain with AES-256-GCM under a random nonce, which prefixes
+// the result
+func seal(key, plain, additionalData []byte) ([]byte, error) {
+	aead, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcmNonceSize)
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, plain, additionalData), nil
+}
+
+func open(key, sealed, additionalData []byte) ([]byte, error) {
+	aead, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(sealed) < gcmNonceSize+gcmTagSize {
+		return nil, fmt.Errorf("ciphertext too short")
+	}
+	return aead.Open(nil, sealed[:gcmNonceSize], sealed[gcmNonceSize:], additionalData)
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	if len(key) != ContentKeySize {
+		return nil, fmt.Errorf("key must be %d bytes", ContentKeySize)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// validateEncryptedBody checks that body is base64 and long enough to hold
+// a nonce and a tag
+func validateEncryptedBody(body string) error {
+	sealed, err := base64.StdEncoding.DecodeString(body)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "encrypted body is not base64 (%s)", err)
+	}
+	if len(sealed) < gcmNonceSize+gcmTagSize {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "encrypted body is too short")
+	}
+	return nil
+}
+
+// Validate checks that the envelope is well-formed and that its public key is
+// the key of its reader
+func (env KeyEnvelope) Validate() error {
+	reader, err := sdk.AccAddressFromBech32(env.Reader)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid reader address (%s)", err)
+	}
+	if _, err := dcrsecp256k1.ParsePubKey(env.ReaderPubKey); err != nil || len(env.ReaderPubKey) != secp256k1.PubKeySize {
+		return errorsmod.Wrapf(ErrInvalidKeyEnvelope, "invalid public key of %s", env.Reader)
+	}
+	if !reader.Equals(sdk.AccAddress((&secp256k1.PubKey{Key: env.ReaderPubKey}).Address())) {
+		return errorsmod.Wrapf(ErrInvalidKeyEnvelope, "public key is not the key of %s", env.Reader)
+	}
+	if _, err := dcrsecp256k1.ParsePubKey(env.EphemeralPubKey); err != nil || len(env.EphemeralPubKey) != secp256k1.PubKeySize {
+		return errorsmod.Wrapf(ErrInvalidKeyEnvelope, "invalid ephemeral public key for %s", env.Reader)
+	}
+	if len(env.SealedKey) != SealedKeySize {
+		return errorsmod.Wrapf(ErrInvalidKeyEnvelope, "sealed key for %s must be %d bytes", env.Reader, SealedKeySize)
+	}
+	return nil
+}
+
+// ValidateKeyEnvelopes checks the envelopes of an encrypted post: each must be
+// valid, readers must be distinct and the creator must be one of them
+func ValidateKeyEnvelopes(envelopes []KeyEnvelope, creator string) error {
+	seen := make(map[string]bool, len(envelopes))
+	for _, env := range envelopes {
+		if err := env.Validate(); err != nil {
+			return err
+		}
+		if seen[env.Reader] {
+			return errorsmod.Wrapf(ErrInvalidKeyEnvelope, "duplicate envelope for %s", env.Reader)
+		}
+		seen[env.Reader] = true
+	}
+	if !seen[creator] {
+		return errorsmod.Wrap(ErrInvalidKeyEnvelope, "missing the envelope of the creator")
+	}
+	return nil
+}
+
+// KeyEnvelopeOf returns the envelope of reader in envelopes
+func KeyEnvelopeOf(envelopes []KeyEnvelope, reader string) (KeyEnvelope, bool) {
+	for _, env := range envelopes {
+		if env.Reader == reader {
+			return env, true
+		}
+	}
+	return KeyEnvelope{}, false
+}
@@ -0,0 +1,95 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyEnvelope(t *testing.T) {
+	reader := secp256k1.GenPrivKey()
+	readerAddr := sdk.AccAddress(reader.PubKey().Address()).String()
+	other := secp256k1.GenPrivKey()
+
+	key, err := NewContentKey()
+	require.NoError(t, err)
+	body, err := EncryptBody(key, "secret")
+	require.NoError(t, err)
+	require.NoError(t, ValidateBody(BodyFormat_BODY_FORMAT_ENCRYPTED, body))
+
+	// Test: The reader unwraps the content key and decrypts the body
+	env, err := SealContentKey(readerAddr, reader.PubKey().Bytes(), key)
+	require.NoError(t, err)
+	require.NoError(t, env.Validate())
+	opened, err := OpenContentKey(env, reader.Key)
+	require.NoError(t, err)
+	plain, err := DecryptBody(opened, body)
+	require.NoError(t, err)
+	require.Equal(t, "secret", plain)
+
+	// Test: Another key cannot open the envelope
+	_, err = OpenContentKey(env, other.Key)
+	require.Error(t, err)
+
+	// Test: An envelope moved to another reader cannot be opened
+	moved := env
+	moved.Reader = sdk.AccAddress(other.PubKey().Address()).String()
+	_, err = OpenContentKey(moved, reader.Key)
+	require.Error(t, err)
+
+	// Test: The public key must be the key of the reader
+	moved.ReaderPubKey = reader.PubKey().Bytes()
+	require.ErrorIs(t, moved.Validate(), ErrInvalidKeyEnvelope)
+
+	// Test: Truncated sealed keys are invalid
+	truncated := env
+	truncated.SealedKey = env.SealedKey[:SealedKeySize-1]
+	require.ErrorIs(t, truncated.Validate(), ErrInvalidKeyEnvelope)
+
+	// Test: Post envelopes must be distinct and include the creator
+	require.NoError(t, ValidateKeyEnvelopes([]KeyEnvelope{env}, readerAddr))
+	require.ErrorIs(t, ValidateKeyEnvelopes([]KeyEnvelope{env, env}, readerAddr), ErrInvalidKeyEnvelope)
+	require.ErrorIs(t, ValidateKeyEnvelopes([]KeyEnvelope{env}, moved.Reader), ErrInvalidKeyEnvelope)
+
+	// Test: Encrypted bodies must be base64 ciphertext
+	require.Error(t, ValidateBody(BodyFormat_BODY_FORMAT_ENCRYPTED, "not base64!"))
+	require.Error(t, ValidateBody(BodyFormat_BODY_FORMAT_ENCRYPTED, "c2hvcnQ="))
+}
+
+func TestValidateEncryptedContent(t *testing.T) {
+	key, err := NewContentKey()
+	require.NoError(t, err)
+	body, err := EncryptBody(key, "secret")
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		desc    string
+		body    string
+		bodyURI string
+		valid   bool
+	}{
+		{desc: "body", body: body, valid: true},
+		{desc: "missing body", valid: false},
+		{desc: "ipfs URI", bodyURI: "ipfs://bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi", valid: true},
+		{desc: "https URI", bodyURI: "https://example.com/post.enc", valid: true},
+		{desc: "arweave URI", bodyURI: "ar://bNbA3TEQVL60xlgCcqdz4ZPHFZ711cZ3hmkpGttDt_U", valid: true},
+		{desc: "body and URI", body: body, bodyURI: "https://example.com/post.enc", valid: true},
+		{desc: "invalid body with URI", body: "not base64!", bodyURI: "https://example.com/post.enc", valid: false},
+		{desc: "http URI", bodyURI: "http://example.com/post.enc", valid: false},
+		{desc: "relative URI", bodyURI: "/post.enc", valid: false},
+		{desc: "URI without host", bodyURI: "https:///post.enc", valid: false},
+		{desc: "long URI", bodyURI: "https://example.com/" + strings.Repeat("a", MaxBodyURILength), valid: false},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := ValidateEncryptedContent(tc.body, tc.bodyURI)
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -8,6 +8,19 @@ import (
 
 // x/blog module sentinel errors
 var (
-	ErrInvalidSigner = sdkerrors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
-	ErrSample        = sdkerrors.Register(ModuleName, 1101, "sample error")
+	ErrInvalidSigner      = sdkerrors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrSample             = sdkerrors.Register(ModuleName, 1101, "sample error")
+	ErrSlugTaken          = sdkerrors.Register(ModuleName, 1102, "slug already taken")
+	ErrDisplayNameTaken   = sdkerrors.Register(ModuleName, 1103, "display name already taken")
+	ErrAlreadyFollowing   = sdkerrors.Register(ModuleName, 1104, "already following")
+	ErrNotFollowing       = sdkerrors.Register(ModuleName, 1105, "not following")
+	ErrFollowLimit        = sdkerrors.Register(ModuleName, 1106, "follow limit reached")
+	ErrApprovalRequired   = sdkerrors.Register(ModuleName, 1107, "post changes require co-author approval")
+	ErrRevisionMismatch   = sdkerrors.Register(ModuleName, 1108, "post revision mismatch")
+	ErrVersionConflict    = sdkerrors.Register(ModuleName, 1109, "post version conflict")
+	ErrPostQuota          = sdkerrors.Register(ModuleName, 1110, "post quota exceeded")
+	ErrTooManyMsgs        = sdkerrors.Register(ModuleName, 1111, "too many blog messages in tx")
+	ErrBodyTooLarge       = sdkerrors.Register(ModuleName, 1112, "post body too large")
+	ErrInvalidKeyEnvelope = sdkerrors.Register(ModuleName, 1113, "invalid key envelope")
+	ErrPriceMismatch      = sdkerrors.Register(ModuleName, 1114, "price mismatch")
 )
@@ -1,5 +1,14 @@
 package types
 
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // this line is used by starport scaffolding # genesis/types/import
 
 // DefaultIndex is the default global index
@@ -8,15 +17,264 @@ const DefaultIndex uint64 = 1
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
+		PostList: []Post{},
 		// this line is used by starport scaffolding # genesis/types/default
 		Params: DefaultParams(),
 	}
 }
 
 // Validate performs basic genesis state validation returning an error upon any
-// failure.
+// failure. Every problem found is reported, joined in one error.
 func (gs GenesisState) Validate() error {
+	var errs []error
+
+	postIDs := make(map[uint64]int, len(gs.PostList))
+	slugs := make(map[string]uint64, len(gs.PostList))
+	for i, post := range gs.PostList {
+		if err := post.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("post_list[%d] (id %d): %w", i, post.Id, err))
+		}
+		if first, found := postIDs[post.Id]; found {
+			errs = append(errs, fmt.Errorf("post_list[%d]: duplicated id %d, also used by post_list[%d]", i, post.Id, first))
+		} else {
+			postIDs[post.Id] = i
+		}
+		if post.Id > gs.PostCount {
+			errs = append(errs, fmt.Errorf("post_list[%d]: id %d is greater than post_count %d", i, post.Id, gs.PostCount))
+		}
+		if post.Slug != "" {
+			if id, taken := slugs[post.Slug]; taken && id != post.Id {
+				errs = append(errs, fmt.Errorf("post_list[%d] (id %d): slug %q is also used by post %d", i, post.Id, post.Slug, id))
+			} else {
+				slugs[post.Slug] = post.Id
+			}
+		}
+	}
+
+	editCounts := make(map[uint64]bool, len(gs.PostEditCounts))
+	for i, count := range gs.PostEditCounts {
+		if _, found := postIDs[count.PostId]; !found {
+			errs = append(errs, fmt.Errorf("post_edit_counts[%d]: post %d is not in post_list", i, count.PostId))
+		}
+		if editCounts[count.PostId] {
+			errs = append(errs, fmt.Errorf("post_edit_counts[%d]: duplicated post %d", i, count.PostId))
+		}
+		editCounts[count.PostId] = true
+		if count.Edits == 0 {
+			errs = append(errs, fmt.Errorf("post_edit_counts[%d]: post %d has no edits", i, count.PostId))
+		}
+	}
+
+	for i, alias := range gs.SlugAliases {
+		if _, found := postIDs[alias.PostId]; !found {
+			errs = append(errs, fmt.Errorf("slug_aliases[%d]: post %d is not in post_list", i, alias.PostId))
+		}
+		if err := ValidateSlug(alias.Slug); err != nil {
+			errs = append(errs, fmt.Errorf("slug_aliases[%d]: %w", i, err))
+		}
+		if id, taken := slugs[alias.Slug]; taken {
+			errs = append(errs, fmt.Errorf("slug_aliases[%d]: slug %q is also used by post %d", i, alias.Slug, id))
+		} else {
+			slugs[alias.Slug] = alias.PostId
+		}
+	}
+
+	profiles := make(map[string]bool, len(gs.Profiles))
+	displayNames := make(map[string]string, len(gs.Profiles))
+	for i, profile := range gs.Profiles {
+		if _, err := sdk.AccAddressFromBech32(profile.Address); err != nil {
+			errs = append(errs, fmt.Errorf("profiles[%d]: invalid address (%s)", i, err))
+		}
+		if err := profile.ValidateBasic(); err != nil {
+			errs = append(errs, fmt.Errorf("profiles[%d] (%s): %w", i, profile.Address, err))
+		}
+		if profiles[profile.Address] {
+			errs = append(errs, fmt.Errorf("profiles[%d]: duplicated address %s", i, profile.Address))
+		}
+		profiles[profile.Address] = true
+		if profile.DisplayName != "" {
+			name := NormalizeDisplayName(profile.DisplayName)
+			if owner, taken := displayNames[name]; taken {
+				errs = append(errs, fmt.Errorf("profiles[%d] (%s): display name %q is also used by %s", i, profile.Address, profile.DisplayName, owner))
+			} else {
+				displayNames[name] = profile.Address
+			}
+		}
+	}
+
+	follows := make(map[Follow]bool, len(gs.Follows))
+	for i, follow := range gs.Follows {
+		if err := validateFollowEdge(follow.Follower, follow.Followee); err != nil {
+			errs = append(errs, fmt.Errorf("follows[%d]: %w", i, err))
+		}
+		if follows[follow] {
+			errs = append(errs, fmt.Errorf("follows[%d]: duplicated edge from %s to %s", i, follow.Follower, follow.Followee))
+		}
+		follows[follow] = true
+	}
+
+	seriesIDs := make(map[uint64]int, len(gs.SeriesList))
+	for i, series := range gs.SeriesList {
+		if series.Id == 0 {
+			errs = append(errs, fmt.Errorf("series_list[%d]: series ID must be positive", i))
+		}
+		if first, found := seriesIDs[series.Id]; found {
+			errs = append(errs, fmt.Errorf("series_list[%d]: duplicated id %d, also used by series_list[%d]", i, series.Id, first))
+		} else {
+			seriesIDs[series.Id] = i
+		}
+		if series.Id > gs.SeriesCount {
+			errs = append(errs, fmt.Errorf("series_list[%d]: id %d is greater than series_count %d", i, series.Id, gs.SeriesCount))
+		}
+		if _, err := sdk.AccAddressFromBech32(series.Owner); err != nil {
+			errs = append(errs, fmt.Errorf("series_list[%d] (id %d): invalid owner address (%s)", i, series.Id, err))
+		}
+		if len(series.Title) == 0 {
+			errs = append(errs, fmt.Errorf("series_list[%d] (id %d): missing title", i, series.Id))
+		}
+		if err := ValidateSeriesPostIDs(series.PostIds); err != nil {
+			errs = append(errs, fmt.Errorf("series_list[%d] (id %d): %w", i, series.Id, err))
+		}
+		for _, postID := range series.PostIds {
+			if _, found := postIDs[postID]; !found {
+				errs = append(errs, fmt.Errorf("series_list[%d] (id %d): post %d is not in post_list", i, series.Id, postID))
+			}
+		}
+	}
+
+	proposalIDs := make(map[uint64]int, len(gs.PostChangeProposals))
+	for i, proposal := range gs.PostChangeProposals {
+		if proposal.Id == 0 {
+			errs = append(errs, fmt.Errorf("post_change_proposals[%d]: proposal ID must be positive", i))
+		}
+		if first, found := proposalIDs[proposal.Id]; found {
+			errs = append(errs, fmt.Errorf("post_change_proposals[%d]: duplicated id %d, also used by post_change_proposals[%d]", i, proposal.Id, first))
+		} else {
+			proposalIDs[proposal.Id] = i
+		}
+		if proposal.Id > gs.PostChangeProposalCount {
+			errs = append(errs, fmt.Errorf("post_change_proposals[%d]: id %d is greater than post_change_proposal_count %d", i, proposal.Id, gs.PostChangeProposalCount))
+		}
+		if j, found := postIDs[proposal.PostId]; !found {
+			errs = append(errs, fmt.Errorf("post_change_proposals[%d] (id %d): post %d is not in post_list", i, proposal.Id, proposal.PostId))
+		} else if !gs.PostList[j].RequiresApproval() {
+			errs = append(errs, fmt.Errorf("post_change_proposals[%d] (id %d): post %d has no co-authors", i, proposal.Id, proposal.PostId))
+		}
+		msg := MsgProposePostChange{
+			Creator:           proposal.Proposer,
+			PostId:            proposal.PostId,
+			Title:             proposal.Title,
+			Body:              proposal.Body,
+			Kind:              proposal.Kind,
+			CoAuthors:         proposal.CoAuthors,
+			ApprovalThreshold: proposal.ApprovalThreshold,
+		}
+		if err := msg.ValidateBasic(); err != nil {
+			errs = append(errs, fmt.Errorf("post_change_proposals[%d] (id %d): %w", i, proposal.Id, err))
+		}
+	}
+
+	deletedIDs := make(map[uint64]bool, len(gs.DeletedPosts))
+	for i, deleted := range gs.DeletedPosts {
+		post := deleted.Post
+		if err := post.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("deleted_posts[%d] (id %d): %w", i, post.Id, err))
+		}
+		if _, found := postIDs[post.Id]; found || deletedIDs[post.Id] {
+			errs = append(errs, fmt.Errorf("deleted_posts[%d]: duplicated id %d", i, post.Id))
+		}
+		deletedIDs[post.Id] = true
+		if post.Id > gs.PostCount {
+			errs = append(errs, fmt.Errorf("deleted_posts[%d]: id %d is greater than post_count %d", i, post.Id, gs.PostCount))
+		}
+		if _, err := sdk.AccAddressFromBech32(deleted.DeletedBy); err != nil {
+			errs = append(errs, fmt.Errorf("deleted_posts[%d] (id %d): invalid deleted_by address (%s)", i, post.Id, err))
+		}
+	}
+
+	// ListPost searches the post store by creation time in ID order, so
+	// creation times must not decrease with the ID
+	created := make(map[uint64]time.Time, len(gs.PostList)+len(gs.DeletedPosts))
+	createdIDs := make([]uint64, 0, len(gs.PostList)+len(gs.DeletedPosts))
+	for _, post := range gs.PostList {
+		created[post.Id] = post.CreatedAt
+		createdIDs = append(createdIDs, post.Id)
+	}
+	for _, deleted := range gs.DeletedPosts {
+		created[deleted.Post.Id] = deleted.Post.CreatedAt
+		createdIDs = append(createdIDs, deleted.Post.Id)
+	}
+	slices.Sort(createdIDs)
+	for i := 1; i < len(createdIDs); i++ {
+		prev, id := createdIDs[i-1], createdIDs[i]
+		if created[id].Before(created[prev]) {
+			errs = append(errs, fmt.Errorf("post %d: created_at %s is before the created_at %s of post %d", id, created[id].Format(time.RFC3339), created[prev].Format(time.RFC3339), prev))
+		}
+	}
+
+	quotaEntries := make(map[PostQuotaEntry]bool, len(gs.PostQuotaEntries))
+	for i, entry := range gs.PostQuotaEntries {
+		if _, err := sdk.AccAddressFromBech32(entry.Creator); err != nil {
+			errs = append(errs, fmt.Errorf("post_quota_entries[%d]: invalid creator address (%s)", i, err))
+		}
+		if entry.Count == 0 {
+			errs = append(errs, fmt.Errorf("post_quota_entries[%d]: count must be positive", i))
+		}
+		key := PostQuotaEntry{Height: entry.Height, Creator: entry.Creator}
+		if quotaEntries[key] {
+			errs = append(errs, fmt.Errorf("post_quota_entries[%d]: duplicated entry of %s at height %d", i, entry.Creator, entry.Height))
+		}
+		quotaEntries[key] = true
+	}
+
+	lastLogHeight := make(map[uint64]int64)
+	for i, entry := range gs.PostLog {
+		if entry.PostId == 0 || entry.PostId > gs.PostCount {
+			errs = append(errs, fmt.Errorf("post_log[%d]: post %d is zero or greater than post_count %d", i, entry.PostId, gs.PostCount))
+		}
+		if _, known := PostLogAction_name[int32(entry.Action)]; !known || entry.Action == PostLogAction_POST_LOG_ACTION_UNSPECIFIED {
+			errs = append(errs, fmt.Errorf("post_log[%d]: invalid action %s", i, entry.Action))
+		}
+		if entry.Actor != "" {
+			if _, err := sdk.AccAddressFromBech32(entry.Actor); err != nil {
+				errs = append(errs, fmt.Errorf("post_log[%d]: invalid actor address (%s)", i, err))
+			}
+		}
+		if last, found := lastLogHeight[entry.PostId]; found && entry.Height < last {
+			errs = append(errs, fmt.Errorf("post_log[%d]: height %d is before the previous change of post %d at %d", i, entry.Height, entry.PostId, last))
+		}
+		lastLogHeight[entry.PostId] = entry.Height
+	}
+
+	type entitlementKey struct {
+		address string
+		postID  uint64
+	}
+	entitlements := make(map[entitlementKey]bool, len(gs.Entitlements))
+	for i, entitlement := range gs.Entitlements {
+		if _, err := sdk.AccAddressFromBech32(entitlement.Address); err != nil {
+			errs = append(errs, fmt.Errorf("entitlements[%d]: invalid address (%s)", i, err))
+		}
+		if entitlement.PostId == 0 || entitlement.PostId > gs.PostCount {
+			errs = append(errs, fmt.Errorf("entitlements[%d]: post %d is zero or greater than post_count %d", i, entitlement.PostId, gs.PostCount))
+		}
+		if err := entitlement.Price.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("entitlements[%d]: invalid price (%s)", i, err))
+		}
+		if entitlement.Escrowed != (entitlement.RefundAt != nil) {
+			errs = append(errs, fmt.Errorf("entitlements[%d]: an escrowed entitlement needs a refund time and only it", i))
+		}
+		key := entitlementKey{entitlement.Address, entitlement.PostId}
+		if entitlements[key] {
+			errs = append(errs, fmt.Errorf("entitlements[%d]: duplicated entitlement of %s to post %d", i, entitlement.Address, entitlement.PostId))
+		}
+		entitlements[key] = true
+	}
 	// this line is used by starport scaffolding # genesis/types/validate
 
-	return gs.Params.Validate()
+	if err := gs.Params.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("params: %w", err))
+	}
+
+	return errors.Join(errs...)
 }
@@ -28,6 +28,49 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// params defines all the parameters of the module.
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// post_list holds the posts to store at genesis. The search, time, slug,
+	// tag and stats indexes are rebuilt from them.
+	PostList []Post `protobuf:"bytes,2,rep,name=post_list,json=postList,proto3" json:"post_list"`
+	// post_count is the ID of the latest post. New posts get IDs above it.
+	PostCount uint64 `protobuf:"varint,3,opt,name=post_count,json=postCount,proto3" json:"post_count,omitempty"`
+	// post_edit_counts holds the number of updates of each post with any. The
+	// edit counters of the creators are rebuilt from them.
+	PostEditCounts []PostEditCount `protobuf:"bytes,4,rep,name=post_edit_counts,json=postEditCounts,proto3" json:"post_edit_counts"`
+	// total_edits is the number of post updates ever applied.
+	TotalEdits uint64 `protobuf:"varint,5,opt,name=total_edits,json=totalEdits,proto3" json:"total_edits,omitempty"`
+	// slug_aliases holds the previous slugs of the posts, which keep resolving
+	// to them. Current slugs are indexed from post_list.
+	SlugAliases []SlugAlias `protobuf:"bytes,6,rep,name=slug_aliases,json=slugAliases,proto3" json:"slug_aliases"`
+	// profiles holds the author profiles. The display name index is rebuilt
+	// from them.
+	Profiles []Profile `protobuf:"bytes,7,rep,name=profiles,proto3" json:"profiles"`
+	// follows holds the follow graph. The follower index and following counts
+	// are rebuilt from it.
+	Follows []Follow `protobuf:"bytes,8,rep,name=follows,proto3" json:"follows"`
+	// series_list holds the series. The index of the series of each post is
+	// rebuilt from them.
+	SeriesList []Series `protobuf:"bytes,9,rep,name=series_list,json=seriesList,proto3" json:"series_list"`
+	// series_count is the ID of the latest series.
+	SeriesCount uint64 `protobuf:"varint,10,opt,name=series_count,json=seriesCount,proto3" json:"series_count,omitempty"`
+	// post_change_proposals holds the pending change proposals of co-authored
+	// posts. Their expiry and post indexes are rebuilt from them.
+	PostChangeProposals []PostChangeProposal `protobuf:"bytes,11,rep,name=post_change_proposals,json=postChangeProposals,proto3" json:"post_change_proposals"`
+	// post_change_proposal_count is the ID of the latest proposal.
+	PostChangeProposalCount uint64 `protobuf:"varint,12,opt,name=post_change_proposal_count,json=postChangeProposalCount,proto3" json:"post_change_proposal_count,omitempty"`
+	// deleted_posts holds the posts in the trash. Their deletion time and
+	// creator indexes are rebuilt from them.
+	DeletedPosts []DeletedPost `protobuf:"bytes,13,rep,name=deleted_posts,json=deletedPosts,proto3" json:"deleted_posts"`
+	// post_quota_entries holds the post creations within the current quota
+	// window. The per-account usage is rebuilt from them. Heights are those of
+	// the exporting chain, so they only carry over when the new chain starts
+	// at the export height (initial_height).
+	PostQuotaEntries []PostQuotaEntry `protobuf:"bytes,14,rep,name=post_quota_entries,json=postQuotaEntries,proto3" json:"post_quota_entries"`
+	// post_log holds the change log of every post, including removed ones,
+	// ordered by post ID and then by change.
+	PostLog []PostLogEntry `protobuf:"bytes,15,rep,name=post_log,json=postLog,proto3" json:"post_log"`
+	// entitlements holds the purchases of read access to paywalled posts. The
+	// index of the purchasers of each post is rebuilt from them.
+	Entitlements []Entitlement `protobuf:"bytes,16,rep,name=entitlements,proto3" json:"entitlements"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -70,26 +113,389 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetPostList() []Post {
+	if m != nil {
+		return m.PostList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPostCount() uint64 {
+	if m != nil {
+		return m.PostCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetPostEditCounts() []PostEditCount {
+	if m != nil {
+		return m.PostEditCounts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTotalEdits() uint64 {
+	if m != nil {
+		return m.TotalEdits
+	}
+	return 0
+}
+
+func (m *GenesisState) GetSlugAliases() []SlugAlias {
+	if m != nil {
+		return m.SlugAliases
+	}
+	return nil
+}
+
+func (m *GenesisState) GetProfiles() []Profile {
+	if m != nil {
+		return m.Profiles
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFollows() []Follow {
+	if m != nil {
+		return m.Follows
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSeriesList() []Series {
+	if m != nil {
+		return m.SeriesList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSeriesCount() uint64 {
+	if m != nil {
+		return m.SeriesCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetPostChangeProposals() []PostChangeProposal {
+	if m != nil {
+		return m.PostChangeProposals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPostChangeProposalCount() uint64 {
+	if m != nil {
+		return m.PostChangeProposalCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetDeletedPosts() []DeletedPost {
+	if m != nil {
+		return m.DeletedPosts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPostQuotaEntries() []PostQuotaEntry {
+	if m != nil {
+		return m.PostQuotaEntries
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPostLog() []PostLogEntry {
+	if m != nil {
+		return m.PostLog
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEntitlements() []Entitlement {
+	if m != nil {
+		return m.Entitlements
+	}
+	return nil
+}
+
+// PostEditCount is the number of updates applied to a post.
+type PostEditCount struct {
+	PostId uint64 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Edits  uint64 `protobuf:"varint,2,opt,name=edits,proto3" json:"edits,omitempty"`
+}
+
+func (m *PostEditCount) Reset()         { *m = PostEditCount{} }
+func (m *PostEditCount) String() string { return proto.CompactTextString(m) }
+func (*PostEditCount) ProtoMessage()    {}
+func (*PostEditCount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ec1b9f8d5f8f516, []int{1}
+}
+func (m *PostEditCount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PostEditCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PostEditCount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PostEditCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PostEditCount.Merge(m, src)
+}
+func (m *PostEditCount) XXX_Size() int {
+	return m.Size()
+}
+func (m *PostEditCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_PostEditCount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PostEditCount proto.InternalMessageInfo
+
+func (m *PostEditCount) GetPostId() uint64 {
+	if m != nil {
+		return m.PostId
+	}
+	return 0
+}
+
+func (m *PostEditCount) GetEdits() uint64 {
+	if m != nil {
+		return m.Edits
+	}
+	return 0
+}
+
+// SlugAlias is a previous slug of a post.
+type SlugAlias struct {
+	Slug   string `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	PostId uint64 `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+}
+
+func (m *SlugAlias) Reset()         { *m = SlugAlias{} }
+func (m *SlugAlias) String() string { return proto.CompactTextString(m) }
+func (*SlugAlias) ProtoMessage()    {}
+func (*SlugAlias) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ec1b9f8d5f8f516, []int{2}
+}
+func (m *SlugAlias) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlugAlias) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlugAlias.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SlugAlias) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlugAlias.Merge(m, src)
+}
+func (m *SlugAlias) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlugAlias) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlugAlias.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlugAlias proto.InternalMessageInfo
+
+func (m *SlugAlias) GetSlug() string {
+	if m != nil {
+		return m.Slug
+	}
+	return ""
+}
+
+func (m *SlugAlias) GetPostId() uint64 {
+	if m != nil {
+		return m.PostId
+	}
+	return 0
+}
+
+// Follow is an edge of the follow graph.
+type Follow struct {
+	Follower string `protobuf:"bytes,1,opt,name=follower,proto3" json:"follower,omitempty"`
+	Followee string `protobuf:"bytes,2,opt,name=followee,proto3" json:"followee,omitempty"`
+}
+
+func (m *Follow) Reset()         { *m = Follow{} }
+func (m *Follow) String() string { return proto.CompactTextString(m) }
+func (*Follow) ProtoMessage()    {}
+func (*Follow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ec1b9f8d5f8f516, []int{3}
+}
+func (m *Follow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Follow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Follow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Follow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Follow.Merge(m, src)
+}
+func (m *Follow) XXX_Size() int {
+	return m.Size()
+}
+func (m *Follow) XXX_DiscardUnknown() {
+	xxx_messageInfo_Follow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Follow proto.InternalMessageInfo
+
+func (m *Follow) GetFollower() string {
+	if m != nil {
+		return m.Follower
+	}
+	return ""
+}
+
+func (m *Follow) GetFollowee() string {
+	if m != nil {
+		return m.Followee
+	}
+	return ""
+}
+
+// PostQuotaEntry is the number of posts an account created at a height,
+// counted against its post quota.
+type PostQuotaEntry struct {
+	Height  uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	Count   uint64 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *PostQuotaEntry) Reset()         { *m = PostQuotaEntry{} }
+func (m *PostQuotaEntry) String() string { return proto.CompactTextString(m) }
+func (*PostQuotaEntry) ProtoMessage()    {}
+func (*PostQuotaEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ec1b9f8d5f8f516, []int{4}
+}
+func (m *PostQuotaEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PostQuotaEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PostQuotaEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PostQuotaEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PostQuotaEntry.Merge(m, src)
+}
+func (m *PostQuotaEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *PostQuotaEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_PostQuotaEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PostQuotaEntry proto.InternalMessageInfo
+
+func (m *PostQuotaEntry) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *PostQuotaEntry) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *PostQuotaEntry) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "blog.blog.GenesisState")
+	proto.RegisterType((*PostEditCount)(nil), "blog.blog.PostEditCount")
+	proto.RegisterType((*SlugAlias)(nil), "blog.blog.SlugAlias")
+	proto.RegisterType((*Follow)(nil), "blog.blog.Follow")
+	proto.RegisterType((*PostQuotaEntry)(nil), "blog.blog.PostQuotaEntry")
 }
 
 func init() { proto.RegisterFile("blog/blog/genesis.proto", fileDescriptor_8ec1b9f8d5f8f516) }
 
 var fileDescriptor_8ec1b9f8d5f8f516 = []byte{
-	// 179 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4f, 0xca, 0xc9, 0x4f,
-	0xd7, 0x07, 0x13, 0xe9, 0xa9, 0x79, 0xa9, 0xc5, 0x99, 0xc5, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9,
-	0x42, 0x9c, 0x20, 0x31, 0x3d, 0x10, 0x21, 0x25, 0x98, 0x98, 0x9b, 0x99, 0x97, 0xaf, 0x0f, 0x26,
-	0x21, 0xb2, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xa6, 0x3e, 0x88, 0x05, 0x15, 0x15, 0x43,
-	0x18, 0x56, 0x90, 0x58, 0x94, 0x98, 0x0b, 0x35, 0x4b, 0xc9, 0x85, 0x8b, 0xc7, 0x1d, 0x62, 0x78,
-	0x70, 0x49, 0x62, 0x49, 0xaa, 0x90, 0x09, 0x17, 0x1b, 0x44, 0x5e, 0x82, 0x51, 0x81, 0x51, 0x83,
-	0xdb, 0x48, 0x50, 0x0f, 0x6e, 0x99, 0x5e, 0x00, 0x58, 0xc2, 0x89, 0xf3, 0xc4, 0x3d, 0x79, 0x86,
-	0x15, 0xcf, 0x37, 0x68, 0x31, 0x06, 0x41, 0xd5, 0x3a, 0x69, 0x9f, 0x78, 0x24, 0xc7, 0x78, 0xe1,
-	0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70,
-	0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x20, 0xd8, 0xca, 0x0a, 0x88, 0xcd, 0x25, 0x95, 0x05, 0xa9, 0xc5,
-	0x49, 0x6c, 0x60, 0x9b, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0xa1, 0x53, 0x6f, 0x38, 0xe0,
-	0x00, 0x00, 0x00,
+	// 705 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x54, 0xcb, 0x6e, 0xd3, 0x40,
+	0x14, 0x4d, 0xd2, 0x34, 0x8f, 0x9b, 0xf4, 0x35, 0xb4, 0xcd, 0x10, 0xd4, 0x34, 0x64, 0x55, 0x81,
+	0x94, 0x8a, 0xd2, 0x45, 0x25, 0x04, 0xea, 0x83, 0xf2, 0x90, 0x8a, 0x54, 0xd2, 0x05, 0x88, 0x4d,
+	0xe4, 0xd6, 0x53, 0xc7, 0x92, 0xeb, 0x31, 0xbe, 0x13, 0x41, 0xff, 0x82, 0xcf, 0x40, 0x62, 0xc3,
+	0x67, 0x74, 0xd9, 0x25, 0x2b, 0x84, 0xda, 0x05, 0xbf, 0x81, 0xe6, 0xce, 0x38, 0x99, 0x26, 0x6c,
+	0x2c, 0xcf, 0x39, 0xf7, 0x9c, 0xfb, 0xf0, 0xf5, 0x40, 0xe3, 0x34, 0x92, 0xc1, 0x26, 0x3d, 0x02,
+	0x11, 0x0b, 0x0c, 0xb1, 0x9b, 0xa4, 0x52, 0x49, 0x56, 0xd5, 0x58, 0x57, 0x3f, 0x9a, 0x4b, 0xde,
+	0x45, 0x18, 0xcb, 0x4d, 0x7a, 0x1a, 0xb6, 0xb9, 0x1c, 0xc8, 0x40, 0xd2, 0xeb, 0xa6, 0x7e, 0xb3,
+	0xe8, 0x83, 0xb1, 0x99, 0x88, 0x55, 0xa8, 0x22, 0x71, 0x21, 0x62, 0x65, 0xc9, 0xd5, 0x31, 0x99,
+	0x78, 0xa9, 0x77, 0x81, 0x99, 0x95, 0x83, 0x4b, 0x54, 0xd3, 0x56, 0x1a, 0xed, 0x9f, 0x0d, 0xbc,
+	0x38, 0x10, 0x96, 0xe4, 0x13, 0xa4, 0xae, 0xd4, 0x30, 0x4e, 0x3b, 0x49, 0x2a, 0xcf, 0xc3, 0x48,
+	0x4c, 0x67, 0x47, 0x91, 0x86, 0x22, 0xcb, 0xbe, 0x32, 0xc6, 0x55, 0xea, 0xe1, 0xc0, 0xc0, 0x9d,
+	0x1f, 0x65, 0xa8, 0xbf, 0x36, 0xf3, 0x38, 0x51, 0x9e, 0x12, 0x6c, 0x1b, 0x4a, 0xa6, 0x6a, 0x9e,
+	0x6f, 0xe7, 0x37, 0x6a, 0x5b, 0x4b, 0xdd, 0xd1, 0x7c, 0xba, 0xc7, 0x44, 0xec, 0x57, 0xaf, 0x7e,
+	0xaf, 0xe7, 0xbe, 0xff, 0xfd, 0xf9, 0x28, 0xdf, 0xb3, 0xb1, 0x6c, 0x0b, 0xaa, 0xa6, 0xc0, 0x10,
+	0x15, 0x2f, 0xb4, 0x67, 0x36, 0x6a, 0x5b, 0x0b, 0xae, 0x50, 0xa2, 0xda, 0x2f, 0x6a, 0x59, 0xaf,
+	0xa2, 0xe3, 0x8e, 0x42, 0x54, 0x6c, 0x0d, 0xc0, 0x74, 0x2c, 0x87, 0xb1, 0xe2, 0x33, 0xed, 0xfc,
+	0x46, 0xb1, 0x47, 0x2e, 0x07, 0x1a, 0x60, 0x6f, 0x60, 0x91, 0x68, 0xe1, 0x87, 0x36, 0x06, 0x79,
+	0x91, 0x9c, 0xf9, 0x84, 0xf3, 0xa1, 0x1f, 0x1a, 0x8d, 0x4d, 0x31, 0x9f, 0xb8, 0x20, 0xb2, 0x75,
+	0xa8, 0x29, 0xa9, 0xbc, 0x88, 0xac, 0x90, 0xcf, 0x52, 0x26, 0x20, 0x48, 0x47, 0x21, 0x7b, 0x0e,
+	0x75, 0x8c, 0x86, 0x41, 0xdf, 0x8b, 0x42, 0x0f, 0x05, 0xf2, 0x12, 0xa5, 0x59, 0x76, 0xd2, 0x9c,
+	0x44, 0xc3, 0x60, 0x4f, 0xb3, 0x36, 0x45, 0x0d, 0x33, 0x40, 0x20, 0xdb, 0x86, 0x8a, 0xfd, 0x06,
+	0xc8, 0xcb, 0x24, 0x65, 0x6e, 0x85, 0x86, 0x1a, 0xb5, 0x6f, 0x23, 0xd9, 0x13, 0x28, 0x9f, 0xcb,
+	0x28, 0x92, 0x5f, 0x90, 0x57, 0xda, 0x33, 0x13, 0x93, 0x7e, 0x45, 0x8c, 0xd5, 0x64, 0x71, 0x6c,
+	0x07, 0x6a, 0xe6, 0x9b, 0x9a, 0x39, 0x57, 0xa7, 0x64, 0x27, 0xc4, 0x5a, 0x19, 0x98, 0x58, 0x9a,
+	0xf5, 0x43, 0xa8, 0x5b, 0xa5, 0x99, 0x36, 0xd0, 0x0c, 0xac, 0x9b, 0x99, 0xf7, 0x07, 0x58, 0x71,
+	0x16, 0xb0, 0x9f, 0xa4, 0x32, 0x91, 0xe8, 0x45, 0xc8, 0x6b, 0x94, 0x66, 0x6d, 0x62, 0xe8, 0x07,
+	0x14, 0x76, 0x6c, 0xa3, 0x6c, 0xca, 0x7b, 0xc9, 0x14, 0x83, 0xec, 0x19, 0x34, 0xff, 0x67, 0x6c,
+	0x2b, 0xa9, 0x53, 0x25, 0x8d, 0x69, 0xa1, 0xa9, 0x6a, 0x0f, 0xe6, 0x7c, 0x11, 0x09, 0x25, 0xfc,
+	0xbe, 0x0e, 0x41, 0x3e, 0x47, 0xd5, 0xac, 0x3a, 0xd5, 0xbc, 0x34, 0xbc, 0xb3, 0x63, 0x75, 0x7f,
+	0x0c, 0x21, 0x7b, 0x07, 0x8c, 0xf2, 0x7f, 0x1e, 0x4a, 0xe5, 0xf5, 0x45, 0xac, 0x74, 0xcf, 0x7c,
+	0x9e, 0x7c, 0xee, 0x4f, 0x74, 0xf5, 0x5e, 0xc7, 0x1c, 0xc6, 0x2a, 0xbd, 0xb4, 0x56, 0x8b, 0x89,
+	0x8b, 0x86, 0x42, 0x7f, 0x84, 0x4a, 0xf6, 0x2f, 0xf2, 0x05, 0x32, 0x69, 0x4c, 0x98, 0x1c, 0xc9,
+	0xc0, 0xb5, 0x28, 0x27, 0x06, 0x63, 0xbb, 0x50, 0x77, 0x6e, 0x0b, 0xe4, 0x8b, 0x53, 0xad, 0x1c,
+	0x8e, 0xe9, 0xac, 0x15, 0x57, 0xd1, 0x79, 0x01, 0x73, 0x77, 0x16, 0x9e, 0x35, 0x80, 0xdc, 0xfb,
+	0xa1, 0x4f, 0xbf, 0x6b, 0xb1, 0x57, 0xd2, 0xc7, 0xb7, 0x3e, 0x5b, 0x86, 0x59, 0xb3, 0xed, 0x05,
+	0x82, 0xcd, 0xa1, 0xb3, 0x03, 0xd5, 0xd1, 0x26, 0x33, 0x06, 0x45, 0xbd, 0xc5, 0x24, 0xac, 0xf6,
+	0xe8, 0xdd, 0xf5, 0x2b, 0xb8, 0x7e, 0x9d, 0x5d, 0x28, 0x99, 0x9d, 0x64, 0x4d, 0xa8, 0x98, 0x7d,
+	0x14, 0xa9, 0x95, 0x8e, 0xce, 0x0e, 0x27, 0x78, 0xe1, 0x0e, 0x27, 0x3a, 0x1f, 0x61, 0xfe, 0xee,
+	0x84, 0xd9, 0x2a, 0x94, 0x06, 0x22, 0x0c, 0x06, 0x2a, 0xab, 0xdd, 0x9c, 0x18, 0x87, 0xf2, 0x59,
+	0x2a, 0x3c, 0x25, 0x53, 0x6b, 0x92, 0x1d, 0x75, 0x57, 0xee, 0x6d, 0x61, 0x0e, 0xfb, 0x8f, 0xaf,
+	0x6e, 0x5a, 0xf9, 0xeb, 0x9b, 0x56, 0xfe, 0xcf, 0x4d, 0x2b, 0xff, 0xed, 0xb6, 0x95, 0xbb, 0xbe,
+	0x6d, 0xe5, 0x7e, 0xdd, 0xb6, 0x72, 0x9f, 0x96, 0xe8, 0xbe, 0xfb, 0x6a, 0xaf, 0xbd, 0xcb, 0x44,
+	0xe0, 0x69, 0x89, 0xee, 0xbd, 0xa7, 0xff, 0x06, 0x00, 0x73, 0x33, 0xbf, 0xda, 0x10, 0x06, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -112,48 +518,1236 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Entitlements) > 0 {
+		for iNdEx := len(m.Entitlements) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entitlements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.PostLog) > 0 {
+		for iNdEx := len(m.PostLog) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PostLog[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.PostQuotaEntries) > 0 {
+		for iNdEx := len(m.PostQuotaEntries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PostQuotaEntries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.DeletedPosts) > 0 {
+		for iNdEx := len(m.DeletedPosts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DeletedPosts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.PostChangeProposalCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PostChangeProposalCount))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.PostChangeProposals) > 0 {
+		for iNdEx := len(m.PostChangeProposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PostChangeProposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.SeriesCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SeriesCount))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.SeriesList) > 0 {
+		for iNdEx := len(m.SeriesList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SeriesList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Follows) > 0 {
+		for iNdEx := len(m.Follows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Follows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Profiles) > 0 {
+		for iNdEx := len(m.Profiles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Profiles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.SlugAliases) > 0 {
+		for iNdEx := len(m.SlugAliases) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlugAliases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.TotalEdits != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TotalEdits))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.PostEditCounts) > 0 {
+		for iNdEx := len(m.PostEditCounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PostEditCounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.PostCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PostCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PostList) > 0 {
+		for iNdEx := len(m.PostList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PostList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PostEditCount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PostEditCount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PostEditCount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Edits != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Edits))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PostId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PostId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SlugAlias) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlugAlias) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlugAlias) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PostId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PostId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Slug) > 0 {
+		i -= len(m.Slug)
+		copy(dAtA[i:], m.Slug)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Slug)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Follow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Follow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Follow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Followee) > 0 {
+		i -= len(m.Followee)
+		copy(dAtA[i:], m.Followee)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Followee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Follower) > 0 {
+		i -= len(m.Follower)
+		copy(dAtA[i:], m.Follower)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Follower)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PostQuotaEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PostQuotaEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PostQuotaEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.PostList) > 0 {
+		for _, e := range m.PostList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.PostCount != 0 {
+		n += 1 + sovGenesis(uint64(m.PostCount))
+	}
+	if len(m.PostEditCounts) > 0 {
+		for _, e := range m.PostEditCounts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.TotalEdits != 0 {
+		n += 1 + sovGenesis(uint64(m.TotalEdits))
+	}
+	if len(m.SlugAliases) > 0 {
+		for _, e := range m.SlugAliases {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Profiles) > 0 {
+		for _, e := range m.Profiles {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Follows) > 0 {
+		for _, e := range m.Follows {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SeriesList) > 0 {
+		for _, e := range m.SeriesList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.SeriesCount != 0 {
+		n += 1 + sovGenesis(uint64(m.SeriesCount))
+	}
+	if len(m.PostChangeProposals) > 0 {
+		for _, e := range m.PostChangeProposals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.PostChangeProposalCount != 0 {
+		n += 1 + sovGenesis(uint64(m.PostChangeProposalCount))
+	}
+	if len(m.DeletedPosts) > 0 {
+		for _, e := range m.DeletedPosts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PostQuotaEntries) > 0 {
+		for _, e := range m.PostQuotaEntries {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PostLog) > 0 {
+		for _, e := range m.PostLog {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Entitlements) > 0 {
+		for _, e := range m.Entitlements {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PostEditCount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PostId != 0 {
+		n += 1 + sovGenesis(uint64(m.PostId))
+	}
+	if m.Edits != 0 {
+		n += 1 + sovGenesis(uint64(m.Edits))
+	}
+	return n
+}
+
+func (m *SlugAlias) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Slug)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.PostId != 0 {
+		n += 1 + sovGenesis(uint64(m.PostId))
+	}
+	return n
+}
+
+func (m *Follow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Follower)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Followee)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *PostQuotaEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovGenesis(uint64(m.Count))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PostList = append(m.PostList, Post{})
+			if err := m.PostList[len(m.PostList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostCount", wireType)
+			}
+			m.PostCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PostCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostEditCounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PostEditCounts = append(m.PostEditCounts, PostEditCount{})
+			if err := m.PostEditCounts[len(m.PostEditCounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalEdits", wireType)
+			}
+			m.TotalEdits = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalEdits |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlugAliases", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlugAliases = append(m.SlugAliases, SlugAlias{})
+			if err := m.SlugAliases[len(m.SlugAliases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Profiles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Profiles = append(m.Profiles, Profile{})
+			if err := m.Profiles[len(m.Profiles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Follows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Follows = append(m.Follows, Follow{})
+			if err := m.Follows[len(m.Follows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeriesList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SeriesList = append(m.SeriesList, Series{})
+			if err := m.SeriesList[len(m.SeriesList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeriesCount", wireType)
+			}
+			m.SeriesCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SeriesCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostChangeProposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PostChangeProposals = append(m.PostChangeProposals, PostChangeProposal{})
+			if err := m.PostChangeProposals[len(m.PostChangeProposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostChangeProposalCount", wireType)
+			}
+			m.PostChangeProposalCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PostChangeProposalCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletedPosts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeletedPosts = append(m.DeletedPosts, DeletedPost{})
+			if err := m.DeletedPosts[len(m.DeletedPosts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostQuotaEntries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PostQuotaEntries = append(m.PostQuotaEntries, PostQuotaEntry{})
+			if err := m.PostQuotaEntries[len(m.PostQuotaEntries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostLog", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PostLog = append(m.PostLog, PostLogEntry{})
+			if err := m.PostLog[len(m.PostLog)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entitlements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entitlements = append(m.Entitlements, Entitlement{})
+			if err := m.Entitlements[len(m.Entitlements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PostEditCount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PostEditCount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PostEditCount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostId", wireType)
+			}
+			m.PostId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PostId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Edits", wireType)
+			}
+			m.Edits = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Edits |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SlugAlias) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlugAlias: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlugAlias: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slug", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Slug = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostId", wireType)
+			}
+			m.PostId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PostId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
-}
 
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *Follow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -176,17 +1770,17 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: Follow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Follow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Follower", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -196,25 +1790,176 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Follower = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Followee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Followee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PostQuotaEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PostQuotaEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PostQuotaEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
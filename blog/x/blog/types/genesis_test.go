@@ -2,13 +2,19 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
+	"blog/testutil/sample"
 	"blog/x/blog/types"
 
 	"github.com/stretchr/testify/require"
 )
 
 func TestGenesisState_Validate(t *testing.T) {
+	creator := sample.AccAddress()
+	post := func(id uint64, slug string) types.Post {
+		return types.Post{Id: id, Creator: creator, Title: "title", Slug: slug, Editors: []string{creator}}
+	}
 	tests := []struct {
 		desc     string
 		genState *types.GenesisState
@@ -20,13 +26,205 @@ func TestGenesisState_Validate(t *testing.T) {
 			valid:    true,
 		},
 		{
-			desc:     "valid genesis state",
+			desc: "valid genesis state",
 			genState: &types.GenesisState{
 
+				PostList:  []types.Post{post(1, "first"), post(2, "")},
+				PostCount: 2,
 				// this line is used by starport scaffolding # types/genesis/validField
 			},
 			valid: true,
 		},
+		{
+			desc: "duplicated post",
+			genState: &types.GenesisState{
+				PostList:  []types.Post{post(1, "first"), post(1, "second")},
+				PostCount: 2,
+			},
+			valid: false,
+		},
+		{
+			desc: "post id above post count",
+			genState: &types.GenesisState{
+				PostList:  []types.Post{post(1, "first"), post(2, "second")},
+				PostCount: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated slug",
+			genState: &types.GenesisState{
+				PostList:  []types.Post{post(1, "same"), post(2, "same")},
+				PostCount: 2,
+			},
+			valid: false,
+		},
+		{
+			desc: "creator is not an editor",
+			genState: &types.GenesisState{
+				PostList:  []types.Post{{Id: 1, Creator: creator, Title: "title"}},
+				PostCount: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid slug",
+			genState: &types.GenesisState{
+				PostList:  []types.Post{post(1, "Not A Slug")},
+				PostCount: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "edit count of an unknown post",
+			genState: &types.GenesisState{
+				PostList:       []types.Post{post(1, "first")},
+				PostCount:      2,
+				PostEditCounts: []types.PostEditCount{{PostId: 2, Edits: 1}},
+			},
+			valid: false,
+		},
+		{
+			desc: "slug alias taken by another post",
+			genState: &types.GenesisState{
+				PostList:    []types.Post{post(1, "first"), post(2, "second")},
+				PostCount:   2,
+				SlugAliases: []types.SlugAlias{{Slug: "first", PostId: 2}},
+			},
+			valid: false,
+		},
+		{
+			desc: "display name used twice",
+			genState: &types.GenesisState{
+				Profiles: []types.Profile{
+					{Address: creator, DisplayName: "jane"},
+					{Address: sample.AccAddress(), DisplayName: "Jane"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "self follow",
+			genState: &types.GenesisState{
+				Follows: []types.Follow{{Follower: creator, Followee: creator}},
+			},
+			valid: false,
+		},
+		{
+			desc: "series of an unknown post",
+			genState: &types.GenesisState{
+				PostList:    []types.Post{post(1, "first")},
+				PostCount:   1,
+				SeriesList:  []types.Series{{Id: 1, Owner: creator, Title: "series", PostIds: []uint64{1, 2}}},
+				SeriesCount: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "series id above series count",
+			genState: &types.GenesisState{
+				SeriesList: []types.Series{{Id: 1, Owner: creator, Title: "series"}},
+			},
+			valid: false,
+		},
+		{
+			desc: "proposal for a post without co-authors",
+			genState: &types.GenesisState{
+				PostList:                []types.Post{post(1, "first")},
+				PostCount:               1,
+				PostChangeProposals:     []types.PostChangeProposal{{Id: 1, PostId: 1, Proposer: creator, Title: "title"}},
+				PostChangeProposalCount: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "deleted post also in post_list",
+			genState: &types.GenesisState{
+				PostList:     []types.Post{post(1, "first")},
+				PostCount:    1,
+				DeletedPosts: []types.DeletedPost{{Post: post(1, "first"), DeletedBy: creator}},
+			},
+			valid: false,
+		},
+		{
+			desc: "post quota entry without posts",
+			genState: &types.GenesisState{
+				PostQuotaEntries: []types.PostQuotaEntry{{Height: 1, Creator: creator}},
+			},
+			valid: false,
+		},
+		{
+			desc: "post log out of order",
+			genState: &types.GenesisState{
+				PostList:  []types.Post{post(1, "first")},
+				PostCount: 1,
+				PostLog: []types.PostLogEntry{
+					{PostId: 1, Height: 5, Action: types.PostLogAction_POST_LOG_ACTION_UPDATE, Actor: creator},
+					{PostId: 1, Height: 3, Action: types.PostLogAction_POST_LOG_ACTION_CREATE, Actor: creator},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated entitlement",
+			genState: &types.GenesisState{
+				PostList:     []types.Post{post(1, "first")},
+				PostCount:    1,
+				Entitlements: []types.Entitlement{{Address: creator, PostId: 1}, {Address: creator, PostId: 1}},
+			},
+			valid: false,
+		},
+		{
+			desc: "body URI of a plain post",
+			genState: &types.GenesisState{
+				PostList: func() []types.Post {
+					p := post(1, "first")
+					p.BodyUri = "https://example.com/first"
+					return []types.Post{p}
+				}(),
+				PostCount: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "escrowed entitlement without refund time",
+			genState: &types.GenesisState{
+				PostList:     []types.Post{post(1, "first")},
+				PostCount:    1,
+				Entitlements: []types.Entitlement{{Address: creator, PostId: 1, Escrowed: true}},
+			},
+			valid: false,
+		},
+		{
+			desc: "creation time decreasing with the id",
+			genState: &types.GenesisState{
+				PostList: func() []types.Post {
+					first, second := post(1, "first"), post(2, "second")
+					first.CreatedAt = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+					second.CreatedAt = time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+					return []types.Post{second, first}
+				}(),
+				PostCount: 2,
+			},
+			valid: false,
+		},
+		{
+			desc: "creation time of a deleted post decreasing with the id",
+			genState: &types.GenesisState{
+				PostList: func() []types.Post {
+					p := post(2, "second")
+					p.CreatedAt = time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+					return []types.Post{p}
+				}(),
+				DeletedPosts: func() []types.DeletedPost {
+					p := post(1, "first")
+					p.CreatedAt = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+					return []types.DeletedPost{{Post: p, DeletedBy: creator}}
+				}(),
+				PostCount: 2,
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	}
 	for _, tc := range tests {
@@ -40,3 +238,19 @@ func TestGenesisState_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestGenesisState_ValidateReportsEveryProblem(t *testing.T) {
+	creator := sample.AccAddress()
+	genState := types.GenesisState{
+		Params: types.DefaultParams(),
+		PostList: []types.Post{
+			{Id: 1, Creator: creator, Title: "", Editors: []string{creator}},
+			{Id: 5, Creator: "invalid", Title: "title", Editors: []string{creator}},
+		},
+		PostCount: 1,
+	}
+	err := genState.Validate()
+	require.ErrorContains(t, err, "post_list[0] (id 1): missing title")
+	require.ErrorContains(t, err, "post_list[1] (id 5): invalid creator address")
+	require.ErrorContains(t, err, "post_list[1]: id 5 is greater than post_count 1")
+}
@@ -20,6 +20,106 @@ var (
 
 	// This key will be used to keep track of the ID of the latest post added to the store.
 	PostCountKey = "Post/count/"
+
+	// PostSearchKey prefixes the inverted index of post terms. Each entry is
+	// keyed by the term, a zero byte and the ID of a post containing the term.
+	PostSearchKey = "Post/search/"
+
+	// PostCreatorKey prefixes the index of posts by creator. Each entry is keyed
+	// by the length-prefixed creator, the creation time and the post ID.
+	PostCreatorKey = "Post/creator/"
+
+	// PostUpdatedKey prefixes the index of posts by last update. Each entry is
+	// keyed by the last update time and the post ID.
+	PostUpdatedKey = "Post/updated/"
+
+	// PostSlugKey prefixes the slug to post ID index. Previous slugs of a post
+	// stay in the index so that old URLs keep resolving.
+	PostSlugKey = "Post/slug/"
+
+	// PostSlugAliasKey prefixes the reverse slug index, keyed by post ID and
+	// slug, used to release every slug of a post when it is removed.
+	PostSlugAliasKey = "Post/slugAlias/"
+
+	// PostTagKey prefixes the index of posts by tag. Each entry is keyed by
+	// the length-prefixed tag and the post ID.
+	PostTagKey = "Post/tag/"
+
+	// ProfileKey prefixes author profiles keyed by address.
+	ProfileKey = "Profile/value/"
+
+	// ProfileNameKey prefixes the index of normalized display names to the
+	// address owning them.
+	ProfileNameKey = "Profile/name/"
+
+	// FollowingKey prefixes the follow graph keyed by the length-prefixed
+	// follower and the followee. FollowerKey holds the same edges keyed by the
+	// length-prefixed followee and the follower.
+	FollowingKey = "Follow/following/"
+	FollowerKey  = "Follow/follower/"
+
+	// FollowingCountKey prefixes the number of accounts followed, keyed by the
+	// follower address.
+	FollowingCountKey = "Follow/followingCount/"
+
+	// SeriesKey prefixes series keyed by ID and SeriesCountKey holds the ID of
+	// the latest series.
+	SeriesKey      = "Series/value/"
+	SeriesCountKey = "Series/count/"
+
+	// SeriesPostKey prefixes the index of the series a post belongs to, keyed
+	// by post ID and series ID.
+	SeriesPostKey = "Series/post/"
+
+	// PostChangeProposalKey prefixes pending post change proposals keyed by ID
+	// and PostChangeProposalCountKey holds the ID of the latest proposal.
+	PostChangeProposalKey      = "PostChange/value/"
+	PostChangeProposalCountKey = "PostChange/count/"
+
+	// PostChangeExpiryKey prefixes the index of proposals by expiry time and
+	// ID, and PostChangePostKey the index of proposals by post ID and ID.
+	PostChangeExpiryKey = "PostChange/expiry/"
+	PostChangePostKey   = "PostChange/post/"
+
+	// TrashKey prefixes deleted posts keyed by ID. TrashDeletedAtKey prefixes
+	// the index of deleted posts by deletion time and ID, and TrashCreatorKey
+	// the index by length-prefixed creator and ID.
+	TrashKey          = "Trash/value/"
+	TrashDeletedAtKey = "Trash/deletedAt/"
+	TrashCreatorKey   = "Trash/creator/"
+
+	// PostQuotaEntryKey prefixes the number of posts an account created at a
+	// height, keyed by height and length-prefixed creator. PostQuotaCountKey
+	// prefixes the number of posts an account created within the current
+	// quota window, keyed by creator.
+	PostQuotaEntryKey = "PostQuota/entry/"
+	PostQuotaCountKey = "PostQuota/count/"
+
+	// PostLogKey prefixes the change log of posts, keyed by post ID and the
+	// sequence number of the change within the log of the post.
+	PostLogKey = "PostLog/value/"
+
+	// EntitlementKey indexes entitlements by length-prefixed address and post
+	// ID, and EntitlementPostKey indexes the purchasers by post ID.
+	EntitlementKey     = "Entitlement/value/"
+	EntitlementPostKey = "Entitlement/post/"
+
+	// EntitlementRefundKey indexes the entitlements paid in escrow by refund
+	// time, post ID and address.
+	EntitlementRefundKey = "Entitlement/refund/"
+
+	// Counters maintained alongside the posts for the stats queries.
+	StatsTotalPostsKey    = "Stats/posts/"
+	StatsTotalCreatorsKey = "Stats/creators/"
+	StatsTotalEditsKey    = "Stats/edits/"
+
+	// StatsCreatorPostsKey and StatsCreatorEditsKey prefix per-creator counters
+	// keyed by the creator address.
+	StatsCreatorPostsKey = "Stats/creatorPosts/"
+	StatsCreatorEditsKey = "Stats/creatorEdits/"
+
+	// StatsPostEditsKey prefixes the per-post edit counters keyed by post ID.
+	StatsPostEditsKey = "Stats/postEdits/"
 )
 
 func KeyPrefix(p string) []byte {
@@ -0,0 +1,67 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgBatchPostOps{}
+
+func NewMsgBatchPostOps(creator string, ops []PostOp) *MsgBatchPostOps {
+	return &MsgBatchPostOps{
+		Creator: creator,
+		Ops:     ops,
+	}
+}
+
+func (msg *MsgBatchPostOps) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if len(msg.Ops) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty batch")
+	}
+
+	for i, op := range msg.Ops {
+		if err := op.Validate(); err != nil {
+			return errorsmod.Wrapf(err, "op %d", i)
+		}
+	}
+
+	return nil
+}
+
+// Validate performs the stateless checks of the standalone Msg matching the
+// operation type.
+func (op PostOp) Validate() error {
+	switch op.OpType {
+	case PostOpType_POST_OP_TYPE_CREATE:
+		if len(op.Title) == 0 {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "missing title")
+		}
+		if len(op.Slug) > 0 {
+			if err := ValidateSlug(op.Slug); err != nil {
+				return err
+			}
+		}
+		if err := ValidateTags(op.Tags); err != nil {
+			return err
+		}
+		return ValidateBody(op.BodyFormat, op.Body)
+	case PostOpType_POST_OP_TYPE_UPDATE:
+		if len(op.Tags) > 0 {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "tags are only set on created posts")
+		}
+		if len(op.Slug) > 0 {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "slugs are only set on created posts")
+		}
+		return ValidateBody(op.BodyFormat, op.Body)
+	case PostOpType_POST_OP_TYPE_DELETE:
+	default:
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "unknown op type %s", op.OpType)
+	}
+	return nil
+}
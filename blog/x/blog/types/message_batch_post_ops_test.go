@@ -0,0 +1,81 @@
+package types
+
+import (
+	"testing"
+
+	"blog/testutil/sample"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgBatchPostOps_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgBatchPostOps
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgBatchPostOps{
+				Creator: "invalid_address",
+				Ops:     []PostOp{{OpType: PostOpType_POST_OP_TYPE_DELETE, Id: 1}},
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "empty batch",
+			msg: MsgBatchPostOps{
+				Creator: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "unspecified op type",
+			msg: MsgBatchPostOps{
+				Creator: sample.AccAddress(),
+				Ops:     []PostOp{{Id: 1}},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "create without title",
+			msg: MsgBatchPostOps{
+				Creator: sample.AccAddress(),
+				Ops:     []PostOp{{OpType: PostOpType_POST_OP_TYPE_CREATE, Body: "body"}},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid slug",
+			msg: MsgBatchPostOps{
+				Creator: sample.AccAddress(),
+				Ops:     []PostOp{{OpType: PostOpType_POST_OP_TYPE_CREATE, Title: "title", Slug: "Not A Slug"}},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "update with slug",
+			msg: MsgBatchPostOps{
+				Creator: sample.AccAddress(),
+				Ops:     []PostOp{{OpType: PostOpType_POST_OP_TYPE_UPDATE, Id: 1, Title: "title", Slug: "title"}},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid batch",
+			msg: MsgBatchPostOps{
+				Creator: sample.AccAddress(),
+				Ops: []PostOp{
+					{OpType: PostOpType_POST_OP_TYPE_CREATE, Title: "title", Body: "body", Slug: "title"},
+					{OpType: PostOpType_POST_OP_TYPE_UPDATE, Id: 1, Title: "title", Body: "body"},
+					{OpType: PostOpType_POST_OP_TYPE_DELETE, Id: 1},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
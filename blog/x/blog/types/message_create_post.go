@@ -26,5 +26,19 @@ func (msg *MsgCreatePost) ValidateBasic() error {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "missing title")
 	}
 
-	return nil
+	if len(msg.Slug) > 0 {
+		if err := ValidateSlug(msg.Slug); err != nil {
+			return err
+		}
+	}
+
+	if err := ValidateTags(msg.Tags); err != nil {
+		return err
+	}
+
+	if msg.BodyFormat == BodyFormat_BODY_FORMAT_ENCRYPTED {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "encrypted posts are created with MsgCreatePrivatePost")
+	}
+
+	return ValidateBody(msg.BodyFormat, msg.Body)
 }
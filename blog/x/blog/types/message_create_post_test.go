@@ -22,6 +22,37 @@ func TestMsgCreatePost_ValidateBasic(t *testing.T) {
 				Title:   "title",
 			},
 			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid slug",
+			msg: MsgCreatePost{
+				Creator: sample.AccAddress(),
+				Title:   "title",
+				Slug:    "Not A Slug",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid tag",
+			msg: MsgCreatePost{
+				Creator: sample.AccAddress(),
+				Title:   "title",
+				Tags:    []string{"cosmos", "Go Lang"},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "duplicate tag",
+			msg: MsgCreatePost{
+				Creator: sample.AccAddress(),
+				Title:   "title",
+				Tags:    []string{"cosmos", "cosmos"},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid tags",
+			msg: MsgCreatePost{
+				Creator: sample.AccAddress(),
+				Title:   "title",
+				Tags:    []string{"cosmos", "go-lang"},
+			},
 		}, {
 			name: "valid address",
 			msg: MsgCreatePost{
@@ -0,0 +1,53 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgFollow{}
+
+func NewMsgFollow(creator string, followee string) *MsgFollow {
+	return &MsgFollow{
+		Creator:  creator,
+		Followee: followee,
+	}
+}
+
+func (msg *MsgFollow) ValidateBasic() error {
+	return validateFollowEdge(msg.Creator, msg.Followee)
+}
+
+var _ sdk.Msg = &MsgUnfollow{}
+
+func NewMsgUnfollow(creator string, followee string) *MsgUnfollow {
+	return &MsgUnfollow{
+		Creator:  creator,
+		Followee: followee,
+	}
+}
+
+func (msg *MsgUnfollow) ValidateBasic() error {
+	return validateFollowEdge(msg.Creator, msg.Followee)
+}
+
+// validateFollowEdge checks both ends of a follow edge. Accounts cannot follow
+// themselves.
+func validateFollowEdge(follower string, followee string) error {
+	_, err := sdk.AccAddressFromBech32(follower)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(followee)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid followee address (%s)", err)
+	}
+
+	if follower == followee {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "cannot follow yourself")
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package types
+
+import (
+	"testing"
+
+	"blog/testutil/sample"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgFollow_ValidateBasic(t *testing.T) {
+	self := sample.AccAddress()
+	tests := []struct {
+		name string
+		msg  MsgFollow
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgFollow{
+				Creator:  "invalid_address",
+				Followee: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid followee",
+			msg: MsgFollow{
+				Creator:  sample.AccAddress(),
+				Followee: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "follow self",
+			msg: MsgFollow{
+				Creator:  self,
+				Followee: self,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid address",
+			msg: MsgFollow{
+				Creator:  sample.AccAddress(),
+				Followee: sample.AccAddress(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgPatchPost{}
+
+func NewMsgPatchPost(creator string, id uint64, ops []PatchOp, expectedRevision string) *MsgPatchPost {
+	return &MsgPatchPost{
+		Creator:          creator,
+		Id:               id,
+		Ops:              ops,
+		ExpectedRevision: expectedRevision,
+	}
+}
+
+func (msg *MsgPatchPost) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if len(msg.ExpectedRevision) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "missing expected revision")
+	}
+
+	return ValidatePatchOps(msg.Ops)
+}
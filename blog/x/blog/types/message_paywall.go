@@ -0,0 +1,52 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgSetPostPrice{}
+
+func NewMsgSetPostPrice(creator string, id uint64, price sdk.Coins) *MsgSetPostPrice {
+	return &MsgSetPostPrice{
+		Creator: creator,
+		Id:      id,
+		Price:   price,
+	}
+}
+
+func (msg *MsgSetPostPrice) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	return ValidatePrice(msg.Price)
+}
+
+var _ sdk.Msg = &MsgPurchaseAccess{}
+
+func NewMsgPurchaseAccess(buyer string, id uint64, price sdk.Coins) *MsgPurchaseAccess {
+	return &MsgPurchaseAccess{
+		Buyer: buyer,
+		Id:    id,
+		Price: price,
+	}
+}
+
+func (msg *MsgPurchaseAccess) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Buyer)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid buyer address (%s)", err)
+	}
+
+	if err := ValidatePrice(msg.Price); err != nil {
+		return err
+	}
+	if msg.Price.Empty() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "missing price")
+	}
+
+	return nil
+}
@@ -0,0 +1,132 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxCoAuthors is the maximum number of co-authors of a post.
+const MaxCoAuthors = 20
+
+var _ sdk.Msg = &MsgSetCoAuthors{}
+
+func NewMsgSetCoAuthors(creator string, id uint64, coAuthors []string, approvalThreshold uint32) *MsgSetCoAuthors {
+	return &MsgSetCoAuthors{
+		Creator:           creator,
+		Id:                id,
+		CoAuthors:         coAuthors,
+		ApprovalThreshold: approvalThreshold,
+	}
+}
+
+func (msg *MsgSetCoAuthors) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	return ValidateCoAuthors(msg.CoAuthors, msg.ApprovalThreshold)
+}
+
+// ValidateCoAuthors checks the co-authors of a post and the number of them
+// required to approve a change
+func ValidateCoAuthors(coAuthors []string, approvalThreshold uint32) error {
+	if len(coAuthors) > MaxCoAuthors {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "a post has at most %d co-authors", MaxCoAuthors)
+	}
+	seen := make(map[string]bool, len(coAuthors))
+	for _, coAuthor := range coAuthors {
+		if _, err := sdk.AccAddressFromBech32(coAuthor); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid co-author address (%s)", err)
+		}
+		if seen[coAuthor] {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "co-author %s is listed twice", coAuthor)
+		}
+		seen[coAuthor] = true
+	}
+
+	if int(approvalThreshold) > len(coAuthors) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "approval threshold exceeds the number of co-authors")
+	}
+	if len(coAuthors) > 0 && approvalThreshold == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "missing approval threshold")
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgProposePostChange{}
+
+func NewMsgProposePostChange(creator string, postID uint64, title string, body string) *MsgProposePostChange {
+	return &MsgProposePostChange{
+		Creator: creator,
+		PostId:  postID,
+		Title:   title,
+		Body:    body,
+	}
+}
+
+func (msg *MsgProposePostChange) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	switch msg.Kind {
+	case PostChangeKind_POST_CHANGE_KIND_CONTENT:
+		if len(msg.CoAuthors) > 0 || msg.ApprovalThreshold != 0 {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "content changes do not set co-authors")
+		}
+		if len(msg.Title) == 0 {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "missing title")
+		}
+	case PostChangeKind_POST_CHANGE_KIND_CO_AUTHORS:
+		if len(msg.Title) > 0 || len(msg.Body) > 0 {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "co-author changes do not set a title or body")
+		}
+		return ValidateCoAuthors(msg.CoAuthors, msg.ApprovalThreshold)
+	case PostChangeKind_POST_CHANGE_KIND_DELETE:
+		if len(msg.Title) > 0 || len(msg.Body) > 0 || len(msg.CoAuthors) > 0 || msg.ApprovalThreshold != 0 {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "deletions carry no content or co-authors")
+		}
+	default:
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "unknown change kind %s", msg.Kind)
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgApprovePostChange{}
+
+func NewMsgApprovePostChange(creator string, proposalID uint64) *MsgApprovePostChange {
+	return &MsgApprovePostChange{
+		Creator:    creator,
+		ProposalId: proposalID,
+	}
+}
+
+func (msg *MsgApprovePostChange) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	return nil
+}
+
+// IsCoAuthor reports whether address is one of the co-authors of the post
+func (p Post) IsCoAuthor(address string) bool {
+	for _, coAuthor := range p.CoAuthors {
+		if coAuthor == address {
+			return true
+		}
+	}
+	return false
+}
+
+// RequiresApproval reports whether changes to the post go through co-author
+// approval
+func (p Post) RequiresApproval() bool {
+	return p.ApprovalThreshold > 0
+}
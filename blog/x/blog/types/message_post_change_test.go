@@ -0,0 +1,176 @@
+package types
+
+import (
+	"testing"
+
+	"blog/testutil/sample"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgSetCoAuthors_ValidateBasic(t *testing.T) {
+	coAuthor := sample.AccAddress()
+	tests := []struct {
+		name string
+		msg  MsgSetCoAuthors
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgSetCoAuthors{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid co-author",
+			msg: MsgSetCoAuthors{
+				Creator:           sample.AccAddress(),
+				CoAuthors:         []string{"invalid_address"},
+				ApprovalThreshold: 1,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "duplicate co-author",
+			msg: MsgSetCoAuthors{
+				Creator:           sample.AccAddress(),
+				CoAuthors:         []string{coAuthor, coAuthor},
+				ApprovalThreshold: 1,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "threshold above co-authors",
+			msg: MsgSetCoAuthors{
+				Creator:           sample.AccAddress(),
+				CoAuthors:         []string{sample.AccAddress()},
+				ApprovalThreshold: 2,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "missing threshold",
+			msg: MsgSetCoAuthors{
+				Creator:   sample.AccAddress(),
+				CoAuthors: []string{sample.AccAddress()},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "clear co-authors",
+			msg: MsgSetCoAuthors{
+				Creator: sample.AccAddress(),
+			},
+		}, {
+			name: "valid address",
+			msg: MsgSetCoAuthors{
+				Creator:           sample.AccAddress(),
+				CoAuthors:         []string{sample.AccAddress(), sample.AccAddress()},
+				ApprovalThreshold: 2,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgProposePostChange_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgProposePostChange
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgProposePostChange{
+				Creator: "invalid_address",
+				Title:   "title",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "content without title",
+			msg: MsgProposePostChange{
+				Creator: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "content with co-authors",
+			msg: MsgProposePostChange{
+				Creator:   sample.AccAddress(),
+				Title:     "title",
+				CoAuthors: []string{sample.AccAddress()},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "co-authors with title",
+			msg: MsgProposePostChange{
+				Creator:           sample.AccAddress(),
+				Kind:              PostChangeKind_POST_CHANGE_KIND_CO_AUTHORS,
+				Title:             "title",
+				CoAuthors:         []string{sample.AccAddress()},
+				ApprovalThreshold: 1,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "co-authors above threshold",
+			msg: MsgProposePostChange{
+				Creator:           sample.AccAddress(),
+				Kind:              PostChangeKind_POST_CHANGE_KIND_CO_AUTHORS,
+				CoAuthors:         []string{sample.AccAddress()},
+				ApprovalThreshold: 2,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "delete with body",
+			msg: MsgProposePostChange{
+				Creator: sample.AccAddress(),
+				Kind:    PostChangeKind_POST_CHANGE_KIND_DELETE,
+				Body:    "body",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "unknown kind",
+			msg: MsgProposePostChange{
+				Creator: sample.AccAddress(),
+				Kind:    PostChangeKind(7),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid content",
+			msg: MsgProposePostChange{
+				Creator: sample.AccAddress(),
+				Title:   "title",
+				Body:    "body",
+			},
+		}, {
+			name: "valid co-authors",
+			msg: MsgProposePostChange{
+				Creator:           sample.AccAddress(),
+				Kind:              PostChangeKind_POST_CHANGE_KIND_CO_AUTHORS,
+				CoAuthors:         []string{sample.AccAddress(), sample.AccAddress()},
+				ApprovalThreshold: 2,
+			},
+		}, {
+			name: "valid delete",
+			msg: MsgProposePostChange{
+				Creator: sample.AccAddress(),
+				Kind:    PostChangeKind_POST_CHANGE_KIND_DELETE,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgCreatePrivatePost{}
+
+func NewMsgCreatePrivatePost(creator string, title string, body string, keyEnvelopes []KeyEnvelope) *MsgCreatePrivatePost {
+	return &MsgCreatePrivatePost{
+		Creator:      creator,
+		Title:        title,
+		Body:         body,
+		KeyEnvelopes: keyEnvelopes,
+	}
+}
+
+func (msg *MsgCreatePrivatePost) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if len(msg.Title) == 0 {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "missing title")
+	}
+
+	if len(msg.Slug) > 0 {
+		if err := ValidateSlug(msg.Slug); err != nil {
+			return err
+		}
+	}
+
+	if err := ValidateSummary(msg.Summary); err != nil {
+		return err
+	}
+
+	if err := ValidateTags(msg.Tags); err != nil {
+		return err
+	}
+
+	if err := ValidatePrice(msg.Price); err != nil {
+		return err
+	}
+
+	if err := ValidateEncryptedContent(msg.Body, msg.BodyUri); err != nil {
+		return err
+	}
+
+	return ValidateKeyEnvelopes(msg.KeyEnvelopes, msg.Creator)
+}
+
+var _ sdk.Msg = &MsgGrantReadAccess{}
+
+func NewMsgGrantReadAccess(creator string, id uint64, envelope KeyEnvelope) *MsgGrantReadAccess {
+	return &MsgGrantReadAccess{
+		Creator:  creator,
+		Id:       id,
+		Envelope: envelope,
+	}
+}
+
+func (msg *MsgGrantReadAccess) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	return msg.Envelope.Validate()
+}
+
+var _ sdk.Msg = &MsgRevokeReadAccess{}
+
+func NewMsgRevokeReadAccess(creator string, id uint64, reader string) *MsgRevokeReadAccess {
+	return &MsgRevokeReadAccess{
+		Creator: creator,
+		Id:      id,
+		Reader:  reader,
+	}
+}
+
+func (msg *MsgRevokeReadAccess) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Reader)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid reader address (%s)", err)
+	}
+
+	if msg.Reader == msg.Creator {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "the creator cannot revoke its own access")
+	}
+
+	// the body and the envelopes are rotated together or not at all
+	if len(msg.Body) == 0 && len(msg.BodyUri) == 0 && len(msg.KeyEnvelopes) == 0 {
+		return nil
+	}
+	if err := ValidateEncryptedContent(msg.Body, msg.BodyUri); err != nil {
+		return err
+	}
+	if err := ValidateKeyEnvelopes(msg.KeyEnvelopes, msg.Creator); err != nil {
+		return err
+	}
+	if _, found := KeyEnvelopeOf(msg.KeyEnvelopes, msg.Reader); found {
+		return errorsmod.Wrap(ErrInvalidKeyEnvelope, "rotated envelopes include the revoked reader")
+	}
+
+	return nil
+}
@@ -0,0 +1,106 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxSeriesPosts is the maximum number of posts in a series.
+const MaxSeriesPosts = 100
+
+var _ sdk.Msg = &MsgCreateSeries{}
+
+func NewMsgCreateSeries(creator string, title string, postIDs []uint64) *MsgCreateSeries {
+	return &MsgCreateSeries{
+		Creator: creator,
+		Title:   title,
+		PostIds: postIDs,
+	}
+}
+
+func (msg *MsgCreateSeries) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if len(msg.Title) == 0 {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "missing title")
+	}
+
+	return ValidateSeriesPostIDs(msg.PostIds)
+}
+
+var _ sdk.Msg = &MsgAppendSeriesPost{}
+
+func NewMsgAppendSeriesPost(creator string, seriesID uint64, postID uint64) *MsgAppendSeriesPost {
+	return &MsgAppendSeriesPost{
+		Creator:  creator,
+		SeriesId: seriesID,
+		PostId:   postID,
+	}
+}
+
+func (msg *MsgAppendSeriesPost) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgReorderSeries{}
+
+func NewMsgReorderSeries(creator string, seriesID uint64, postIDs []uint64) *MsgReorderSeries {
+	return &MsgReorderSeries{
+		Creator:  creator,
+		SeriesId: seriesID,
+		PostIds:  postIDs,
+	}
+}
+
+func (msg *MsgReorderSeries) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	return ValidateSeriesPostIDs(msg.PostIds)
+}
+
+var _ sdk.Msg = &MsgRemoveSeriesPost{}
+
+func NewMsgRemoveSeriesPost(creator string, seriesID uint64, postID uint64) *MsgRemoveSeriesPost {
+	return &MsgRemoveSeriesPost{
+		Creator:  creator,
+		SeriesId: seriesID,
+		PostId:   postID,
+	}
+}
+
+func (msg *MsgRemoveSeriesPost) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	return nil
+}
+
+// ValidateSeriesPostIDs checks that a list of series posts is not too long
+// and holds no duplicates.
+func ValidateSeriesPostIDs(ids []uint64) error {
+	if len(ids) > MaxSeriesPosts {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "a series holds at most %d posts", MaxSeriesPosts)
+	}
+	seen := make(map[uint64]bool, len(ids))
+	for _, id := range ids {
+		if seen[id] {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "post %d is listed twice", id)
+		}
+		seen[id] = true
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package types
+
+import (
+	"testing"
+
+	"blog/testutil/sample"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgCreateSeries_ValidateBasic(t *testing.T) {
+	tooMany := make([]uint64, MaxSeriesPosts+1)
+	for i := range tooMany {
+		tooMany[i] = uint64(i + 1)
+	}
+
+	tests := []struct {
+		name string
+		msg  MsgCreateSeries
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgCreateSeries{
+				Creator: "invalid_address",
+				Title:   "series",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "missing title",
+			msg: MsgCreateSeries{
+				Creator: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "duplicate post",
+			msg: MsgCreateSeries{
+				Creator: sample.AccAddress(),
+				Title:   "series",
+				PostIds: []uint64{1, 2, 1},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "too many posts",
+			msg: MsgCreateSeries{
+				Creator: sample.AccAddress(),
+				Title:   "series",
+				PostIds: tooMany,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid address",
+			msg: MsgCreateSeries{
+				Creator: sample.AccAddress(),
+				Title:   "series",
+				PostIds: []uint64{2, 1},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgSetPostTags{}
+
+func NewMsgSetPostTags(creator string, id uint64, tags []string) *MsgSetPostTags {
+	return &MsgSetPostTags{
+		Creator: creator,
+		Id:      id,
+		Tags:    tags,
+	}
+}
+
+func (msg *MsgSetPostTags) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	return ValidateTags(msg.Tags)
+}
@@ -0,0 +1,39 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgSetProfile{}
+
+func NewMsgSetProfile(creator string, displayName string, bio string, avatarURI string, links []string) *MsgSetProfile {
+	return &MsgSetProfile{
+		Creator:     creator,
+		DisplayName: displayName,
+		Bio:         bio,
+		AvatarUri:   avatarURI,
+		Links:       links,
+	}
+}
+
+func (msg *MsgSetProfile) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	return msg.Profile().ValidateBasic()
+}
+
+// Profile returns the profile the message sets for its signer.
+func (msg *MsgSetProfile) Profile() Profile {
+	return Profile{
+		Address:     msg.Creator,
+		DisplayName: msg.DisplayName,
+		Bio:         msg.Bio,
+		AvatarUri:   msg.AvatarUri,
+		Links:       msg.Links,
+	}
+}
@@ -0,0 +1,93 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	"blog/testutil/sample"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgSetProfile_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgSetProfile
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgSetProfile{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "short display name",
+			msg: MsgSetProfile{
+				Creator:     sample.AccAddress(),
+				DisplayName: "ab",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "display name with spaces",
+			msg: MsgSetProfile{
+				Creator:     sample.AccAddress(),
+				DisplayName: "jane doe",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "long bio",
+			msg: MsgSetProfile{
+				Creator: sample.AccAddress(),
+				Bio:     strings.Repeat("a", MaxBioLength+1),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "avatar with unsupported scheme",
+			msg: MsgSetProfile{
+				Creator:   sample.AccAddress(),
+				AvatarUri: "javascript:alert(1)",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "too many links",
+			msg: MsgSetProfile{
+				Creator: sample.AccAddress(),
+				Links:   []string{"https://a.io", "https://b.io", "https://c.io", "https://d.io", "https://e.io", "https://f.io"},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "relative link",
+			msg: MsgSetProfile{
+				Creator: sample.AccAddress(),
+				Links:   []string{"/about"},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "empty profile",
+			msg: MsgSetProfile{
+				Creator: sample.AccAddress(),
+			},
+		}, {
+			name: "valid profile",
+			msg: MsgSetProfile{
+				Creator:     sample.AccAddress(),
+				DisplayName: "Jane_Doe",
+				Bio:         "Writes about validators.",
+				AvatarUri:   "ipfs://bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi",
+				Links:       []string{"https://example.com"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
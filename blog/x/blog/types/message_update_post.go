@@ -22,5 +22,12 @@ func (msg *MsgUpdatePost) ValidateBasic() error {
 	if err != nil {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
 	}
-	return nil
+
+	if len(msg.Slug) > 0 {
+		if err := ValidateSlug(msg.Slug); err != nil {
+			return err
+		}
+	}
+
+	return ValidateBody(msg.BodyFormat, msg.Body)
 }
@@ -21,6 +21,13 @@ func TestMsgUpdatePost_ValidateBasic(t *testing.T) {
 				Creator: "invalid_address",
 			},
 			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid slug",
+			msg: MsgUpdatePost{
+				Creator: sample.AccAddress(),
+				Slug:    "Not A Slug",
+			},
+			err: sdkerrors.ErrInvalidRequest,
 		}, {
 			name: "valid address",
 			msg: MsgUpdatePost{
@@ -1,32 +1,225 @@
 package types
 
 import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
+var (
+	KeyMaxBatchSize            = []byte("MaxBatchSize")
+	DefaultMaxBatchSize uint64 = 100
+
+	KeyMaxFollowing            = []byte("MaxFollowing")
+	DefaultMaxFollowing uint64 = 1000
+
+	KeyChangeProposalTtl            = []byte("ChangeProposalTtl")
+	DefaultChangeProposalTtl uint64 = 7 * 24 * 60 * 60
+
+	KeyTrashRetention            = []byte("TrashRetention")
+	DefaultTrashRetention uint64 = 30 * 24 * 60 * 60
+
+	KeyCreateGasPerByte            = []byte("CreateGasPerByte")
+	DefaultCreateGasPerByte uint64 = 10
+
+	KeyUpdateGasPerByte            = []byte("UpdateGasPerByte")
+	DefaultUpdateGasPerByte uint64 = 5
+
+	KeyPostQuota            = []byte("PostQuota")
+	DefaultPostQuota uint64 = 50
+
+	KeyPostQuotaWindow            = []byte("PostQuotaWindow")
+	DefaultPostQuotaWindow uint64 = 100
+
+	KeyPostQuotaAllowlist     = []byte("PostQuotaAllowlist")
+	DefaultPostQuotaAllowlist []string
+
+	KeyMaxTxMsgs            = []byte("MaxTxMsgs")
+	DefaultMaxTxMsgs uint64 = 10
+
+	KeyMaxBodyBytes            = []byte("MaxBodyBytes")
+	DefaultMaxBodyBytes uint64 = 64 << 10
+
+	KeyMinPostFee     = []byte("MinPostFee")
+	DefaultMinPostFee = sdk.NewCoin(sdk.DefaultBondDenom, math.ZeroInt())
+
+	KeyPurchaseRefundTimeout            = []byte("PurchaseRefundTimeout")
+	DefaultPurchaseRefundTimeout uint64 = 7 * 24 * 60 * 60
+)
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
 // NewParams creates a new Params instance
-func NewParams() Params {
-	return Params{}
+func NewParams(
+	maxBatchSize uint64,
+	maxFollowing uint64,
+	changeProposalTtl uint64,
+	trashRetention uint64,
+	createGasPerByte uint64,
+	updateGasPerByte uint64,
+	postQuota uint64,
+	postQuotaWindow uint64,
+	postQuotaAllowlist []string,
+	maxTxMsgs uint64,
+	maxBodyBytes uint64,
+	minPostFee sdk.Coin,
+	purchaseRefundTimeout uint64,
+) Params {
+	return Params{
+		MaxBatchSize:       maxBatchSize,
+		MaxFollowing:       maxFollowing,
+		ChangeProposalTtl:  changeProposalTtl,
+		TrashRetention:     trashRetention,
+		CreateGasPerByte:   createGasPerByte,
+		UpdateGasPerByte:   updateGasPerByte,
+		PostQuota:          postQuota,
+		PostQuotaWindow:    postQuotaWindow,
+		PostQuotaAllowlist: postQuotaAllowlist,
+		MaxTxMsgs:          maxTxMsgs,
+		MaxBodyBytes:       maxBodyBytes,
+		MinPostFee:         minPostFee,
+
+		PurchaseRefundTimeout: purchaseRefundTimeout,
+	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(
+		DefaultMaxBatchSize,
+		DefaultMaxFollowing,
+		DefaultChangeProposalTtl,
+		DefaultTrashRetention,
+		DefaultCreateGasPerByte,
+		DefaultUpdateGasPerByte,
+		DefaultPostQuota,
+		DefaultPostQuotaWindow,
+		DefaultPostQuotaAllowlist,
+		DefaultMaxTxMsgs,
+		DefaultMaxBodyBytes,
+		DefaultMinPostFee,
+		DefaultPurchaseRefundTimeout,
+	)
 }
 
 // ParamSetPairs get the params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{}
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyMaxBatchSize, &p.MaxBatchSize, validateUint64),
+		paramtypes.NewParamSetPair(KeyMaxFollowing, &p.MaxFollowing, validateUint64),
+		paramtypes.NewParamSetPair(KeyChangeProposalTtl, &p.ChangeProposalTtl, validateUint64),
+		paramtypes.NewParamSetPair(KeyTrashRetention, &p.TrashRetention, validateUint64),
+		paramtypes.NewParamSetPair(KeyCreateGasPerByte, &p.CreateGasPerByte, validateUint64),
+		paramtypes.NewParamSetPair(KeyUpdateGasPerByte, &p.UpdateGasPerByte, validateUint64),
+		paramtypes.NewParamSetPair(KeyPostQuota, &p.PostQuota, validateUint64),
+		paramtypes.NewParamSetPair(KeyPostQuotaWindow, &p.PostQuotaWindow, validateUint64),
+		paramtypes.NewParamSetPair(KeyPostQuotaAllowlist, &p.PostQuotaAllowlist, validateAddressList),
+		paramtypes.NewParamSetPair(KeyMaxTxMsgs, &p.MaxTxMsgs, validateUint64),
+		paramtypes.NewParamSetPair(KeyMaxBodyBytes, &p.MaxBodyBytes, validateUint64),
+		paramtypes.NewParamSetPair(KeyMinPostFee, &p.MinPostFee, validateCoin),
+		paramtypes.NewParamSetPair(KeyPurchaseRefundTimeout, &p.PurchaseRefundTimeout, validateUint64),
+	}
 }
 
 // Validate validates the set of params
 func (p Params) Validate() error {
+	if err := validateUint64(p.MaxBatchSize); err != nil {
+		return err
+	}
+	if err := validateUint64(p.MaxFollowing); err != nil {
+		return err
+	}
+	if err := validateUint64(p.ChangeProposalTtl); err != nil {
+		return err
+	}
+	if err := validateUint64(p.TrashRetention); err != nil {
+		return err
+	}
+	if err := validateUint64(p.CreateGasPerByte); err != nil {
+		return err
+	}
+	if err := validateUint64(p.UpdateGasPerByte); err != nil {
+		return err
+	}
+	if err := validateUint64(p.PostQuota); err != nil {
+		return err
+	}
+	if err := validateUint64(p.PostQuotaWindow); err != nil {
+		return err
+	}
+	if err := validateAddressList(p.PostQuotaAllowlist); err != nil {
+		return err
+	}
+	if err := validateUint64(p.MaxTxMsgs); err != nil {
+		return err
+	}
+	if err := validateUint64(p.MaxBodyBytes); err != nil {
+		return err
+	}
+	if err := validateCoin(p.MinPostFee); err != nil {
+		return err
+	}
+	return validateUint64(p.PurchaseRefundTimeout)
+}
+
+// IsPostQuotaExempt reports whether address is on the post quota allowlist
+func (p Params) IsPostQuotaExempt(address string) bool {
+	for _, exempt := range p.PostQuotaAllowlist {
+		if exempt == address {
+			return true
+		}
+	}
+	return false
+}
+
+// validateUint64 checks the type of a numeric parameter. Zero is a valid value
+// for every numeric parameter and means the corresponding limit is disabled.
+func validateUint64(v interface{}) error {
+	if _, ok := v.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	return nil
+}
+
+// validateAddressList checks that a parameter is a list of distinct account
+// addresses
+func validateAddressList(v interface{}) error {
+	addresses, ok := v.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	seen := make(map[string]bool, len(addresses))
+	for _, address := range addresses {
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			return fmt.Errorf("invalid address %q: %w", address, err)
+		}
+		if seen[address] {
+			return fmt.Errorf("duplicate address %q", address)
+		}
+		seen[address] = true
+	}
 	return nil
 }
+
+// validateCoin checks that a parameter is a valid coin. The empty coin is
+// valid and disables the corresponding requirement.
+func validateCoin(v interface{}) error {
+	coin, ok := v.(sdk.Coin)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+	if coin.Denom == "" && (coin.Amount.IsNil() || coin.Amount.IsZero()) {
+		return nil
+	}
+	if coin.Amount.IsNil() {
+		return fmt.Errorf("coin %q has no amount", coin.Denom)
+	}
+	return coin.Validate()
+}
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -26,6 +27,44 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
+	// max_batch_size caps the number of operations a single MsgBatchPostOps may
+	// carry. Zero disables the cap.
+	MaxBatchSize uint64 `protobuf:"varint,1,opt,name=max_batch_size,json=maxBatchSize,proto3" json:"max_batch_size,omitempty"`
+	// max_following caps the number of accounts an account may follow, which
+	// bounds the work of the Feed query. Zero disables the cap.
+	MaxFollowing uint64 `protobuf:"varint,2,opt,name=max_following,json=maxFollowing,proto3" json:"max_following,omitempty"`
+	// change_proposal_ttl is the number of seconds a post change proposal stays
+	// open for approval. Zero keeps proposals open until applied.
+	ChangeProposalTtl uint64 `protobuf:"varint,3,opt,name=change_proposal_ttl,json=changeProposalTtl,proto3" json:"change_proposal_ttl,omitempty"`
+	// trash_retention is the number of seconds a deleted post can be restored
+	// before it is purged. Zero keeps deleted posts forever.
+	TrashRetention uint64 `protobuf:"varint,4,opt,name=trash_retention,json=trashRetention,proto3" json:"trash_retention,omitempty"`
+	// create_gas_per_byte is charged for every byte of the title, summary, body,
+	// body URI and slug of a new post, on top of the gas of the store writes.
+	CreateGasPerByte uint64 `protobuf:"varint,5,opt,name=create_gas_per_byte,json=createGasPerByte,proto3" json:"create_gas_per_byte,omitempty"`
+	// update_gas_per_byte is charged for every byte of the title and body of an
+	// updated post, and for every byte a patch inserts.
+	UpdateGasPerByte uint64 `protobuf:"varint,6,opt,name=update_gas_per_byte,json=updateGasPerByte,proto3" json:"update_gas_per_byte,omitempty"`
+	// post_quota caps the number of posts an account may create within the
+	// last post_quota_window blocks. Zero in either disables the quota.
+	PostQuota       uint64 `protobuf:"varint,7,opt,name=post_quota,json=postQuota,proto3" json:"post_quota,omitempty"`
+	PostQuotaWindow uint64 `protobuf:"varint,8,opt,name=post_quota_window,json=postQuotaWindow,proto3" json:"post_quota_window,omitempty"`
+	// post_quota_allowlist lists the accounts exempt from the post quota.
+	PostQuotaAllowlist []string `protobuf:"bytes,9,rep,name=post_quota_allowlist,json=postQuotaAllowlist,proto3" json:"post_quota_allowlist,omitempty"`
+	// max_tx_msgs caps the number of blog messages a single tx may carry,
+	// including those wrapped in authz MsgExec. Zero disables the cap.
+	MaxTxMsgs uint64 `protobuf:"varint,10,opt,name=max_tx_msgs,json=maxTxMsgs,proto3" json:"max_tx_msgs,omitempty"`
+	// max_body_bytes caps the size of post bodies carried by a tx, checked
+	// before execution. Zero disables the cap.
+	MaxBodyBytes uint64 `protobuf:"varint,11,opt,name=max_body_bytes,json=maxBodyBytes,proto3" json:"max_body_bytes,omitempty"`
+	// min_post_fee is the minimum fee a tx creating posts must pay in the denom
+	// of the coin. A zero amount disables the requirement.
+	MinPostFee types.Coin `protobuf:"bytes,12,opt,name=min_post_fee,json=minPostFee,proto3" json:"min_post_fee"`
+	// purchase_refund_timeout is the number of seconds the payment of a
+	// purchase is held in escrow waiting for the creator to grant a key
+	// envelope, after which the buyer is refunded. Zero pays the creator at
+	// once.
+	PurchaseRefundTimeout uint64 `protobuf:"varint,13,opt,name=purchase_refund_timeout,json=purchaseRefundTimeout,proto3" json:"purchase_refund_timeout,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -61,6 +100,97 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetMaxBatchSize() uint64 {
+	if m != nil {
+		return m.MaxBatchSize
+	}
+	return 0
+}
+
+func (m *Params) GetMaxFollowing() uint64 {
+	if m != nil {
+		return m.MaxFollowing
+	}
+	return 0
+}
+
+func (m *Params) GetChangeProposalTtl() uint64 {
+	if m != nil {
+		return m.ChangeProposalTtl
+	}
+	return 0
+}
+
+func (m *Params) GetTrashRetention() uint64 {
+	if m != nil {
+		return m.TrashRetention
+	}
+	return 0
+}
+
+func (m *Params) GetCreateGasPerByte() uint64 {
+	if m != nil {
+		return m.CreateGasPerByte
+	}
+	return 0
+}
+
+func (m *Params) GetUpdateGasPerByte() uint64 {
+	if m != nil {
+		return m.UpdateGasPerByte
+	}
+	return 0
+}
+
+func (m *Params) GetPostQuota() uint64 {
+	if m != nil {
+		return m.PostQuota
+	}
+	return 0
+}
+
+func (m *Params) GetPostQuotaWindow() uint64 {
+	if m != nil {
+		return m.PostQuotaWindow
+	}
+	return 0
+}
+
+func (m *Params) GetPostQuotaAllowlist() []string {
+	if m != nil {
+		return m.PostQuotaAllowlist
+	}
+	return nil
+}
+
+func (m *Params) GetMaxTxMsgs() uint64 {
+	if m != nil {
+		return m.MaxTxMsgs
+	}
+	return 0
+}
+
+func (m *Params) GetMaxBodyBytes() uint64 {
+	if m != nil {
+		return m.MaxBodyBytes
+	}
+	return 0
+}
+
+func (m *Params) GetMinPostFee() types.Coin {
+	if m != nil {
+		return m.MinPostFee
+	}
+	return types.Coin{}
+}
+
+func (m *Params) GetPurchaseRefundTimeout() uint64 {
+	if m != nil {
+		return m.PurchaseRefundTimeout
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "blog.blog.Params")
 }
@@ -68,16 +198,40 @@ func init() {
 func init() { proto.RegisterFile("blog/blog/params.proto", fileDescriptor_4090b74576102d17) }
 
 var fileDescriptor_4090b74576102d17 = []byte{
-	// 143 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4b, 0xca, 0xc9, 0x4f,
-	0xd7, 0x07, 0x13, 0x05, 0x89, 0x45, 0x89, 0xb9, 0xc5, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42,
-	0x9c, 0x20, 0x21, 0x3d, 0x10, 0x21, 0x25, 0x98, 0x98, 0x9b, 0x99, 0x97, 0xaf, 0x0f, 0x26, 0x21,
-	0xb2, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xa6, 0x3e, 0x88, 0x05, 0x11, 0x55, 0x52, 0xe5,
-	0x62, 0x0b, 0x00, 0x9b, 0x61, 0x25, 0xfd, 0x62, 0x81, 0x3c, 0x63, 0xd7, 0xf3, 0x0d, 0x5a, 0x42,
-	0x60, 0x93, 0x2b, 0x20, 0x16, 0x40, 0x24, 0x9d, 0xb4, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48,
-	0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1,
-	0x58, 0x8e, 0x21, 0x4a, 0x10, 0x59, 0x75, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0x68,
-	0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x14, 0x81, 0xa3, 0x47, 0xa8, 0x00, 0x00, 0x00,
+	// 517 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x92, 0x4f, 0x8b, 0x13, 0x31,
+	0x18, 0xc6, 0x3b, 0x6e, 0xad, 0x36, 0xed, 0xee, 0xda, 0xec, 0xaa, 0x71, 0xc5, 0xd9, 0xa2, 0x82,
+	0xa5, 0xe2, 0x8c, 0xab, 0xe0, 0xc1, 0x9b, 0x15, 0xea, 0x49, 0xa8, 0xb5, 0x20, 0x78, 0x09, 0x99,
+	0x69, 0x3a, 0x0d, 0xcc, 0xcc, 0x3b, 0x4e, 0x52, 0x3b, 0xdd, 0x8f, 0xe0, 0xc9, 0x8f, 0xe0, 0xd1,
+	0xe3, 0x7e, 0x8c, 0x3d, 0xee, 0xd1, 0x93, 0x48, 0x7b, 0x58, 0xf1, 0x53, 0x48, 0x92, 0xfe, 0x83,
+	0xbd, 0xbc, 0x84, 0xe7, 0xf7, 0x83, 0x97, 0x24, 0x0f, 0xba, 0x13, 0xc4, 0x10, 0xf9, 0x66, 0x64,
+	0x2c, 0x67, 0x89, 0xf4, 0xb2, 0x1c, 0x14, 0xe0, 0xaa, 0x8e, 0x3c, 0x3d, 0x8e, 0x1a, 0x2c, 0x11,
+	0x29, 0xf8, 0x66, 0x5a, 0x7a, 0xe4, 0x86, 0x20, 0x13, 0x90, 0x7e, 0xc0, 0x24, 0xf7, 0xbf, 0x9e,
+	0x04, 0x5c, 0xb1, 0x13, 0x3f, 0x04, 0x91, 0x2e, 0xf9, 0x61, 0x04, 0x11, 0x98, 0xa3, 0xaf, 0x4f,
+	0x36, 0x7d, 0xf8, 0xaf, 0x8c, 0x2a, 0x3d, 0xb3, 0x04, 0x3f, 0x46, 0x7b, 0x09, 0x2b, 0x68, 0xc0,
+	0x54, 0x38, 0xa6, 0x52, 0x9c, 0x72, 0xe2, 0x34, 0x9d, 0x56, 0xb9, 0x5f, 0x4f, 0x58, 0xd1, 0xd1,
+	0xe1, 0x47, 0x71, 0xca, 0xf1, 0x23, 0xb4, 0xab, 0xad, 0x11, 0xc4, 0x31, 0x4c, 0x45, 0x1a, 0x91,
+	0x6b, 0x6b, 0xa9, 0xbb, 0xca, 0xb0, 0x87, 0x0e, 0xc2, 0x31, 0x4b, 0x23, 0x4e, 0xb3, 0x1c, 0x32,
+	0x90, 0x2c, 0xa6, 0x4a, 0xc5, 0x64, 0xc7, 0xa8, 0x0d, 0x8b, 0x7a, 0x4b, 0x32, 0x50, 0x31, 0x7e,
+	0x82, 0xf6, 0x55, 0xce, 0xe4, 0x98, 0xe6, 0x5c, 0xf1, 0x54, 0x09, 0x48, 0x49, 0xd9, 0xb8, 0x7b,
+	0x26, 0xee, 0xaf, 0x52, 0xfc, 0x0c, 0x1d, 0x84, 0x39, 0x67, 0x8a, 0xd3, 0x88, 0x49, 0x9a, 0xf1,
+	0x9c, 0x06, 0x33, 0xc5, 0xc9, 0x75, 0x23, 0xdf, 0xb2, 0xe8, 0x1d, 0x93, 0x3d, 0x9e, 0x77, 0x66,
+	0x8a, 0x6b, 0x7d, 0x92, 0x0d, 0xaf, 0xe8, 0x15, 0xab, 0x5b, 0xb4, 0xa5, 0x3f, 0x40, 0x28, 0x03,
+	0xa9, 0xe8, 0x97, 0x09, 0x28, 0x46, 0x6e, 0x18, 0xab, 0xaa, 0x93, 0x0f, 0x3a, 0xc0, 0x6d, 0xd4,
+	0xd8, 0x60, 0x3a, 0x15, 0xe9, 0x10, 0xa6, 0xe4, 0xa6, 0xb1, 0xf6, 0xd7, 0xd6, 0x27, 0x13, 0xe3,
+	0xe7, 0xe8, 0x70, 0xcb, 0x65, 0xfa, 0x61, 0x62, 0x21, 0x15, 0xa9, 0x36, 0x77, 0x5a, 0xd5, 0x3e,
+	0x5e, 0xeb, 0x6f, 0x56, 0x04, 0xbb, 0xa8, 0xa6, 0x1f, 0x56, 0x15, 0x34, 0x91, 0x91, 0x24, 0xc8,
+	0x6e, 0x4f, 0x58, 0x31, 0x28, 0xde, 0xcb, 0x68, 0xf3, 0x3d, 0x30, 0x9c, 0x99, 0x5b, 0x48, 0x52,
+	0xdb, 0x7c, 0x0f, 0x0c, 0x67, 0xfa, 0x06, 0x12, 0x77, 0x51, 0x3d, 0x11, 0x29, 0x35, 0xbb, 0x47,
+	0x9c, 0x93, 0x7a, 0xd3, 0x69, 0xd5, 0x5e, 0xdc, 0xf3, 0x6c, 0x39, 0x3c, 0x5d, 0x0e, 0x6f, 0x59,
+	0x0e, 0xef, 0x2d, 0x88, 0xb4, 0x53, 0x3d, 0xff, 0x7d, 0x5c, 0xfa, 0x79, 0x79, 0xd6, 0x76, 0xfa,
+	0x28, 0x11, 0x69, 0x0f, 0xa4, 0xea, 0x72, 0x8e, 0x5f, 0xa1, 0xbb, 0xd9, 0x24, 0x0f, 0xc7, 0x4c,
+	0x72, 0x9a, 0xf3, 0xd1, 0x24, 0x1d, 0x52, 0x25, 0x12, 0x0e, 0x13, 0x45, 0x76, 0xcd, 0xda, 0xdb,
+	0x2b, 0xdc, 0x37, 0x74, 0x60, 0xe1, 0xeb, 0xfb, 0x7f, 0x7f, 0x1c, 0x3b, 0xdf, 0x2e, 0xcf, 0xda,
+	0xd8, 0xf4, 0xb7, 0xb0, 0x35, 0xb6, 0x0d, 0xeb, 0x3c, 0x3d, 0x9f, 0xbb, 0xce, 0xc5, 0xdc, 0x75,
+	0xfe, 0xcc, 0x5d, 0xe7, 0xfb, 0xc2, 0x2d, 0x5d, 0x2c, 0xdc, 0xd2, 0xaf, 0x85, 0x5b, 0xfa, 0xdc,
+	0xd8, 0xb6, 0xd5, 0x2c, 0xe3, 0x32, 0xa8, 0x98, 0x82, 0xbe, 0xfc, 0x3f, 0x00, 0x06, 0x71, 0x19,
+	0x87, 0x0e, 0x03, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -99,6 +253,50 @@ func (this *Params) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if this.MaxBatchSize != that1.MaxBatchSize {
+		return false
+	}
+	if this.MaxFollowing != that1.MaxFollowing {
+		return false
+	}
+	if this.ChangeProposalTtl != that1.ChangeProposalTtl {
+		return false
+	}
+	if this.TrashRetention != that1.TrashRetention {
+		return false
+	}
+	if this.CreateGasPerByte != that1.CreateGasPerByte {
+		return false
+	}
+	if this.UpdateGasPerByte != that1.UpdateGasPerByte {
+		return false
+	}
+	if this.PostQuota != that1.PostQuota {
+		return false
+	}
+	if this.PostQuotaWindow != that1.PostQuotaWindow {
+		return false
+	}
+	if len(this.PostQuotaAllowlist) != len(that1.PostQuotaAllowlist) {
+		return false
+	}
+	for i := range this.PostQuotaAllowlist {
+		if this.PostQuotaAllowlist[i] != that1.PostQuotaAllowlist[i] {
+			return false
+		}
+	}
+	if this.MaxTxMsgs != that1.MaxTxMsgs {
+		return false
+	}
+	if this.MaxBodyBytes != that1.MaxBodyBytes {
+		return false
+	}
+	if !this.MinPostFee.Equal(&that1.MinPostFee) {
+		return false
+	}
+	if this.PurchaseRefundTimeout != that1.PurchaseRefundTimeout {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -121,6 +319,80 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PurchaseRefundTimeout != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PurchaseRefundTimeout))
+		i--
+		dAtA[i] = 0x68
+	}
+	{
+		size, err := m.MinPostFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if m.MaxBodyBytes != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxBodyBytes))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.MaxTxMsgs != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxTxMsgs))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.PostQuotaAllowlist) > 0 {
+		for iNdEx := len(m.PostQuotaAllowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PostQuotaAllowlist[iNdEx])
+			copy(dAtA[i:], m.PostQuotaAllowlist[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.PostQuotaAllowlist[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.PostQuotaWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PostQuotaWindow))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.PostQuota != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PostQuota))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.UpdateGasPerByte != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.UpdateGasPerByte))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.CreateGasPerByte != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.CreateGasPerByte))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TrashRetention != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.TrashRetention))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ChangeProposalTtl != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ChangeProposalTtl))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxFollowing != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxFollowing))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxBatchSize != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxBatchSize))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -141,6 +413,47 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.MaxBatchSize != 0 {
+		n += 1 + sovParams(uint64(m.MaxBatchSize))
+	}
+	if m.MaxFollowing != 0 {
+		n += 1 + sovParams(uint64(m.MaxFollowing))
+	}
+	if m.ChangeProposalTtl != 0 {
+		n += 1 + sovParams(uint64(m.ChangeProposalTtl))
+	}
+	if m.TrashRetention != 0 {
+		n += 1 + sovParams(uint64(m.TrashRetention))
+	}
+	if m.CreateGasPerByte != 0 {
+		n += 1 + sovParams(uint64(m.CreateGasPerByte))
+	}
+	if m.UpdateGasPerByte != 0 {
+		n += 1 + sovParams(uint64(m.UpdateGasPerByte))
+	}
+	if m.PostQuota != 0 {
+		n += 1 + sovParams(uint64(m.PostQuota))
+	}
+	if m.PostQuotaWindow != 0 {
+		n += 1 + sovParams(uint64(m.PostQuotaWindow))
+	}
+	if len(m.PostQuotaAllowlist) > 0 {
+		for _, s := range m.PostQuotaAllowlist {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.MaxTxMsgs != 0 {
+		n += 1 + sovParams(uint64(m.MaxTxMsgs))
+	}
+	if m.MaxBodyBytes != 0 {
+		n += 1 + sovParams(uint64(m.MaxBodyBytes))
+	}
+	l = m.MinPostFee.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.PurchaseRefundTimeout != 0 {
+		n += 1 + sovParams(uint64(m.PurchaseRefundTimeout))
+	}
 	return n
 }
 
@@ -179,6 +492,280 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBatchSize", wireType)
+			}
+			m.MaxBatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBatchSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFollowing", wireType)
+			}
+			m.MaxFollowing = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxFollowing |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeProposalTtl", wireType)
+			}
+			m.ChangeProposalTtl = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChangeProposalTtl |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrashRetention", wireType)
+			}
+			m.TrashRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TrashRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateGasPerByte", wireType)
+			}
+			m.CreateGasPerByte = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreateGasPerByte |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateGasPerByte", wireType)
+			}
+			m.UpdateGasPerByte = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdateGasPerByte |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostQuota", wireType)
+			}
+			m.PostQuota = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PostQuota |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostQuotaWindow", wireType)
+			}
+			m.PostQuotaWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PostQuotaWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostQuotaAllowlist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PostQuotaAllowlist = append(m.PostQuotaAllowlist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTxMsgs", wireType)
+			}
+			m.MaxTxMsgs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTxMsgs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBodyBytes", wireType)
+			}
+			m.MaxBodyBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBodyBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPostFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinPostFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PurchaseRefundTimeout", wireType)
+			}
+			m.PurchaseRefundTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PurchaseRefundTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -0,0 +1,65 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"unicode/utf8"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxPatchOps is the maximum number of operations in a MsgPatchPost.
+const MaxPatchOps = 256
+
+// PostRevision returns the revision hash of the content of a post: the hex
+// encoded SHA-256 of its title, a zero byte and its body.
+func PostRevision(post Post) string {
+	h := sha256.New()
+	h.Write([]byte(post.Title))
+	h.Write([]byte{0})
+	h.Write([]byte(post.Body))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// ValidatePatchOps checks that operations are sorted and do not overlap.
+func ValidatePatchOps(ops []PatchOp) error {
+	if len(ops) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "missing patch operations")
+	}
+	if len(ops) > MaxPatchOps {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "a patch has at most %d operations", MaxPatchOps)
+	}
+	for i, op := range ops {
+		if op.End < op.Start {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "op %d: end %d is before start %d", i, op.End, op.Start)
+		}
+		if i > 0 && op.Start < ops[i-1].End {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "op %d overlaps or precedes op %d", i, i-1)
+		}
+	}
+	return nil
+}
+
+// ApplyPatch applies validated operations to body. Ranges past the end of
+// body or results that are not valid UTF-8 are rejected.
+func ApplyPatch(body string, ops []PatchOp) (string, error) {
+	var b strings.Builder
+	pos := uint64(0)
+	for i, op := range ops {
+		if op.End > uint64(len(body)) {
+			return "", errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "op %d: range [%d, %d) is past the end of the body", i, op.Start, op.End)
+		}
+		b.WriteString(body[pos:op.Start])
+		b.WriteString(op.Text)
+		pos = op.End
+	}
+	b.WriteString(body[pos:])
+
+	patched := b.String()
+	if !utf8.ValidString(patched) {
+		return "", errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "patch splits a multi-byte character")
+	}
+	return patched, nil
+}
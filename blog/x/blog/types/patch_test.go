@@ -0,0 +1,121 @@
+package types
+
+import (
+	"testing"
+
+	"blog/testutil/sample"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyPatch(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		ops  []PatchOp
+		want string
+		err  error
+	}{
+		{
+			name: "replace",
+			body: "hello world",
+			ops:  []PatchOp{{Start: 6, End: 11, Text: "cosmos"}},
+			want: "hello cosmos",
+		}, {
+			name: "insert and delete",
+			body: "hello world",
+			ops:  []PatchOp{{Start: 0, End: 0, Text: "> "}, {Start: 5, End: 11}},
+			want: "> hello",
+		}, {
+			name: "append",
+			body: "hello",
+			ops:  []PatchOp{{Start: 5, End: 5, Text: "!"}},
+			want: "hello!",
+		}, {
+			name: "past the end",
+			body: "hello",
+			ops:  []PatchOp{{Start: 3, End: 6}},
+			err:  sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "split rune",
+			body: "héllo",
+			ops:  []PatchOp{{Start: 2, End: 3}},
+			err:  sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ApplyPatch(tt.body, tt.ops)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestMsgPatchPost_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgPatchPost
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgPatchPost{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "missing revision",
+			msg: MsgPatchPost{
+				Creator: sample.AccAddress(),
+				Ops:     []PatchOp{{Start: 0, End: 1}},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "missing ops",
+			msg: MsgPatchPost{
+				Creator:          sample.AccAddress(),
+				ExpectedRevision: "abc",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "inverted range",
+			msg: MsgPatchPost{
+				Creator:          sample.AccAddress(),
+				Ops:              []PatchOp{{Start: 2, End: 1}},
+				ExpectedRevision: "abc",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "overlapping ops",
+			msg: MsgPatchPost{
+				Creator:          sample.AccAddress(),
+				Ops:              []PatchOp{{Start: 0, End: 3}, {Start: 2, End: 4}},
+				ExpectedRevision: "abc",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid address",
+			msg: MsgPatchPost{
+				Creator:          sample.AccAddress(),
+				Ops:              []PatchOp{{Start: 0, End: 3}, {Start: 3, End: 3, Text: "x"}},
+				ExpectedRevision: "abc",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
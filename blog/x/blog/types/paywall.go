@@ -0,0 +1,81 @@
+package types
+
+import (
+	"net/url"
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// MaxSummaryLength is the maximum length of the summary of a post.
+	MaxSummaryLength = 500
+	// MaxBodyURILength is the maximum length of the body URI of a post.
+	MaxBodyURILength = 256
+)
+
+// bodyURISchemes are the schemes of the body URIs, those of content stored
+// off chain for good
+var bodyURISchemes = []string{"https", "ipfs", "ar"}
+
+// ValidateSummary checks the length of the summary of a post
+func ValidateSummary(summary string) error {
+	if len(summary) > MaxSummaryLength {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "summary is longer than %d characters", MaxSummaryLength)
+	}
+	return nil
+}
+
+// ValidatePrice checks that price is a sorted set of positive coins. An empty
+// price is valid and means the post is not for sale.
+func ValidatePrice(price sdk.Coins) error {
+	if err := price.Validate(); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid price (%s)", err)
+	}
+	return nil
+}
+
+// ValidatePostPrice checks the price of a post. Only encrypted posts can be
+// sold, since the body of other posts is public.
+func ValidatePostPrice(format BodyFormat, price sdk.Coins) error {
+	if err := ValidatePrice(price); err != nil {
+		return err
+	}
+	if !price.Empty() && format != BodyFormat_BODY_FORMAT_ENCRYPTED {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "only encrypted posts have a price")
+	}
+	return nil
+}
+
+// ValidateBodyURI checks that uri is an absolute https, ipfs or ar URI no
+// longer than MaxBodyURILength
+func ValidateBodyURI(uri string) error {
+	if len(uri) > MaxBodyURILength {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "body URI is longer than %d characters", MaxBodyURILength)
+	}
+	u, err := url.Parse(uri)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid body URI (%s)", err)
+	}
+	if !slices.Contains(bodyURISchemes, u.Scheme) || (u.Host == "" && u.Opaque == "") {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "body URI %q is not an absolute %v URI", uri, bodyURISchemes)
+	}
+	return nil
+}
+
+// ValidateEncryptedContent checks the ciphertext of an encrypted body and its
+// optional URI. The body may only be empty when the URI is set.
+func ValidateEncryptedContent(body string, bodyURI string) error {
+	if bodyURI == "" {
+		return validateEncryptedBody(body)
+	}
+	if err := ValidateBodyURI(bodyURI); err != nil {
+		return err
+	}
+	if body == "" {
+		return nil
+	}
+	return validateEncryptedBody(body)
+}
@@ -0,0 +1,73 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Validate checks the fields of a stored post that do not depend on other
+// posts
+func (p Post) Validate() error {
+	if p.Id == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "post ID must be positive")
+	}
+	if _, err := sdk.AccAddressFromBech32(p.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if len(p.Title) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "missing title")
+	}
+	if p.Slug != "" {
+		if err := ValidateSlug(p.Slug); err != nil {
+			return err
+		}
+	}
+
+	hasCreator := false
+	for _, editor := range p.Editors {
+		if _, err := sdk.AccAddressFromBech32(editor); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid editor address (%s)", err)
+		}
+		hasCreator = hasCreator || editor == p.Creator
+	}
+	if !hasCreator {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "the creator must be an editor")
+	}
+
+	if p.BodyFormat == BodyFormat_BODY_FORMAT_ENCRYPTED {
+		if err := ValidateEncryptedContent(p.Body, p.BodyUri); err != nil {
+			return err
+		}
+	} else if p.BodyUri != "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "only encrypted posts have a body URI")
+	} else if err := ValidateBody(p.BodyFormat, p.Body); err != nil {
+		return err
+	}
+	if err := ValidatePostEncryption(p.Creator, p.BodyFormat, p.KeyEnvelopes); err != nil {
+		return err
+	}
+	if err := ValidateSummary(p.Summary); err != nil {
+		return err
+	}
+	if err := ValidateTags(p.Tags); err != nil {
+		return err
+	}
+	if err := ValidatePostPrice(p.BodyFormat, p.Price); err != nil {
+		return err
+	}
+
+	return ValidateCoAuthors(p.CoAuthors, p.ApprovalThreshold)
+}
+
+// ValidatePostEncryption checks that a post has key envelopes if and only if
+// its body is encrypted, and that the envelopes are valid
+func ValidatePostEncryption(creator string, format BodyFormat, envelopes []KeyEnvelope) error {
+	if format != BodyFormat_BODY_FORMAT_ENCRYPTED {
+		if len(envelopes) > 0 {
+			return errorsmod.Wrap(ErrInvalidKeyEnvelope, "only encrypted posts have key envelopes")
+		}
+		return nil
+	}
+	return ValidateKeyEnvelopes(envelopes, creator)
+}
@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -28,6 +30,120 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// BodyFormat tells how the body of a post is written and rendered.
+type BodyFormat int32
+
+const (
+	// BODY_FORMAT_PLAIN bodies are plain text.
+	BodyFormat_BODY_FORMAT_PLAIN BodyFormat = 0
+	// BODY_FORMAT_MARKDOWN bodies are CommonMark with the common extensions.
+	BodyFormat_BODY_FORMAT_MARKDOWN BodyFormat = 1
+	// BODY_FORMAT_HTML_SANITIZED bodies are HTML that the post sanitizer leaves
+	// unchanged.
+	BodyFormat_BODY_FORMAT_HTML_SANITIZED BodyFormat = 2
+	// BODY_FORMAT_ENCRYPTED bodies are the base64 ciphertext of the body,
+	// encrypted with a content key that the key envelopes of the post wrap for
+	// each reader.
+	BodyFormat_BODY_FORMAT_ENCRYPTED BodyFormat = 3
+)
+
+var BodyFormat_name = map[int32]string{
+	0: "BODY_FORMAT_PLAIN",
+	1: "BODY_FORMAT_MARKDOWN",
+	2: "BODY_FORMAT_HTML_SANITIZED",
+	3: "BODY_FORMAT_ENCRYPTED",
+}
+
+var BodyFormat_value = map[string]int32{
+	"BODY_FORMAT_PLAIN":          0,
+	"BODY_FORMAT_MARKDOWN":       1,
+	"BODY_FORMAT_HTML_SANITIZED": 2,
+	"BODY_FORMAT_ENCRYPTED":      3,
+}
+
+func (x BodyFormat) String() string {
+	return proto.EnumName(BodyFormat_name, int32(x))
+}
+
+func (BodyFormat) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8f060607f92e3b72, []int{0}
+}
+
+// KeyEnvelope is the content key of an encrypted post wrapped for one reader.
+// The key is sealed with AES-256-GCM under a key derived from the ECDH secret
+// of an ephemeral secp256k1 key and the public key of the reader.
+type KeyEnvelope struct {
+	Reader string `protobuf:"bytes,1,opt,name=reader,proto3" json:"reader,omitempty"`
+	// reader_pub_key is the compressed secp256k1 public key of the reader, kept
+	// so that the content key can be wrapped again when it is rotated.
+	ReaderPubKey []byte `protobuf:"bytes,2,opt,name=reader_pub_key,json=readerPubKey,proto3" json:"reader_pub_key,omitempty"`
+	// ephemeral_pub_key is the compressed public key of the ephemeral key.
+	EphemeralPubKey []byte `protobuf:"bytes,3,opt,name=ephemeral_pub_key,json=ephemeralPubKey,proto3" json:"ephemeral_pub_key,omitempty"`
+	// sealed_key is the nonce followed by the sealed content key.
+	SealedKey []byte `protobuf:"bytes,4,opt,name=sealed_key,json=sealedKey,proto3" json:"sealed_key,omitempty"`
+}
+
+func (m *KeyEnvelope) Reset()         { *m = KeyEnvelope{} }
+func (m *KeyEnvelope) String() string { return proto.CompactTextString(m) }
+func (*KeyEnvelope) ProtoMessage()    {}
+func (*KeyEnvelope) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f060607f92e3b72, []int{0}
+}
+func (m *KeyEnvelope) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KeyEnvelope) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_KeyEnvelope.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *KeyEnvelope) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyEnvelope.Merge(m, src)
+}
+func (m *KeyEnvelope) XXX_Size() int {
+	return m.Size()
+}
+func (m *KeyEnvelope) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyEnvelope.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KeyEnvelope proto.InternalMessageInfo
+
+func (m *KeyEnvelope) GetReader() string {
+	if m != nil {
+		return m.Reader
+	}
+	return ""
+}
+
+func (m *KeyEnvelope) GetReaderPubKey() []byte {
+	if m != nil {
+		return m.ReaderPubKey
+	}
+	return nil
+}
+
+func (m *KeyEnvelope) GetEphemeralPubKey() []byte {
+	if m != nil {
+		return m.EphemeralPubKey
+	}
+	return nil
+}
+
+func (m *KeyEnvelope) GetSealedKey() []byte {
+	if m != nil {
+		return m.SealedKey
+	}
+	return nil
+}
+
 type Post struct {
 	Title         string    `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
 	Body          string    `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
@@ -36,13 +152,38 @@ type Post struct {
 	CreatedAt     time.Time `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at"`
 	LastUpdatedAt time.Time `protobuf:"bytes,6,opt,name=last_updated_at,json=lastUpdatedAt,proto3,stdtime" json:"last_updated_at"`
 	Editors       []string  `protobuf:"bytes,7,rep,name=editors,proto3" json:"editors,omitempty"`
+	// slug is the unique, human-readable identifier of the post in URLs.
+	Slug string `protobuf:"bytes,8,opt,name=slug,proto3" json:"slug,omitempty"`
+	// co_authors must approve changes to the title and body once
+	// approval_threshold is set. Editors cannot update the post directly then.
+	CoAuthors         []string `protobuf:"bytes,9,rep,name=co_authors,json=coAuthors,proto3" json:"co_authors,omitempty"`
+	ApprovalThreshold uint32   `protobuf:"varint,10,opt,name=approval_threshold,json=approvalThreshold,proto3" json:"approval_threshold,omitempty"`
+	// version starts at 1 and is incremented on every write of the post.
+	Version    uint64     `protobuf:"varint,11,opt,name=version,proto3" json:"version,omitempty"`
+	BodyFormat BodyFormat `protobuf:"varint,12,opt,name=body_format,json=bodyFormat,proto3,enum=blog.blog.BodyFormat" json:"body_format,omitempty"`
+	// key_envelopes hold the content key of encrypted posts for each address
+	// allowed to read them.
+	KeyEnvelopes []KeyEnvelope `protobuf:"bytes,13,rep,name=key_envelopes,json=keyEnvelopes,proto3" json:"key_envelopes"`
+	// price is what MsgPurchaseAccess pays the creator for a key envelope of
+	// an encrypted post. Posts without a price are not for sale.
+	Price github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,14,rep,name=price,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"price"`
+	// summary is a public teaser of the post. Unlike the body, it stays
+	// readable and searchable when the body is encrypted.
+	Summary string `protobuf:"bytes,15,opt,name=summary,proto3" json:"summary,omitempty"`
+	// tags are lower-case topics of the post, used to list and subscribe to
+	// the posts of a topic.
+	Tags []string `protobuf:"bytes,16,rep,name=tags,proto3" json:"tags,omitempty"`
+	// body_uri points to the ciphertext of an encrypted body stored off chain,
+	// e.g. on IPFS. The body is then empty; a body set on chain takes
+	// precedence.
+	BodyUri string `protobuf:"bytes,17,opt,name=body_uri,json=bodyUri,proto3" json:"body_uri,omitempty"`
 }
 
 func (m *Post) Reset()         { *m = Post{} }
 func (m *Post) String() string { return proto.CompactTextString(m) }
 func (*Post) ProtoMessage()    {}
 func (*Post) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8f060607f92e3b72, []int{0}
+	return fileDescriptor_8f060607f92e3b72, []int{1}
 }
 func (m *Post) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -120,33 +261,181 @@ func (m *Post) GetEditors() []string {
 	return nil
 }
 
+func (m *Post) GetSlug() string {
+	if m != nil {
+		return m.Slug
+	}
+	return ""
+}
+
+func (m *Post) GetCoAuthors() []string {
+	if m != nil {
+		return m.CoAuthors
+	}
+	return nil
+}
+
+func (m *Post) GetApprovalThreshold() uint32 {
+	if m != nil {
+		return m.ApprovalThreshold
+	}
+	return 0
+}
+
+func (m *Post) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *Post) GetBodyFormat() BodyFormat {
+	if m != nil {
+		return m.BodyFormat
+	}
+	return BodyFormat_BODY_FORMAT_PLAIN
+}
+
+func (m *Post) GetKeyEnvelopes() []KeyEnvelope {
+	if m != nil {
+		return m.KeyEnvelopes
+	}
+	return nil
+}
+
+func (m *Post) GetPrice() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Price
+	}
+	return nil
+}
+
+func (m *Post) GetSummary() string {
+	if m != nil {
+		return m.Summary
+	}
+	return ""
+}
+
+func (m *Post) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *Post) GetBodyUri() string {
+	if m != nil {
+		return m.BodyUri
+	}
+	return ""
+}
+
 func init() {
+	proto.RegisterEnum("blog.blog.BodyFormat", BodyFormat_name, BodyFormat_value)
+	proto.RegisterType((*KeyEnvelope)(nil), "blog.blog.KeyEnvelope")
 	proto.RegisterType((*Post)(nil), "blog.blog.Post")
 }
 
 func init() { proto.RegisterFile("blog/blog/post.proto", fileDescriptor_8f060607f92e3b72) }
 
 var fileDescriptor_8f060607f92e3b72 = []byte{
-	// 298 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x51, 0xb1, 0x4e, 0xc3, 0x30,
-	0x14, 0x8c, 0xd3, 0xb4, 0xa5, 0x46, 0x80, 0x6a, 0x75, 0xb0, 0x3a, 0xb8, 0x11, 0x53, 0x25, 0xa4,
-	0x44, 0x82, 0x2f, 0x68, 0x59, 0x19, 0x50, 0x05, 0x0b, 0x4b, 0x95, 0x60, 0x13, 0x59, 0x4a, 0x79,
-	0x51, 0xfc, 0x2a, 0xd1, 0x2f, 0x60, 0xed, 0x67, 0x75, 0xec, 0xc8, 0x04, 0xa8, 0xf9, 0x11, 0x64,
-	0x3b, 0xf9, 0x00, 0x96, 0xd3, 0xdd, 0x3d, 0xdf, 0xf3, 0x49, 0x8f, 0x4e, 0xf2, 0x12, 0x8a, 0xd4,
-	0x41, 0x05, 0x06, 0x93, 0xaa, 0x06, 0x04, 0x36, 0xb2, 0x46, 0x62, 0x61, 0x3a, 0x2b, 0x00, 0x8a,
-	0x52, 0xa5, 0x6e, 0x90, 0x6f, 0xdf, 0x52, 0xd4, 0x1b, 0x65, 0x30, 0xdb, 0x54, 0xfe, 0xed, 0x74,
-	0x52, 0x40, 0x01, 0x8e, 0xa6, 0x96, 0xb5, 0xee, 0x38, 0xdb, 0xe8, 0x77, 0x48, 0x1d, 0x7a, 0xeb,
-	0xfa, 0x33, 0xa4, 0xd1, 0x23, 0x18, 0x64, 0x13, 0xda, 0x47, 0x8d, 0xa5, 0xe2, 0x24, 0x26, 0xf3,
-	0xd1, 0xca, 0x0b, 0xc6, 0x68, 0x94, 0x83, 0xdc, 0xf1, 0xd0, 0x99, 0x8e, 0x33, 0x4e, 0x87, 0xaf,
-	0xb5, 0xca, 0x10, 0x6a, 0xde, 0x73, 0x76, 0x27, 0xd9, 0x25, 0x0d, 0xb5, 0xe4, 0x51, 0x4c, 0xe6,
-	0xd1, 0x2a, 0xd4, 0x92, 0xdd, 0x53, 0xea, 0x46, 0x4a, 0xae, 0x33, 0xe4, 0xfd, 0x98, 0xcc, 0xcf,
-	0x6f, 0xa7, 0x89, 0xef, 0x9e, 0x74, 0xdd, 0x93, 0xa7, 0xae, 0xfb, 0xf2, 0xec, 0xf0, 0x3d, 0x0b,
-	0xf6, 0x3f, 0x33, 0xb2, 0x1a, 0xb5, 0xb9, 0x05, 0xb2, 0x07, 0x7a, 0x55, 0x66, 0x06, 0xd7, 0xdb,
-	0x4a, 0x76, 0x9b, 0x06, 0xff, 0xd8, 0x74, 0x61, 0xc3, 0xcf, 0x3e, 0xbb, 0x40, 0x5b, 0x5e, 0x49,
-	0x8d, 0x50, 0x1b, 0x3e, 0x8c, 0x7b, 0xb6, 0x7c, 0x2b, 0x97, 0x37, 0x87, 0x93, 0x20, 0xc7, 0x93,
-	0x20, 0xbf, 0x27, 0x41, 0xf6, 0x8d, 0x08, 0x8e, 0x8d, 0x08, 0xbe, 0x1a, 0x11, 0xbc, 0x8c, 0xdd,
-	0x25, 0x3e, 0xfc, 0x41, 0x70, 0x57, 0x29, 0x93, 0x0f, 0xdc, 0x9f, 0x77, 0x7f, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0x92, 0x62, 0x54, 0x27, 0xaa, 0x01, 0x00, 0x00,
+	// 704 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcb, 0x4e, 0xf3, 0x46,
+	0x18, 0x8d, 0x73, 0xe1, 0xc7, 0x93, 0x0b, 0xc9, 0x28, 0xfc, 0x32, 0x91, 0xea, 0x44, 0xa8, 0x8b,
+	0x88, 0x0a, 0xbb, 0x50, 0xa9, 0x7b, 0x87, 0x04, 0x15, 0x05, 0x42, 0xe4, 0x06, 0x55, 0xb0, 0xb1,
+	0xc6, 0xf1, 0xe0, 0x58, 0xb1, 0x33, 0x96, 0x67, 0x1c, 0xe1, 0xb7, 0x60, 0xd5, 0x87, 0xe0, 0x49,
+	0x58, 0xb2, 0xec, 0xaa, 0x54, 0xf0, 0x22, 0xd5, 0xcc, 0x38, 0x21, 0xdb, 0x7f, 0x33, 0xfe, 0xbe,
+	0x73, 0xbe, 0x33, 0x97, 0xa3, 0x23, 0x83, 0xb6, 0x1b, 0x12, 0xdf, 0x14, 0x4b, 0x4c, 0x28, 0x33,
+	0xe2, 0x84, 0x30, 0x02, 0x55, 0x0e, 0x18, 0x7c, 0xe9, 0x74, 0x7d, 0x42, 0xfc, 0x10, 0x9b, 0x82,
+	0x70, 0xd3, 0x47, 0x93, 0x05, 0x11, 0xa6, 0x0c, 0x45, 0xb1, 0x9c, 0xed, 0xb4, 0x7d, 0xe2, 0x13,
+	0x51, 0x9a, 0xbc, 0xca, 0xd1, 0x16, 0x8a, 0x82, 0x15, 0x31, 0xc5, 0x9a, 0x43, 0xfa, 0x9c, 0xd0,
+	0x88, 0x50, 0xd3, 0x45, 0x14, 0x9b, 0xeb, 0x33, 0x17, 0x33, 0x74, 0x66, 0xce, 0x49, 0xb0, 0x92,
+	0xfc, 0xf1, 0xdf, 0x0a, 0xa8, 0x8e, 0x71, 0x36, 0x5a, 0xad, 0x71, 0x48, 0x62, 0x0c, 0xbf, 0x83,
+	0xbd, 0x04, 0x23, 0x0f, 0x27, 0x9a, 0xd2, 0x53, 0xfa, 0xaa, 0x9d, 0x77, 0xf0, 0x67, 0xd0, 0x90,
+	0x95, 0x13, 0xa7, 0xae, 0xb3, 0xc4, 0x99, 0x56, 0xec, 0x29, 0xfd, 0x9a, 0x5d, 0x93, 0xe8, 0x34,
+	0x75, 0xc7, 0x38, 0x83, 0x27, 0xa0, 0x85, 0xe3, 0x05, 0x8e, 0x70, 0x82, 0xc2, 0xed, 0x60, 0x49,
+	0x0c, 0x1e, 0x6c, 0x89, 0x7c, 0xf6, 0x27, 0x00, 0x28, 0x46, 0x21, 0xf6, 0xc4, 0x50, 0x59, 0x0c,
+	0xa9, 0x12, 0x19, 0xe3, 0xec, 0xf8, 0xa5, 0x02, 0xca, 0x53, 0x42, 0x19, 0x6c, 0x83, 0x0a, 0x0b,
+	0x58, 0x88, 0xf3, 0x0b, 0xc9, 0x06, 0x42, 0x50, 0x76, 0x89, 0x27, 0x6f, 0xa1, 0xda, 0xa2, 0x86,
+	0x1a, 0xf8, 0x36, 0x4f, 0x30, 0x62, 0x24, 0x11, 0x67, 0xaa, 0xf6, 0xa6, 0x85, 0x0d, 0x50, 0x0c,
+	0x3c, 0x71, 0x46, 0xd9, 0x2e, 0x06, 0x1e, 0xbc, 0x00, 0x40, 0x50, 0xd8, 0x73, 0x10, 0xd3, 0x2a,
+	0x3d, 0xa5, 0x5f, 0x3d, 0xef, 0x18, 0xd2, 0x74, 0x63, 0x63, 0xba, 0x31, 0xdb, 0x98, 0x3e, 0xd8,
+	0x7f, 0xfd, 0xb7, 0x5b, 0x78, 0x7e, 0xef, 0x2a, 0xb6, 0x9a, 0xeb, 0x2c, 0x06, 0xaf, 0xc1, 0x41,
+	0x88, 0x28, 0x73, 0xd2, 0xd8, 0xdb, 0xec, 0xb4, 0xf7, 0x03, 0x3b, 0xd5, 0xb9, 0xf8, 0x4e, 0x6a,
+	0x2d, 0xc6, 0x2f, 0x8f, 0xbd, 0x80, 0x91, 0x84, 0x6a, 0xdf, 0x7a, 0x25, 0x7e, 0xf9, 0xbc, 0xe5,
+	0x4f, 0xa5, 0x61, 0xea, 0x6b, 0xfb, 0xf2, 0xa9, 0xbc, 0xe6, 0xe6, 0xcd, 0x89, 0x83, 0x52, 0xb6,
+	0xe0, 0x02, 0x55, 0x08, 0xd4, 0x39, 0xb1, 0x24, 0x00, 0x4f, 0x01, 0x44, 0x71, 0x9c, 0x90, 0x35,
+	0x0a, 0x1d, 0xb6, 0x48, 0x30, 0x5d, 0x90, 0xd0, 0xd3, 0x40, 0x4f, 0xe9, 0xd7, 0xed, 0xd6, 0x86,
+	0x99, 0x6d, 0x08, 0x7e, 0xf6, 0x1a, 0x27, 0x34, 0x20, 0x2b, 0xad, 0x2a, 0x3c, 0xda, 0xb4, 0xf0,
+	0x77, 0x50, 0xe5, 0xd6, 0x3a, 0x8f, 0x24, 0x89, 0x10, 0xd3, 0x6a, 0x3d, 0xa5, 0xdf, 0x38, 0x3f,
+	0x34, 0xb6, 0x49, 0x35, 0x06, 0xc4, 0xcb, 0x2e, 0x05, 0x69, 0x03, 0x77, 0x5b, 0x43, 0x0b, 0xd4,
+	0x97, 0x38, 0x73, 0x70, 0x1e, 0x2b, 0xaa, 0xd5, 0x7b, 0xa5, 0x7e, 0xf5, 0xfc, 0xfb, 0x8e, 0x72,
+	0x27, 0x75, 0x83, 0x32, 0x77, 0xc5, 0xae, 0x2d, 0xbf, 0x20, 0x0a, 0x11, 0xa8, 0xc4, 0x49, 0x30,
+	0xc7, 0x5a, 0x43, 0x48, 0x8f, 0x0c, 0x99, 0x64, 0x83, 0x27, 0xd9, 0xc8, 0x93, 0x6c, 0x5c, 0x90,
+	0x60, 0x35, 0xf8, 0x95, 0xab, 0x5f, 0xde, 0xbb, 0x7d, 0x3f, 0x60, 0x8b, 0xd4, 0x35, 0xe6, 0x24,
+	0x32, 0xf3, 0xd8, 0xcb, 0xcf, 0x29, 0xf5, 0x96, 0x26, 0xcb, 0x62, 0x4c, 0x85, 0x80, 0xda, 0x72,
+	0x67, 0xfe, 0x6e, 0x9a, 0x46, 0x11, 0x4a, 0x32, 0xed, 0x40, 0x06, 0x26, 0x6f, 0xb9, 0xe7, 0x0c,
+	0xf9, 0x54, 0x6b, 0x0a, 0x67, 0x45, 0x0d, 0x8f, 0xc0, 0xbe, 0xf0, 0x22, 0x4d, 0x02, 0xad, 0x25,
+	0xc7, 0x79, 0x7f, 0x97, 0x04, 0x27, 0x4f, 0x00, 0x7c, 0x19, 0x01, 0x0f, 0x41, 0x6b, 0x70, 0x3b,
+	0xbc, 0x77, 0x2e, 0x6f, 0xed, 0x1b, 0x6b, 0xe6, 0x4c, 0xaf, 0xad, 0xab, 0x49, 0xb3, 0x00, 0x35,
+	0xd0, 0xde, 0x85, 0x6f, 0x2c, 0x7b, 0x3c, 0xbc, 0xfd, 0x6b, 0xd2, 0x54, 0xa0, 0x0e, 0x3a, 0xbb,
+	0xcc, 0x1f, 0xb3, 0x9b, 0x6b, 0xe7, 0x4f, 0x6b, 0x72, 0x35, 0xbb, 0x7a, 0x18, 0x0d, 0x9b, 0x45,
+	0x78, 0x04, 0x0e, 0x77, 0xf9, 0xd1, 0xe4, 0xc2, 0xbe, 0x9f, 0xce, 0x46, 0xc3, 0x66, 0x69, 0xf0,
+	0xcb, 0xeb, 0x87, 0xae, 0xbc, 0x7d, 0xe8, 0xca, 0x7f, 0x1f, 0xba, 0xf2, 0xfc, 0xa9, 0x17, 0xde,
+	0x3e, 0xf5, 0xc2, 0x3f, 0x9f, 0x7a, 0xe1, 0xa1, 0x25, 0xfe, 0x2f, 0x4f, 0xf2, 0x37, 0x23, 0x1e,
+	0xef, 0xee, 0x89, 0x40, 0xfe, 0xf6, 0xff, 0x00, 0xd1, 0x2b, 0x2f, 0x5e, 0x80, 0x04, 0x00, 0x00,
+}
+
+func (m *KeyEnvelope) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KeyEnvelope) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KeyEnvelope) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SealedKey) > 0 {
+		i -= len(m.SealedKey)
+		copy(dAtA[i:], m.SealedKey)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.SealedKey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.EphemeralPubKey) > 0 {
+		i -= len(m.EphemeralPubKey)
+		copy(dAtA[i:], m.EphemeralPubKey)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.EphemeralPubKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ReaderPubKey) > 0 {
+		i -= len(m.ReaderPubKey)
+		copy(dAtA[i:], m.ReaderPubKey)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.ReaderPubKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Reader) > 0 {
+		i -= len(m.Reader)
+		copy(dAtA[i:], m.Reader)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.Reader)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
 func (m *Post) Marshal() (dAtA []byte, err error) {
@@ -169,6 +458,92 @@ func (m *Post) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BodyUri) > 0 {
+		i -= len(m.BodyUri)
+		copy(dAtA[i:], m.BodyUri)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.BodyUri)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.Tags) > 0 {
+		for iNdEx := len(m.Tags) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tags[iNdEx])
+			copy(dAtA[i:], m.Tags[iNdEx])
+			i = encodeVarintPost(dAtA, i, uint64(len(m.Tags[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.Summary) > 0 {
+		i -= len(m.Summary)
+		copy(dAtA[i:], m.Summary)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.Summary)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.Price) > 0 {
+		for iNdEx := len(m.Price) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Price[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPost(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.KeyEnvelopes) > 0 {
+		for iNdEx := len(m.KeyEnvelopes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.KeyEnvelopes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPost(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.BodyFormat != 0 {
+		i = encodeVarintPost(dAtA, i, uint64(m.BodyFormat))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.Version != 0 {
+		i = encodeVarintPost(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.ApprovalThreshold != 0 {
+		i = encodeVarintPost(dAtA, i, uint64(m.ApprovalThreshold))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.CoAuthors) > 0 {
+		for iNdEx := len(m.CoAuthors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CoAuthors[iNdEx])
+			copy(dAtA[i:], m.CoAuthors[iNdEx])
+			i = encodeVarintPost(dAtA, i, uint64(len(m.CoAuthors[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Slug) > 0 {
+		i -= len(m.Slug)
+		copy(dAtA[i:], m.Slug)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.Slug)))
+		i--
+		dAtA[i] = 0x42
+	}
 	if len(m.Editors) > 0 {
 		for iNdEx := len(m.Editors) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Editors[iNdEx])
@@ -234,6 +609,31 @@ func encodeVarintPost(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
+func (m *KeyEnvelope) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Reader)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	l = len(m.ReaderPubKey)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	l = len(m.EphemeralPubKey)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	l = len(m.SealedKey)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	return n
+}
+
 func (m *Post) Size() (n int) {
 	if m == nil {
 		return 0
@@ -265,6 +665,51 @@ func (m *Post) Size() (n int) {
 			n += 1 + l + sovPost(uint64(l))
 		}
 	}
+	l = len(m.Slug)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	if len(m.CoAuthors) > 0 {
+		for _, s := range m.CoAuthors {
+			l = len(s)
+			n += 1 + l + sovPost(uint64(l))
+		}
+	}
+	if m.ApprovalThreshold != 0 {
+		n += 1 + sovPost(uint64(m.ApprovalThreshold))
+	}
+	if m.Version != 0 {
+		n += 1 + sovPost(uint64(m.Version))
+	}
+	if m.BodyFormat != 0 {
+		n += 1 + sovPost(uint64(m.BodyFormat))
+	}
+	if len(m.KeyEnvelopes) > 0 {
+		for _, e := range m.KeyEnvelopes {
+			l = e.Size()
+			n += 1 + l + sovPost(uint64(l))
+		}
+	}
+	if len(m.Price) > 0 {
+		for _, e := range m.Price {
+			l = e.Size()
+			n += 1 + l + sovPost(uint64(l))
+		}
+	}
+	l = len(m.Summary)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	if len(m.Tags) > 0 {
+		for _, s := range m.Tags {
+			l = len(s)
+			n += 2 + l + sovPost(uint64(l))
+		}
+	}
+	l = len(m.BodyUri)
+	if l > 0 {
+		n += 2 + l + sovPost(uint64(l))
+	}
 	return n
 }
 
@@ -274,7 +719,7 @@ func sovPost(x uint64) (n int) {
 func sozPost(x uint64) (n int) {
 	return sovPost(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *Post) Unmarshal(dAtA []byte) error {
+func (m *KeyEnvelope) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -297,15 +742,15 @@ func (m *Post) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Post: wiretype end group for non-group")
+			return fmt.Errorf("proto: KeyEnvelope: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Post: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KeyEnvelope: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reader", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -333,13 +778,13 @@ func (m *Post) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.Reader = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReaderPubKey", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPost
@@ -349,29 +794,31 @@ func (m *Post) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPost
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPost
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Body = string(dAtA[iNdEx:postIndex])
+			m.ReaderPubKey = append(m.ReaderPubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.ReaderPubKey == nil {
+				m.ReaderPubKey = []byte{}
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EphemeralPubKey", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPost
@@ -381,48 +828,31 @@ func (m *Post) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPost
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPost
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.EphemeralPubKey = append(m.EphemeralPubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.EphemeralPubKey == nil {
+				m.EphemeralPubKey = []byte{}
+			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPost
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SealedKey", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPost
@@ -432,23 +862,222 @@ func (m *Post) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPost
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPost
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.SealedKey = append(m.SealedKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.SealedKey == nil {
+				m.SealedKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Post) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Post: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Post: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Body = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 6:
@@ -516,6 +1145,291 @@ func (m *Post) Unmarshal(dAtA []byte) error {
 			}
 			m.Editors = append(m.Editors, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slug", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Slug = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoAuthors", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CoAuthors = append(m.CoAuthors, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovalThreshold", wireType)
+			}
+			m.ApprovalThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApprovalThreshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BodyFormat", wireType)
+			}
+			m.BodyFormat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BodyFormat |= BodyFormat(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyEnvelopes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyEnvelopes = append(m.KeyEnvelopes, KeyEnvelope{})
+			if err := m.KeyEnvelopes[len(m.KeyEnvelopes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = append(m.Price, types.Coin{})
+			if err := m.Price[len(m.Price)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Summary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tags = append(m.Tags, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BodyUri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BodyUri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPost(dAtA[iNdEx:])
@@ -0,0 +1,879 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: blog/blog/post_change.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PostChangeKind is what a change proposal does to a co-authored post.
+type PostChangeKind int32
+
+const (
+	// POST_CHANGE_KIND_CONTENT replaces the title and body.
+	PostChangeKind_POST_CHANGE_KIND_CONTENT PostChangeKind = 0
+	// POST_CHANGE_KIND_CO_AUTHORS replaces the co-authors and approval
+	// threshold. A zero threshold with no co-authors ends co-authorship.
+	PostChangeKind_POST_CHANGE_KIND_CO_AUTHORS PostChangeKind = 1
+	// POST_CHANGE_KIND_DELETE moves the post to the trash.
+	PostChangeKind_POST_CHANGE_KIND_DELETE PostChangeKind = 2
+)
+
+var PostChangeKind_name = map[int32]string{
+	0: "POST_CHANGE_KIND_CONTENT",
+	1: "POST_CHANGE_KIND_CO_AUTHORS",
+	2: "POST_CHANGE_KIND_DELETE",
+}
+
+var PostChangeKind_value = map[string]int32{
+	"POST_CHANGE_KIND_CONTENT":    0,
+	"POST_CHANGE_KIND_CO_AUTHORS": 1,
+	"POST_CHANGE_KIND_DELETE":     2,
+}
+
+func (x PostChangeKind) String() string {
+	return proto.EnumName(PostChangeKind_name, int32(x))
+}
+
+func (PostChangeKind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_fc6e6f6ec1a6484f, []int{0}
+}
+
+// PostChangeProposal is a pending change to a co-authored post. It is applied
+// once approvals reaches the approval threshold of the post.
+type PostChangeProposal struct {
+	Id       uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PostId   uint64 `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Proposer string `protobuf:"bytes,3,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	Title    string `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Body     string `protobuf:"bytes,5,opt,name=body,proto3" json:"body,omitempty"`
+	// approvals lists the co-authors that approved the change, starting with
+	// the proposer.
+	Approvals []string  `protobuf:"bytes,6,rep,name=approvals,proto3" json:"approvals,omitempty"`
+	CreatedAt time.Time `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at"`
+	// expires_at is when the proposal is dropped if still pending. It is unset
+	// when proposals do not expire.
+	ExpiresAt *time.Time     `protobuf:"bytes,8,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at,omitempty"`
+	Kind      PostChangeKind `protobuf:"varint,9,opt,name=kind,proto3,enum=blog.blog.PostChangeKind" json:"kind,omitempty"`
+	// co_authors and approval_threshold replace those of the post for
+	// POST_CHANGE_KIND_CO_AUTHORS proposals.
+	CoAuthors         []string `protobuf:"bytes,10,rep,name=co_authors,json=coAuthors,proto3" json:"co_authors,omitempty"`
+	ApprovalThreshold uint32   `protobuf:"varint,11,opt,name=approval_threshold,json=approvalThreshold,proto3" json:"approval_threshold,omitempty"`
+	// base_version is the version of the post the change was proposed
+	// against. The proposal is not applied once the post has changed since.
+	BaseVersion uint64 `protobuf:"varint,12,opt,name=base_version,json=baseVersion,proto3" json:"base_version,omitempty"`
+}
+
+func (m *PostChangeProposal) Reset()         { *m = PostChangeProposal{} }
+func (m *PostChangeProposal) String() string { return proto.CompactTextString(m) }
+func (*PostChangeProposal) ProtoMessage()    {}
+func (*PostChangeProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc6e6f6ec1a6484f, []int{0}
+}
+func (m *PostChangeProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PostChangeProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PostChangeProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PostChangeProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PostChangeProposal.Merge(m, src)
+}
+func (m *PostChangeProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *PostChangeProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_PostChangeProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PostChangeProposal proto.InternalMessageInfo
+
+func (m *PostChangeProposal) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *PostChangeProposal) GetPostId() uint64 {
+	if m != nil {
+		return m.PostId
+	}
+	return 0
+}
+
+func (m *PostChangeProposal) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
+func (m *PostChangeProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *PostChangeProposal) GetBody() string {
+	if m != nil {
+		return m.Body
+	}
+	return ""
+}
+
+func (m *PostChangeProposal) GetApprovals() []string {
+	if m != nil {
+		return m.Approvals
+	}
+	return nil
+}
+
+func (m *PostChangeProposal) GetCreatedAt() time.Time {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return time.Time{}
+}
+
+func (m *PostChangeProposal) GetExpiresAt() *time.Time {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
+func (m *PostChangeProposal) GetKind() PostChangeKind {
+	if m != nil {
+		return m.Kind
+	}
+	return PostChangeKind_POST_CHANGE_KIND_CONTENT
+}
+
+func (m *PostChangeProposal) GetCoAuthors() []string {
+	if m != nil {
+		return m.CoAuthors
+	}
+	return nil
+}
+
+func (m *PostChangeProposal) GetApprovalThreshold() uint32 {
+	if m != nil {
+		return m.ApprovalThreshold
+	}
+	return 0
+}
+
+func (m *PostChangeProposal) GetBaseVersion() uint64 {
+	if m != nil {
+		return m.BaseVersion
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("blog.blog.PostChangeKind", PostChangeKind_name, PostChangeKind_value)
+	proto.RegisterType((*PostChangeProposal)(nil), "blog.blog.PostChangeProposal")
+}
+
+func init() { proto.RegisterFile("blog/blog/post_change.proto", fileDescriptor_fc6e6f6ec1a6484f) }
+
+var fileDescriptor_fc6e6f6ec1a6484f = []byte{
+	// 476 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xc1, 0x4e, 0xdb, 0x40,
+	0x10, 0x86, 0xb3, 0xc1, 0x84, 0x78, 0x42, 0xa3, 0x30, 0x42, 0x62, 0x9b, 0x50, 0xc7, 0xed, 0xc9,
+	0x6a, 0x85, 0x23, 0xd1, 0x07, 0xa8, 0x4c, 0xb0, 0x0a, 0xa2, 0x4a, 0x22, 0xe3, 0xf6, 0xd0, 0x8b,
+	0x65, 0xc7, 0x5b, 0xc7, 0xaa, 0xc9, 0x5a, 0xde, 0x05, 0xc1, 0x5b, 0xf0, 0x56, 0xe5, 0xc8, 0xb1,
+	0xa7, 0xb6, 0x4a, 0x5e, 0xa4, 0xf2, 0x1a, 0x83, 0xaa, 0x56, 0xea, 0x65, 0x35, 0xf3, 0xfd, 0x33,
+	0x63, 0xff, 0xb3, 0x0b, 0x83, 0x28, 0xe3, 0xc9, 0x48, 0x1d, 0x39, 0x17, 0x32, 0x98, 0x2f, 0xc2,
+	0x65, 0xc2, 0xec, 0xbc, 0xe0, 0x92, 0xa3, 0x5e, 0x72, 0xbb, 0x3c, 0xfa, 0xc3, 0x84, 0xf3, 0x24,
+	0x63, 0x23, 0x25, 0x44, 0x97, 0x5f, 0x46, 0x32, 0xbd, 0x60, 0x42, 0x86, 0x17, 0x79, 0x55, 0xdb,
+	0xdf, 0x4d, 0x78, 0xc2, 0x55, 0x38, 0x2a, 0xa3, 0x8a, 0xbe, 0xfa, 0xb6, 0x01, 0x38, 0xe3, 0x42,
+	0x8e, 0xd5, 0xd8, 0x59, 0xc1, 0x73, 0x2e, 0xc2, 0x0c, 0xbb, 0xd0, 0x4c, 0x63, 0x4a, 0x4c, 0x62,
+	0x69, 0x5e, 0x33, 0x8d, 0x71, 0x0f, 0xb6, 0xd4, 0xd7, 0xd3, 0x98, 0x36, 0x15, 0x6c, 0x95, 0xe9,
+	0x69, 0x8c, 0x7d, 0x68, 0xe7, 0xaa, 0x89, 0x15, 0x74, 0xc3, 0x24, 0x96, 0xee, 0x3d, 0xe6, 0xb8,
+	0x0b, 0x9b, 0x32, 0x95, 0x19, 0xa3, 0x9a, 0x12, 0xaa, 0x04, 0x11, 0xb4, 0x88, 0xc7, 0x37, 0x74,
+	0x53, 0x41, 0x15, 0xe3, 0x3e, 0xe8, 0x61, 0x9e, 0x17, 0xfc, 0x2a, 0xcc, 0x04, 0x6d, 0x99, 0x1b,
+	0x96, 0xee, 0x3d, 0x01, 0x1c, 0x03, 0xcc, 0x0b, 0x16, 0x4a, 0x16, 0x07, 0xa1, 0xa4, 0x5b, 0x26,
+	0xb1, 0x3a, 0x87, 0x7d, 0xbb, 0xf2, 0x6b, 0xd7, 0x7e, 0x6d, 0xbf, 0xf6, 0x7b, 0xd4, 0xbe, 0xfb,
+	0x31, 0x6c, 0xdc, 0xfe, 0x1c, 0x12, 0x4f, 0x7f, 0xe8, 0x73, 0x24, 0xbe, 0x03, 0x60, 0xd7, 0x79,
+	0x5a, 0x30, 0x51, 0x0e, 0x69, 0xff, 0x77, 0x88, 0x56, 0x0d, 0x78, 0xe8, 0x71, 0x24, 0x1e, 0x80,
+	0xf6, 0x35, 0x5d, 0xc6, 0x54, 0x37, 0x89, 0xd5, 0x3d, 0x7c, 0x6e, 0x3f, 0xae, 0xde, 0x7e, 0xda,
+	0xdf, 0x59, 0xba, 0x8c, 0x3d, 0x55, 0x86, 0x2f, 0x00, 0xe6, 0x3c, 0x08, 0x2f, 0xe5, 0x82, 0x17,
+	0x82, 0x42, 0xe5, 0x69, 0xce, 0x9d, 0x0a, 0xe0, 0x01, 0x60, 0x6d, 0x30, 0x90, 0x8b, 0x82, 0x89,
+	0x05, 0xcf, 0x62, 0xda, 0x31, 0x89, 0xf5, 0xcc, 0xdb, 0xa9, 0x15, 0xbf, 0x16, 0xf0, 0x25, 0x6c,
+	0x47, 0xa1, 0x60, 0xc1, 0x15, 0x2b, 0x44, 0xca, 0x97, 0x74, 0x5b, 0x5d, 0x42, 0xa7, 0x64, 0x9f,
+	0x2a, 0xf4, 0x3a, 0x83, 0xee, 0x9f, 0x3f, 0x82, 0xfb, 0x40, 0x67, 0xd3, 0x73, 0x3f, 0x18, 0x9f,
+	0x38, 0x93, 0xf7, 0x6e, 0x70, 0x76, 0x3a, 0x39, 0x0e, 0xc6, 0xd3, 0x89, 0xef, 0x4e, 0xfc, 0x5e,
+	0x03, 0x87, 0x30, 0xf8, 0x87, 0x1a, 0x38, 0x1f, 0xfd, 0x93, 0xa9, 0x77, 0xde, 0x23, 0x38, 0x80,
+	0xbd, 0xbf, 0x0a, 0x8e, 0xdd, 0x0f, 0xae, 0xef, 0xf6, 0x9a, 0x47, 0x6f, 0xee, 0x56, 0x06, 0xb9,
+	0x5f, 0x19, 0xe4, 0xd7, 0xca, 0x20, 0xb7, 0x6b, 0xa3, 0x71, 0xbf, 0x36, 0x1a, 0xdf, 0xd7, 0x46,
+	0xe3, 0xf3, 0x8e, 0x7a, 0xab, 0xd7, 0xd5, 0x93, 0x95, 0x37, 0x39, 0x13, 0x51, 0x4b, 0xed, 0xf7,
+	0xed, 0xef, 0x01, 0x00, 0xcd, 0x36, 0x9a, 0x82, 0xcc, 0x02, 0x00, 0x00,
+}
+
+func (m *PostChangeProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PostChangeProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PostChangeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BaseVersion != 0 {
+		i = encodeVarintPostChange(dAtA, i, uint64(m.BaseVersion))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.ApprovalThreshold != 0 {
+		i = encodeVarintPostChange(dAtA, i, uint64(m.ApprovalThreshold))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.CoAuthors) > 0 {
+		for iNdEx := len(m.CoAuthors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CoAuthors[iNdEx])
+			copy(dAtA[i:], m.CoAuthors[iNdEx])
+			i = encodeVarintPostChange(dAtA, i, uint64(len(m.CoAuthors[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.Kind != 0 {
+		i = encodeVarintPostChange(dAtA, i, uint64(m.Kind))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ExpiresAt != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpiresAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpiresAt):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintPostChange(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x42
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintPostChange(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x3a
+	if len(m.Approvals) > 0 {
+		for iNdEx := len(m.Approvals) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Approvals[iNdEx])
+			copy(dAtA[i:], m.Approvals[iNdEx])
+			i = encodeVarintPostChange(dAtA, i, uint64(len(m.Approvals[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Body) > 0 {
+		i -= len(m.Body)
+		copy(dAtA[i:], m.Body)
+		i = encodeVarintPostChange(dAtA, i, uint64(len(m.Body)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintPostChange(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintPostChange(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PostId != 0 {
+		i = encodeVarintPostChange(dAtA, i, uint64(m.PostId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintPostChange(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPostChange(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPostChange(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PostChangeProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovPostChange(uint64(m.Id))
+	}
+	if m.PostId != 0 {
+		n += 1 + sovPostChange(uint64(m.PostId))
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovPostChange(uint64(l))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovPostChange(uint64(l))
+	}
+	l = len(m.Body)
+	if l > 0 {
+		n += 1 + l + sovPostChange(uint64(l))
+	}
+	if len(m.Approvals) > 0 {
+		for _, s := range m.Approvals {
+			l = len(s)
+			n += 1 + l + sovPostChange(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt)
+	n += 1 + l + sovPostChange(uint64(l))
+	if m.ExpiresAt != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpiresAt)
+		n += 1 + l + sovPostChange(uint64(l))
+	}
+	if m.Kind != 0 {
+		n += 1 + sovPostChange(uint64(m.Kind))
+	}
+	if len(m.CoAuthors) > 0 {
+		for _, s := range m.CoAuthors {
+			l = len(s)
+			n += 1 + l + sovPostChange(uint64(l))
+		}
+	}
+	if m.ApprovalThreshold != 0 {
+		n += 1 + sovPostChange(uint64(m.ApprovalThreshold))
+	}
+	if m.BaseVersion != 0 {
+		n += 1 + sovPostChange(uint64(m.BaseVersion))
+	}
+	return n
+}
+
+func sovPostChange(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPostChange(x uint64) (n int) {
+	return sovPostChange(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PostChangeProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPostChange
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PostChangeProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PostChangeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostChange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostId", wireType)
+			}
+			m.PostId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostChange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PostId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostChange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPostChange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPostChange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostChange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPostChange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPostChange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostChange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPostChange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPostChange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Body = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approvals", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostChange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPostChange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPostChange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Approvals = append(m.Approvals, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostChange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPostChange
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPostChange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostChange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPostChange
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPostChange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiresAt == nil {
+				m.ExpiresAt = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostChange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= PostChangeKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoAuthors", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostChange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPostChange
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPostChange
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CoAuthors = append(m.CoAuthors, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovalThreshold", wireType)
+			}
+			m.ApprovalThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostChange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApprovalThreshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseVersion", wireType)
+			}
+			m.BaseVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostChange
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPostChange(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPostChange
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPostChange(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPostChange
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPostChange
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPostChange
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPostChange
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPostChange
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPostChange
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPostChange        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPostChange          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPostChange = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,601 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: blog/blog/post_log.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PostLogAction is the kind of change recorded in the log of a post.
+type PostLogAction int32
+
+const (
+	PostLogAction_POST_LOG_ACTION_UNSPECIFIED PostLogAction = 0
+	// POST_LOG_ACTION_CREATE is the creation of the post.
+	PostLogAction_POST_LOG_ACTION_CREATE PostLogAction = 1
+	// POST_LOG_ACTION_UPDATE is a change of the content of the post, by an
+	// update, a patch or an approved change proposal.
+	PostLogAction_POST_LOG_ACTION_UPDATE         PostLogAction = 2
+	PostLogAction_POST_LOG_ACTION_ADD_EDITOR     PostLogAction = 3
+	PostLogAction_POST_LOG_ACTION_DELETE_EDITOR  PostLogAction = 4
+	PostLogAction_POST_LOG_ACTION_SET_CO_AUTHORS PostLogAction = 5
+	// POST_LOG_ACTION_DELETE is the move of the post to the trash.
+	PostLogAction_POST_LOG_ACTION_DELETE  PostLogAction = 6
+	PostLogAction_POST_LOG_ACTION_RESTORE PostLogAction = 7
+	// POST_LOG_ACTION_PURGE is the removal of the post from the trash once the
+	// retention period elapsed.
+	PostLogAction_POST_LOG_ACTION_PURGE              PostLogAction = 8
+	PostLogAction_POST_LOG_ACTION_GRANT_READ_ACCESS  PostLogAction = 9
+	PostLogAction_POST_LOG_ACTION_REVOKE_READ_ACCESS PostLogAction = 10
+	PostLogAction_POST_LOG_ACTION_SET_PRICE          PostLogAction = 11
+	PostLogAction_POST_LOG_ACTION_SET_TAGS           PostLogAction = 12
+)
+
+var PostLogAction_name = map[int32]string{
+	0:  "POST_LOG_ACTION_UNSPECIFIED",
+	1:  "POST_LOG_ACTION_CREATE",
+	2:  "POST_LOG_ACTION_UPDATE",
+	3:  "POST_LOG_ACTION_ADD_EDITOR",
+	4:  "POST_LOG_ACTION_DELETE_EDITOR",
+	5:  "POST_LOG_ACTION_SET_CO_AUTHORS",
+	6:  "POST_LOG_ACTION_DELETE",
+	7:  "POST_LOG_ACTION_RESTORE",
+	8:  "POST_LOG_ACTION_PURGE",
+	9:  "POST_LOG_ACTION_GRANT_READ_ACCESS",
+	10: "POST_LOG_ACTION_REVOKE_READ_ACCESS",
+	11: "POST_LOG_ACTION_SET_PRICE",
+	12: "POST_LOG_ACTION_SET_TAGS",
+}
+
+var PostLogAction_value = map[string]int32{
+	"POST_LOG_ACTION_UNSPECIFIED":        0,
+	"POST_LOG_ACTION_CREATE":             1,
+	"POST_LOG_ACTION_UPDATE":             2,
+	"POST_LOG_ACTION_ADD_EDITOR":         3,
+	"POST_LOG_ACTION_DELETE_EDITOR":      4,
+	"POST_LOG_ACTION_SET_CO_AUTHORS":     5,
+	"POST_LOG_ACTION_DELETE":             6,
+	"POST_LOG_ACTION_RESTORE":            7,
+	"POST_LOG_ACTION_PURGE":              8,
+	"POST_LOG_ACTION_GRANT_READ_ACCESS":  9,
+	"POST_LOG_ACTION_REVOKE_READ_ACCESS": 10,
+	"POST_LOG_ACTION_SET_PRICE":          11,
+	"POST_LOG_ACTION_SET_TAGS":           12,
+}
+
+func (x PostLogAction) String() string {
+	return proto.EnumName(PostLogAction_name, int32(x))
+}
+
+func (PostLogAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_0d10719a085a3c8c, []int{0}
+}
+
+// PostLogEntry records a change of a post at a height. The log of a post
+// outlives the post itself so that audits can follow it after deletion.
+type PostLogEntry struct {
+	PostId uint64        `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Height int64         `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Time   time.Time     `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time"`
+	Action PostLogAction `protobuf:"varint,4,opt,name=action,proto3,enum=blog.blog.PostLogAction" json:"action,omitempty"`
+	// actor is the address that made the change, empty for purges.
+	Actor string `protobuf:"bytes,5,opt,name=actor,proto3" json:"actor,omitempty"`
+	// version is the version of the post after the change.
+	Version uint64 `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (m *PostLogEntry) Reset()         { *m = PostLogEntry{} }
+func (m *PostLogEntry) String() string { return proto.CompactTextString(m) }
+func (*PostLogEntry) ProtoMessage()    {}
+func (*PostLogEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d10719a085a3c8c, []int{0}
+}
+func (m *PostLogEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PostLogEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PostLogEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PostLogEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PostLogEntry.Merge(m, src)
+}
+func (m *PostLogEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *PostLogEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_PostLogEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PostLogEntry proto.InternalMessageInfo
+
+func (m *PostLogEntry) GetPostId() uint64 {
+	if m != nil {
+		return m.PostId
+	}
+	return 0
+}
+
+func (m *PostLogEntry) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *PostLogEntry) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *PostLogEntry) GetAction() PostLogAction {
+	if m != nil {
+		return m.Action
+	}
+	return PostLogAction_POST_LOG_ACTION_UNSPECIFIED
+}
+
+func (m *PostLogEntry) GetActor() string {
+	if m != nil {
+		return m.Actor
+	}
+	return ""
+}
+
+func (m *PostLogEntry) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("blog.blog.PostLogAction", PostLogAction_name, PostLogAction_value)
+	proto.RegisterType((*PostLogEntry)(nil), "blog.blog.PostLogEntry")
+}
+
+func init() { proto.RegisterFile("blog/blog/post_log.proto", fileDescriptor_0d10719a085a3c8c) }
+
+var fileDescriptor_0d10719a085a3c8c = []byte{
+	// 482 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x93, 0xc1, 0x6e, 0xd3, 0x30,
+	0x1c, 0xc6, 0xe3, 0xb5, 0x4d, 0xd7, 0xff, 0x06, 0x32, 0xd6, 0xd8, 0xb2, 0x8c, 0xa5, 0x59, 0x25,
+	0x50, 0x04, 0x52, 0x8a, 0xc6, 0x85, 0x6b, 0x96, 0x98, 0x12, 0x51, 0x35, 0x91, 0xe3, 0x72, 0xe0,
+	0x12, 0xb5, 0x5b, 0xc8, 0x2a, 0x75, 0x73, 0xd5, 0x18, 0xc4, 0xde, 0x62, 0x6f, 0xc2, 0x6b, 0xec,
+	0xb8, 0x23, 0x12, 0x12, 0xa0, 0xf6, 0x45, 0x50, 0x92, 0x16, 0x69, 0xa1, 0x17, 0xcb, 0x9f, 0x7f,
+	0x5f, 0x3e, 0x7f, 0x96, 0xf2, 0x07, 0x6d, 0x3c, 0x15, 0x69, 0xb7, 0x58, 0x66, 0x22, 0x93, 0xf1,
+	0x54, 0xa4, 0xf6, 0x6c, 0x2e, 0xa4, 0x20, 0xad, 0xfc, 0xd0, 0xce, 0x17, 0xbd, 0x9d, 0x0a, 0x91,
+	0x4e, 0x93, 0x6e, 0x01, 0xc6, 0x5f, 0x3e, 0x77, 0xe5, 0xe4, 0x2a, 0xc9, 0xe4, 0xe8, 0x6a, 0x56,
+	0x7a, 0xf5, 0xbd, 0x54, 0xa4, 0xa2, 0xd8, 0x76, 0xf3, 0x5d, 0x79, 0xda, 0xf9, 0x89, 0x60, 0x37,
+	0x14, 0x99, 0xec, 0x8b, 0x94, 0x5e, 0xcb, 0xf9, 0x0d, 0x39, 0x80, 0x66, 0x71, 0xc9, 0xe4, 0x42,
+	0x43, 0x26, 0xb2, 0xea, 0x4c, 0xcd, 0xa5, 0x7f, 0x41, 0xf6, 0x41, 0xbd, 0x4c, 0x26, 0xe9, 0xa5,
+	0xd4, 0xb6, 0x4c, 0x64, 0xd5, 0xd8, 0x4a, 0x91, 0xb7, 0x50, 0xcf, 0xaf, 0xd2, 0x6a, 0x26, 0xb2,
+	0x76, 0x4e, 0x75, 0xbb, 0xec, 0x61, 0xaf, 0x7b, 0xd8, 0x7c, 0xdd, 0xe3, 0x6c, 0xfb, 0xee, 0x57,
+	0x5b, 0xb9, 0xfd, 0xdd, 0x46, 0xac, 0xf8, 0x82, 0xbc, 0x06, 0x75, 0x74, 0x2e, 0x27, 0xe2, 0x5a,
+	0xab, 0x9b, 0xc8, 0x7a, 0x7c, 0xaa, 0xd9, 0xff, 0x9e, 0x63, 0xaf, 0x3a, 0x39, 0x05, 0x67, 0x2b,
+	0x1f, 0xd9, 0x83, 0xc6, 0xe8, 0x5c, 0x8a, 0xb9, 0xd6, 0x30, 0x91, 0xd5, 0x62, 0xa5, 0x20, 0x1a,
+	0x34, 0xbf, 0x26, 0xf3, 0x2c, 0x0f, 0x52, 0x8b, 0xca, 0x6b, 0xf9, 0xf2, 0x7b, 0x0d, 0x1e, 0x3d,
+	0x48, 0x22, 0x6d, 0x38, 0x0a, 0x83, 0x88, 0xc7, 0xfd, 0xa0, 0x17, 0x3b, 0x2e, 0xf7, 0x83, 0x41,
+	0x3c, 0x1c, 0x44, 0x21, 0x75, 0xfd, 0x77, 0x3e, 0xf5, 0xb0, 0x42, 0x74, 0xd8, 0xaf, 0x1a, 0x5c,
+	0x46, 0x1d, 0x4e, 0x31, 0xda, 0xc4, 0x86, 0xa1, 0x97, 0xb3, 0x2d, 0x62, 0x80, 0x5e, 0x65, 0x8e,
+	0xe7, 0xc5, 0xd4, 0xf3, 0x79, 0xc0, 0x70, 0x8d, 0x9c, 0xc0, 0x71, 0x95, 0x7b, 0xb4, 0x4f, 0x39,
+	0x5d, 0x5b, 0xea, 0xa4, 0x03, 0x46, 0xd5, 0x12, 0x51, 0x1e, 0xbb, 0x41, 0xec, 0x0c, 0xf9, 0xfb,
+	0x80, 0x45, 0xb8, 0xb1, 0xa9, 0x42, 0x19, 0x83, 0x55, 0x72, 0x04, 0x07, 0x55, 0xc6, 0x68, 0xc4,
+	0x03, 0x46, 0x71, 0x93, 0x1c, 0xc2, 0xd3, 0x2a, 0x0c, 0x87, 0xac, 0x47, 0xf1, 0x36, 0x79, 0x0e,
+	0x27, 0x55, 0xd4, 0x63, 0xce, 0x80, 0xc7, 0x8c, 0x3a, 0x5e, 0xec, 0xb8, 0x2e, 0x8d, 0x22, 0xdc,
+	0x22, 0x2f, 0xa0, 0xf3, 0x7f, 0xfc, 0xc7, 0xe0, 0x03, 0x7d, 0xe0, 0x03, 0x72, 0x0c, 0x87, 0x9b,
+	0x9e, 0x11, 0x32, 0xdf, 0xa5, 0x78, 0x87, 0x3c, 0x03, 0x6d, 0x13, 0xe6, 0x4e, 0x2f, 0xc2, 0xbb,
+	0x67, 0xaf, 0xee, 0x16, 0x06, 0xba, 0x5f, 0x18, 0xe8, 0xcf, 0xc2, 0x40, 0xb7, 0x4b, 0x43, 0xb9,
+	0x5f, 0x1a, 0xca, 0x8f, 0xa5, 0xa1, 0x7c, 0x7a, 0x52, 0x0c, 0xc0, 0xb7, 0x72, 0x0e, 0xe4, 0xcd,
+	0x2c, 0xc9, 0xc6, 0x6a, 0xf1, 0x93, 0xbd, 0xf9, 0x3b, 0x00, 0x1e, 0x2e, 0x8f, 0x5f, 0x21, 0x03,
+	0x00, 0x00,
+}
+
+func (m *PostLogEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PostLogEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PostLogEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintPostLog(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Actor) > 0 {
+		i -= len(m.Actor)
+		copy(dAtA[i:], m.Actor)
+		i = encodeVarintPostLog(dAtA, i, uint64(len(m.Actor)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Action != 0 {
+		i = encodeVarintPostLog(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x20
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintPostLog(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintPostLog(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PostId != 0 {
+		i = encodeVarintPostLog(dAtA, i, uint64(m.PostId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPostLog(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPostLog(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PostLogEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PostId != 0 {
+		n += 1 + sovPostLog(uint64(m.PostId))
+	}
+	if m.Height != 0 {
+		n += 1 + sovPostLog(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovPostLog(uint64(l))
+	if m.Action != 0 {
+		n += 1 + sovPostLog(uint64(m.Action))
+	}
+	l = len(m.Actor)
+	if l > 0 {
+		n += 1 + l + sovPostLog(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovPostLog(uint64(m.Version))
+	}
+	return n
+}
+
+func sovPostLog(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPostLog(x uint64) (n int) {
+	return sovPostLog(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PostLogEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPostLog
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PostLogEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PostLogEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostId", wireType)
+			}
+			m.PostId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostLog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PostId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostLog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostLog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPostLog
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPostLog
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostLog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= PostLogAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostLog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPostLog
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPostLog
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Actor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPostLog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPostLog(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPostLog
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPostLog(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPostLog
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPostLog
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPostLog
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPostLog
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPostLog
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPostLog
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPostLog        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPostLog          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPostLog = fmt.Errorf("proto: unexpected end of group")
+)
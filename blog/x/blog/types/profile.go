@@ -0,0 +1,88 @@
+package types
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// MinDisplayNameLength and MaxDisplayNameLength bound the length of a
+	// profile display name.
+	MinDisplayNameLength = 3
+	MaxDisplayNameLength = 32
+
+	// MaxBioLength is the maximum length of a profile bio.
+	MaxBioLength = 500
+
+	// MaxProfileURILength is the maximum length of the avatar URI and links.
+	MaxProfileURILength = 256
+
+	// MaxProfileLinks is the maximum number of links on a profile.
+	MaxProfileLinks = 5
+)
+
+var displayNameRegexp = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_.-]*$`)
+
+// avatarURISchemes lists the schemes accepted for avatar URIs.
+var avatarURISchemes = map[string]bool{"https": true, "ipfs": true, "ar": true}
+
+// NormalizeDisplayName returns the form of a display name used by the
+// uniqueness index, so that names differing only in case collide.
+func NormalizeDisplayName(name string) string {
+	return strings.ToLower(name)
+}
+
+// ValidateDisplayName checks that a display name is made of letters, digits,
+// underscores, dots and dashes and starts with a letter or digit.
+func ValidateDisplayName(name string) error {
+	if len(name) < MinDisplayNameLength || len(name) > MaxDisplayNameLength {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "display name must be between %d and %d characters", MinDisplayNameLength, MaxDisplayNameLength)
+	}
+	if !displayNameRegexp.MatchString(name) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid display name %q", name)
+	}
+	return nil
+}
+
+// ValidateBasic checks the fields of a profile that do not depend on state.
+// An empty display name is allowed and means the profile has none.
+func (p Profile) ValidateBasic() error {
+	if p.DisplayName != "" {
+		if err := ValidateDisplayName(p.DisplayName); err != nil {
+			return err
+		}
+	}
+	if len(p.Bio) > MaxBioLength {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "bio is longer than %d characters", MaxBioLength)
+	}
+	if p.AvatarUri != "" {
+		if err := validateProfileURI(p.AvatarUri, avatarURISchemes); err != nil {
+			return errorsmod.Wrap(err, "avatar uri")
+		}
+	}
+	if len(p.Links) > MaxProfileLinks {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "a profile has at most %d links", MaxProfileLinks)
+	}
+	for i, link := range p.Links {
+		if err := validateProfileURI(link, map[string]bool{"http": true, "https": true}); err != nil {
+			return errorsmod.Wrapf(err, "link %d", i)
+		}
+	}
+	return nil
+}
+
+// validateProfileURI checks that uri is an absolute URI using one of schemes.
+func validateProfileURI(uri string, schemes map[string]bool) error {
+	if len(uri) > MaxProfileURILength {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "uri is longer than %d characters", MaxProfileURILength)
+	}
+	u, err := url.Parse(uri)
+	if err != nil || u.Host == "" || !schemes[u.Scheme] {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid uri %q", uri)
+	}
+	return nil
+}
@@ -11,19 +11,23 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -31,6 +35,34 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ListPostOrder selects the order in which ListPost returns posts.
+type ListPostOrder int32
+
+const (
+	// LIST_POST_ORDER_ID returns posts in ascending ID order.
+	ListPostOrder_LIST_POST_ORDER_ID ListPostOrder = 0
+	// LIST_POST_ORDER_LAST_UPDATED_DESC returns the most recently updated posts first.
+	ListPostOrder_LIST_POST_ORDER_LAST_UPDATED_DESC ListPostOrder = 1
+)
+
+var ListPostOrder_name = map[int32]string{
+	0: "LIST_POST_ORDER_ID",
+	1: "LIST_POST_ORDER_LAST_UPDATED_DESC",
+}
+
+var ListPostOrder_value = map[string]int32{
+	"LIST_POST_ORDER_ID":                0,
+	"LIST_POST_ORDER_LAST_UPDATED_DESC": 1,
+}
+
+func (x ListPostOrder) String() string {
+	return proto.EnumName(ListPostOrder_name, int32(x))
+}
+
+func (ListPostOrder) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a5bb36fa4271d1d5, []int{0}
+}
+
 // QueryParamsRequest is request type for the Query/Params RPC method.
 type QueryParamsRequest struct {
 }
@@ -116,6 +148,8 @@ func (m *QueryParamsResponse) GetParams() Params {
 
 type QueryShowPostRequest struct {
 	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// include_profile embeds the profile of the post creator in the response.
+	IncludeProfile bool `protobuf:"varint,2,opt,name=include_profile,json=includeProfile,proto3" json:"include_profile,omitempty"`
 }
 
 func (m *QueryShowPostRequest) Reset()         { *m = QueryShowPostRequest{} }
@@ -158,8 +192,23 @@ func (m *QueryShowPostRequest) GetId() uint64 {
 	return 0
 }
 
+func (m *QueryShowPostRequest) GetIncludeProfile() bool {
+	if m != nil {
+		return m.IncludeProfile
+	}
+	return false
+}
+
 type QueryShowPostResponse struct {
 	Post Post `protobuf:"bytes,1,opt,name=post,proto3" json:"post"`
+	// edit_count is the number of times the post has been updated.
+	EditCount uint64 `protobuf:"varint,2,opt,name=edit_count,json=editCount,proto3" json:"edit_count,omitempty"`
+	// author_profile is the creator's profile, if requested and set.
+	AuthorProfile *Profile `protobuf:"bytes,3,opt,name=author_profile,json=authorProfile,proto3" json:"author_profile,omitempty"`
+	// series_ids lists the series the post belongs to.
+	SeriesIds []uint64 `protobuf:"varint,4,rep,packed,name=series_ids,json=seriesIds,proto3" json:"series_ids,omitempty"`
+	// revision is the hash MsgPatchPost expects for the current content.
+	Revision string `protobuf:"bytes,5,opt,name=revision,proto3" json:"revision,omitempty"`
 }
 
 func (m *QueryShowPostResponse) Reset()         { *m = QueryShowPostResponse{} }
@@ -202,15 +251,158 @@ func (m *QueryShowPostResponse) GetPost() Post {
 	return Post{}
 }
 
+func (m *QueryShowPostResponse) GetEditCount() uint64 {
+	if m != nil {
+		return m.EditCount
+	}
+	return 0
+}
+
+func (m *QueryShowPostResponse) GetAuthorProfile() *Profile {
+	if m != nil {
+		return m.AuthorProfile
+	}
+	return nil
+}
+
+func (m *QueryShowPostResponse) GetSeriesIds() []uint64 {
+	if m != nil {
+		return m.SeriesIds
+	}
+	return nil
+}
+
+func (m *QueryShowPostResponse) GetRevision() string {
+	if m != nil {
+		return m.Revision
+	}
+	return ""
+}
+
+type QueryShowPostBySlugRequest struct {
+	Slug string `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+}
+
+func (m *QueryShowPostBySlugRequest) Reset()         { *m = QueryShowPostBySlugRequest{} }
+func (m *QueryShowPostBySlugRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryShowPostBySlugRequest) ProtoMessage()    {}
+func (*QueryShowPostBySlugRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5bb36fa4271d1d5, []int{4}
+}
+func (m *QueryShowPostBySlugRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryShowPostBySlugRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryShowPostBySlugRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryShowPostBySlugRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryShowPostBySlugRequest.Merge(m, src)
+}
+func (m *QueryShowPostBySlugRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryShowPostBySlugRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryShowPostBySlugRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryShowPostBySlugRequest proto.InternalMessageInfo
+
+func (m *QueryShowPostBySlugRequest) GetSlug() string {
+	if m != nil {
+		return m.Slug
+	}
+	return ""
+}
+
+type QueryShowPostBySlugResponse struct {
+	Post Post `protobuf:"bytes,1,opt,name=post,proto3" json:"post"`
+	// redirect is set when the requested slug is a previous slug of the post;
+	// clients should redirect to post.slug.
+	Redirect bool `protobuf:"varint,2,opt,name=redirect,proto3" json:"redirect,omitempty"`
+}
+
+func (m *QueryShowPostBySlugResponse) Reset()         { *m = QueryShowPostBySlugResponse{} }
+func (m *QueryShowPostBySlugResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryShowPostBySlugResponse) ProtoMessage()    {}
+func (*QueryShowPostBySlugResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5bb36fa4271d1d5, []int{5}
+}
+func (m *QueryShowPostBySlugResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryShowPostBySlugResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryShowPostBySlugResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryShowPostBySlugResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryShowPostBySlugResponse.Merge(m, src)
+}
+func (m *QueryShowPostBySlugResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryShowPostBySlugResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryShowPostBySlugResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryShowPostBySlugResponse proto.InternalMessageInfo
+
+func (m *QueryShowPostBySlugResponse) GetPost() Post {
+	if m != nil {
+		return m.Post
+	}
+	return Post{}
+}
+
+func (m *QueryShowPostBySlugResponse) GetRedirect() bool {
+	if m != nil {
+		return m.Redirect
+	}
+	return false
+}
+
 type QueryListPostRequest struct {
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// creator only returns posts created by this address.
+	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	// created_after only returns posts created at or after this time.
+	CreatedAfter *time.Time `protobuf:"bytes,3,opt,name=created_after,json=createdAfter,proto3,stdtime" json:"created_after,omitempty"`
+	// created_before only returns posts created strictly before this time.
+	CreatedBefore *time.Time `protobuf:"bytes,4,opt,name=created_before,json=createdBefore,proto3,stdtime" json:"created_before,omitempty"`
+	// updated_after only returns posts last updated at or after this time.
+	UpdatedAfter *time.Time `protobuf:"bytes,5,opt,name=updated_after,json=updatedAfter,proto3,stdtime" json:"updated_after,omitempty"`
+	// updated_before only returns posts last updated strictly before this time.
+	UpdatedBefore *time.Time `protobuf:"bytes,6,opt,name=updated_before,json=updatedBefore,proto3,stdtime" json:"updated_before,omitempty"`
+	// title_prefix only returns posts whose title starts with this string.
+	TitlePrefix string        `protobuf:"bytes,7,opt,name=title_prefix,json=titlePrefix,proto3" json:"title_prefix,omitempty"`
+	Order       ListPostOrder `protobuf:"varint,8,opt,name=order,proto3,enum=blog.blog.ListPostOrder" json:"order,omitempty"`
+	// include_profiles embeds the profiles of the creators of the listed posts.
+	IncludeProfiles bool `protobuf:"varint,9,opt,name=include_profiles,json=includeProfiles,proto3" json:"include_profiles,omitempty"`
+	// tag only returns posts with this tag.
+	Tag string `protobuf:"bytes,10,opt,name=tag,proto3" json:"tag,omitempty"`
 }
 
 func (m *QueryListPostRequest) Reset()         { *m = QueryListPostRequest{} }
 func (m *QueryListPostRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryListPostRequest) ProtoMessage()    {}
 func (*QueryListPostRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a5bb36fa4271d1d5, []int{4}
+	return fileDescriptor_a5bb36fa4271d1d5, []int{6}
 }
 func (m *QueryListPostRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -246,16 +438,82 @@ func (m *QueryListPostRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryListPostRequest) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *QueryListPostRequest) GetCreatedAfter() *time.Time {
+	if m != nil {
+		return m.CreatedAfter
+	}
+	return nil
+}
+
+func (m *QueryListPostRequest) GetCreatedBefore() *time.Time {
+	if m != nil {
+		return m.CreatedBefore
+	}
+	return nil
+}
+
+func (m *QueryListPostRequest) GetUpdatedAfter() *time.Time {
+	if m != nil {
+		return m.UpdatedAfter
+	}
+	return nil
+}
+
+func (m *QueryListPostRequest) GetUpdatedBefore() *time.Time {
+	if m != nil {
+		return m.UpdatedBefore
+	}
+	return nil
+}
+
+func (m *QueryListPostRequest) GetTitlePrefix() string {
+	if m != nil {
+		return m.TitlePrefix
+	}
+	return ""
+}
+
+func (m *QueryListPostRequest) GetOrder() ListPostOrder {
+	if m != nil {
+		return m.Order
+	}
+	return ListPostOrder_LIST_POST_ORDER_ID
+}
+
+func (m *QueryListPostRequest) GetIncludeProfiles() bool {
+	if m != nil {
+		return m.IncludeProfiles
+	}
+	return false
+}
+
+func (m *QueryListPostRequest) GetTag() string {
+	if m != nil {
+		return m.Tag
+	}
+	return ""
+}
+
 type QueryListPostResponse struct {
 	Post       []Post              `protobuf:"bytes,1,rep,name=post,proto3" json:"post"`
 	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// profiles holds one entry per distinct creator of the listed posts that
+	// has a profile, if requested.
+	Profiles []Profile `protobuf:"bytes,3,rep,name=profiles,proto3" json:"profiles"`
 }
 
 func (m *QueryListPostResponse) Reset()         { *m = QueryListPostResponse{} }
 func (m *QueryListPostResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryListPostResponse) ProtoMessage()    {}
 func (*QueryListPostResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a5bb36fa4271d1d5, []int{5}
+	return fileDescriptor_a5bb36fa4271d1d5, []int{7}
 }
 func (m *QueryListPostResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -298,505 +556,9166 @@ func (m *QueryListPostResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "blog.blog.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "blog.blog.QueryParamsResponse")
-	proto.RegisterType((*QueryShowPostRequest)(nil), "blog.blog.QueryShowPostRequest")
-	proto.RegisterType((*QueryShowPostResponse)(nil), "blog.blog.QueryShowPostResponse")
-	proto.RegisterType((*QueryListPostRequest)(nil), "blog.blog.QueryListPostRequest")
-	proto.RegisterType((*QueryListPostResponse)(nil), "blog.blog.QueryListPostResponse")
-}
-
-func init() { proto.RegisterFile("blog/blog/query.proto", fileDescriptor_a5bb36fa4271d1d5) }
-
-var fileDescriptor_a5bb36fa4271d1d5 = []byte{
-	// 486 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0x3f, 0x6f, 0x13, 0x31,
-	0x18, 0xc6, 0x73, 0x47, 0x88, 0x5a, 0x23, 0x81, 0xe2, 0x5e, 0x2b, 0x1a, 0xca, 0xb5, 0x78, 0x28,
-	0x50, 0x24, 0x5b, 0x2d, 0x7c, 0x82, 0x0c, 0x30, 0xc0, 0x10, 0x8e, 0x8d, 0x01, 0xe4, 0x23, 0xd6,
-	0xd5, 0x22, 0xb9, 0xf7, 0x1a, 0xbb, 0x94, 0x0a, 0xb1, 0xb0, 0xb2, 0x20, 0xf1, 0x25, 0x18, 0xf9,
-	0x18, 0x15, 0x53, 0x25, 0x16, 0x26, 0x84, 0x12, 0x24, 0xbe, 0x06, 0xf2, 0x9f, 0x34, 0xee, 0x9d,
-	0xaa, 0x2e, 0x96, 0xf5, 0xfa, 0x79, 0x9f, 0xdf, 0x93, 0xf7, 0xcd, 0xa1, 0xd5, 0x7c, 0x04, 0x05,
-	0xb3, 0xc7, 0xc1, 0xa1, 0x98, 0x1c, 0xd3, 0x6a, 0x02, 0x1a, 0xf0, 0xb2, 0xa9, 0x50, 0x73, 0xf4,
-	0xba, 0x7c, 0x2c, 0x4b, 0x60, 0xf6, 0x74, 0xaf, 0xbd, 0xa4, 0x80, 0x02, 0xec, 0x95, 0x99, 0x9b,
-	0xaf, 0x6e, 0x14, 0x00, 0xc5, 0x48, 0x30, 0x5e, 0x49, 0xc6, 0xcb, 0x12, 0x34, 0xd7, 0x12, 0x4a,
-	0xe5, 0x5f, 0x77, 0xde, 0x80, 0x1a, 0x83, 0x62, 0x39, 0x57, 0xc2, 0xa1, 0xd8, 0xbb, 0xdd, 0x5c,
-	0x68, 0xbe, 0xcb, 0x2a, 0x5e, 0xc8, 0xd2, 0x8a, 0xbd, 0x76, 0x6d, 0x11, 0xaa, 0xe2, 0x13, 0x3e,
-	0x9e, 0x7b, 0x24, 0x41, 0x1d, 0x94, 0x76, 0x55, 0x92, 0x20, 0xfc, 0xdc, 0xf8, 0x0d, 0xac, 0x34,
-	0x13, 0x07, 0x87, 0x42, 0x69, 0xf2, 0x14, 0xad, 0x9c, 0xab, 0xaa, 0x0a, 0x4a, 0x25, 0xf0, 0x23,
-	0xd4, 0x71, 0x96, 0x37, 0xa3, 0xad, 0xe8, 0xde, 0xb5, 0xbd, 0x2e, 0x3d, 0xfb, 0xa5, 0xd4, 0x49,
-	0xfb, 0xcb, 0x27, 0xbf, 0x37, 0x5b, 0xdf, 0xfe, 0x7d, 0xdf, 0x89, 0x32, 0xaf, 0x25, 0xdb, 0x28,
-	0xb1, 0x66, 0x2f, 0xf6, 0xe1, 0x68, 0x00, 0x4a, 0x7b, 0x08, 0xbe, 0x8e, 0x62, 0x39, 0xb4, 0x4e,
-	0xed, 0x2c, 0x96, 0x43, 0xd2, 0x47, 0xab, 0x35, 0x9d, 0xc7, 0xde, 0x47, 0x6d, 0x93, 0xd8, 0x43,
-	0x6f, 0x84, 0x50, 0x50, 0xba, 0xdf, 0x36, 0xc8, 0xcc, 0x4a, 0xc8, 0x2b, 0xcf, 0x7a, 0x26, 0x95,
-	0x0e, 0x59, 0x8f, 0x11, 0x5a, 0x0c, 0xca, 0x1b, 0x6d, 0x53, 0x37, 0x55, 0x6a, 0xa6, 0x4a, 0xdd,
-	0x02, 0xfd, 0x54, 0xe9, 0x80, 0x17, 0xc2, 0xf7, 0x66, 0x41, 0x27, 0xf9, 0x1c, 0xf9, 0x90, 0x0b,
-	0x40, 0x23, 0xe4, 0x95, 0x4b, 0x42, 0xe2, 0x27, 0xe7, 0xc2, 0xc4, 0x36, 0xcc, 0xdd, 0x4b, 0xc3,
-	0x38, 0x4e, 0x98, 0x66, 0xef, 0x47, 0x8c, 0xae, 0xda, 0x34, 0x38, 0x47, 0x1d, 0xb7, 0x00, 0x7c,
-	0x3b, 0x20, 0x37, 0x37, 0xdb, 0x4b, 0x2f, 0x7a, 0x76, 0xf6, 0x64, 0xfd, 0xd3, 0xcf, 0xbf, 0x5f,
-	0xe3, 0x15, 0xdc, 0x65, 0xf5, 0xbf, 0x11, 0xae, 0xd0, 0xd2, 0x7c, 0x35, 0x78, 0xb3, 0x6e, 0x53,
-	0x5b, 0x6e, 0x6f, 0xeb, 0x62, 0x81, 0x27, 0xdd, 0xb1, 0xa4, 0x5b, 0x78, 0x3d, 0x20, 0xa9, 0x7d,
-	0x38, 0x7a, 0x6d, 0x66, 0xc4, 0x3e, 0xc8, 0xe1, 0x47, 0xfc, 0x16, 0x2d, 0xcd, 0xe7, 0xdc, 0x24,
-	0xd6, 0x56, 0xdc, 0x24, 0xd6, 0x57, 0x44, 0x36, 0x2c, 0x71, 0x0d, 0x27, 0x01, 0x71, 0x24, 0x95,
-	0xb6, 0xc4, 0xfe, 0x83, 0x93, 0x69, 0x1a, 0x9d, 0x4e, 0xd3, 0xe8, 0xcf, 0x34, 0x8d, 0xbe, 0xcc,
-	0xd2, 0xd6, 0xe9, 0x2c, 0x6d, 0xfd, 0x9a, 0xa5, 0xad, 0x97, 0x5d, 0xab, 0x7c, 0xef, 0x1a, 0xf4,
-	0x71, 0x25, 0x54, 0xde, 0xb1, 0x5f, 0xcf, 0xc3, 0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0x7e, 0xdd,
-	0x1c, 0x24, 0x02, 0x04, 0x00, 0x00,
+func (m *QueryListPostResponse) GetProfiles() []Profile {
+	if m != nil {
+		return m.Profiles
+	}
+	return nil
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Queries a list of ShowPost items.
-	ShowPost(ctx context.Context, in *QueryShowPostRequest, opts ...grpc.CallOption) (*QueryShowPostResponse, error)
-	// Queries a list of ListPost items.
-	ListPost(ctx context.Context, in *QueryListPostRequest, opts ...grpc.CallOption) (*QueryListPostResponse, error)
+type QuerySearchPostsRequest struct {
+	Query      string             `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QuerySearchPostsRequest) Reset()         { *m = QuerySearchPostsRequest{} }
+func (m *QuerySearchPostsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySearchPostsRequest) ProtoMessage()    {}
+func (*QuerySearchPostsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5bb36fa4271d1d5, []int{8}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QuerySearchPostsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/blog.blog.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySearchPostsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySearchPostsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QuerySearchPostsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySearchPostsRequest.Merge(m, src)
+}
+func (m *QuerySearchPostsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySearchPostsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySearchPostsRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) ShowPost(ctx context.Context, in *QueryShowPostRequest, opts ...grpc.CallOption) (*QueryShowPostResponse, error) {
-	out := new(QueryShowPostResponse)
-	err := c.cc.Invoke(ctx, "/blog.blog.Query/ShowPost", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySearchPostsRequest proto.InternalMessageInfo
+
+func (m *QuerySearchPostsRequest) GetQuery() string {
+	if m != nil {
+		return m.Query
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) ListPost(ctx context.Context, in *QueryListPostRequest, opts ...grpc.CallOption) (*QueryListPostResponse, error) {
-	out := new(QueryListPostResponse)
-	err := c.cc.Invoke(ctx, "/blog.blog.Query/ListPost", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySearchPostsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Queries a list of ShowPost items.
-	ShowPost(context.Context, *QueryShowPostRequest) (*QueryShowPostResponse, error)
-	// Queries a list of ListPost items.
-	ListPost(context.Context, *QueryListPostRequest) (*QueryListPostResponse, error)
+type QuerySearchPostsResponse struct {
+	Posts      []Post              `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QuerySearchPostsResponse) Reset()         { *m = QuerySearchPostsResponse{} }
+func (m *QuerySearchPostsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySearchPostsResponse) ProtoMessage()    {}
+func (*QuerySearchPostsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5bb36fa4271d1d5, []int{9}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QuerySearchPostsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) ShowPost(ctx context.Context, req *QueryShowPostRequest) (*QueryShowPostResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ShowPost not implemented")
+func (m *QuerySearchPostsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySearchPostsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) ListPost(ctx context.Context, req *QueryListPostRequest) (*QueryListPostResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListPost not implemented")
+func (m *QuerySearchPostsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySearchPostsResponse.Merge(m, src)
+}
+func (m *QuerySearchPostsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySearchPostsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySearchPostsResponse.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+var xxx_messageInfo_QuerySearchPostsResponse proto.InternalMessageInfo
+
+func (m *QuerySearchPostsResponse) GetPosts() []Post {
+	if m != nil {
+		return m.Posts
+	}
+	return nil
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QuerySearchPostsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/blog.blog.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_ShowPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryShowPostRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ShowPost(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/blog.blog.Query/ShowPost",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ShowPost(ctx, req.(*QueryShowPostRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryBlogStatsRequest struct {
 }
 
-func _Query_ListPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryListPostRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListPost(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/blog.blog.Query/ListPost",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListPost(ctx, req.(*QueryListPostRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryBlogStatsRequest) Reset()         { *m = QueryBlogStatsRequest{} }
+func (m *QueryBlogStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlogStatsRequest) ProtoMessage()    {}
+func (*QueryBlogStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5bb36fa4271d1d5, []int{10}
 }
-
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "blog.blog.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "ShowPost",
-			Handler:    _Query_ShowPost_Handler,
-		},
-		{
-			MethodName: "ListPost",
-			Handler:    _Query_ListPost_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "blog/blog/query.proto",
+func (m *QueryBlogStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryBlogStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlogStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryBlogStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlogStatsRequest.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryBlogStatsRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryBlogStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlogStatsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryBlogStatsRequest proto.InternalMessageInfo
+
+type QueryBlogStatsResponse struct {
+	// total_posts is the number of posts currently stored.
+	TotalPosts uint64 `protobuf:"varint,1,opt,name=total_posts,json=totalPosts,proto3" json:"total_posts,omitempty"`
+	// total_creators is the number of addresses with at least one post.
+	TotalCreators uint64 `protobuf:"varint,2,opt,name=total_creators,json=totalCreators,proto3" json:"total_creators,omitempty"`
+	// total_edits is the number of post updates ever applied.
+	TotalEdits uint64 `protobuf:"varint,3,opt,name=total_edits,json=totalEdits,proto3" json:"total_edits,omitempty"`
+	// last_post_id is the ID assigned to the most recently created post.
+	LastPostId uint64 `protobuf:"varint,4,opt,name=last_post_id,json=lastPostId,proto3" json:"last_post_id,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryBlogStatsResponse) Reset()         { *m = QueryBlogStatsResponse{} }
+func (m *QueryBlogStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlogStatsResponse) ProtoMessage()    {}
+func (*QueryBlogStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5bb36fa4271d1d5, []int{11}
+}
+func (m *QueryBlogStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlogStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlogStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryBlogStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlogStatsResponse.Merge(m, src)
+}
+func (m *QueryBlogStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlogStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlogStatsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryShowPostRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryBlogStatsResponse proto.InternalMessageInfo
+
+func (m *QueryBlogStatsResponse) GetTotalPosts() uint64 {
+	if m != nil {
+		return m.TotalPosts
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryShowPostRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryBlogStatsResponse) GetTotalCreators() uint64 {
+	if m != nil {
+		return m.TotalCreators
+	}
+	return 0
 }
 
-func (m *QueryShowPostRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryBlogStatsResponse) GetTotalEdits() uint64 {
+	if m != nil {
+		return m.TotalEdits
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryShowPostResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryBlogStatsResponse) GetLastPostId() uint64 {
+	if m != nil {
+		return m.LastPostId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryShowPostResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryCreatorStatsRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *QueryShowPostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Post.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryCreatorStatsRequest) Reset()         { *m = QueryCreatorStatsRequest{} }
+func (m *QueryCreatorStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCreatorStatsRequest) ProtoMessage()    {}
+func (*QueryCreatorStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5bb36fa4271d1d5, []int{12}
+}
+func (m *QueryCreatorStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCreatorStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCreatorStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryCreatorStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCreatorStatsRequest.Merge(m, src)
+}
+func (m *QueryCreatorStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCreatorStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCreatorStatsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryListPostRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCreatorStatsRequest proto.InternalMessageInfo
+
+func (m *QueryCreatorStatsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryListPostRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryCreatorStatsResponse struct {
+	// post_count is the number of posts the address currently has.
+	PostCount uint64 `protobuf:"varint,1,opt,name=post_count,json=postCount,proto3" json:"post_count,omitempty"`
+	// edit_count is the number of updates applied to those posts.
+	EditCount uint64 `protobuf:"varint,2,opt,name=edit_count,json=editCount,proto3" json:"edit_count,omitempty"`
 }
 
-func (m *QueryListPostRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryCreatorStatsResponse) Reset()         { *m = QueryCreatorStatsResponse{} }
+func (m *QueryCreatorStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCreatorStatsResponse) ProtoMessage()    {}
+func (*QueryCreatorStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5bb36fa4271d1d5, []int{13}
+}
+func (m *QueryCreatorStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCreatorStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCreatorStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryCreatorStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCreatorStatsResponse.Merge(m, src)
+}
+func (m *QueryCreatorStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCreatorStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCreatorStatsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryListPostResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCreatorStatsResponse proto.InternalMessageInfo
+
+func (m *QueryCreatorStatsResponse) GetPostCount() uint64 {
+	if m != nil {
+		return m.PostCount
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryListPostResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryCreatorStatsResponse) GetEditCount() uint64 {
+	if m != nil {
+		return m.EditCount
+	}
+	return 0
 }
 
-func (m *QueryListPostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+type QueryProfileRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryProfileRequest) Reset()         { *m = QueryProfileRequest{} }
+func (m *QueryProfileRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProfileRequest) ProtoMessage()    {}
+func (*QueryProfileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5bb36fa4271d1d5, []int{14}
+}
+func (m *QueryProfileRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProfileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProfileRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Post) > 0 {
-		for iNdEx := len(m.Post) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Post[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+}
+func (m *QueryProfileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProfileRequest.Merge(m, src)
+}
+func (m *QueryProfileRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProfileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProfileRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProfileRequest proto.InternalMessageInfo
+
+func (m *QueryProfileRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type QueryProfileResponse struct {
+	Profile Profile `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile"`
+}
+
+func (m *QueryProfileResponse) Reset()         { *m = QueryProfileResponse{} }
+func (m *QueryProfileResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProfileResponse) ProtoMessage()    {}
+func (*QueryProfileResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5bb36fa4271d1d5, []int{15}
+}
+func (m *QueryProfileResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProfileResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProfileResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryProfileResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProfileResponse.Merge(m, src)
+}
+func (m *QueryProfileResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProfileResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProfileResponse.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryProfileResponse proto.InternalMessageInfo
+
+func (m *QueryProfileResponse) GetProfile() Profile {
+	if m != nil {
+		return m.Profile
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return Profile{}
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+type QueryProfileByNameRequest struct {
+	DisplayName string `protobuf:"bytes,1,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+}
+
+func (m *QueryProfileByNameRequest) Reset()         { *m = QueryProfileByNameRequest{} }
+func (m *QueryProfileByNameRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProfileByNameRequest) ProtoMessage()    {}
+func (*QueryProfileByNameRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5bb36fa4271d1d5, []int{16}
+}
+func (m *QueryProfileByNameRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProfileByNameRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProfileByNameRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *QueryProfileByNameRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProfileByNameRequest.Merge(m, src)
+}
+func (m *QueryProfileByNameRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProfileByNameRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProfileByNameRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryProfileByNameRequest proto.InternalMessageInfo
+
+func (m *QueryProfileByNameRequest) GetDisplayName() string {
+	if m != nil {
+		return m.DisplayName
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return ""
 }
 
-func (m *QueryShowPostRequest) Size() (n int) {
-	if m == nil {
-		return 0
+type QueryProfileByNameResponse struct {
+	Profile Profile `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile"`
+}
+
+func (m *QueryProfileByNameResponse) Reset()         { *m = QueryProfileByNameResponse{} }
+func (m *QueryProfileByNameResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProfileByNameResponse) ProtoMessage()    {}
+func (*QueryProfileByNameResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5bb36fa4271d1d5, []int{17}
+}
+func (m *QueryProfileByNameResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProfileByNameResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProfileByNameResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+}
+func (m *QueryProfileByNameResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProfileByNameResponse.Merge(m, src)
+}
+func (m *QueryProfileByNameResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProfileByNameResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProfileByNameResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProfileByNameResponse proto.InternalMessageInfo
+
+func (m *QueryProfileByNameResponse) GetProfile() Profile {
+	if m != nil {
+		return m.Profile
 	}
-	return n
+	return Profile{}
 }
 
-func (m *QueryShowPostResponse) Size() (n int) {
-	if m == nil {
-		return 0
+type QueryFollowersRequest struct {
+	Address    string             `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFollowersRequest) Reset()         { *m = QueryFollowersRequest{} }
+func (m *QueryFollowersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFollowersRequest) ProtoMessage()    {}
+func (*QueryFollowersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5bb36fa4271d1d5, []int{18}
+}
+func (m *QueryFollowersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFollowersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFollowersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = m.Post.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+}
+func (m *QueryFollowersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFollowersRequest.Merge(m, src)
+}
+func (m *QueryFollowersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFollowersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFollowersRequest.DiscardUnknown(m)
 }
 
-func (m *QueryListPostRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryFollowersRequest proto.InternalMessageInfo
+
+func (m *QueryFollowersRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+func (m *QueryFollowersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return n
+	return nil
 }
 
-func (m *QueryListPostResponse) Size() (n int) {
-	if m == nil {
-		return 0
+type QueryFollowersResponse struct {
+	Followers  []string            `protobuf:"bytes,1,rep,name=followers,proto3" json:"followers,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFollowersResponse) Reset()         { *m = QueryFollowersResponse{} }
+func (m *QueryFollowersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFollowersResponse) ProtoMessage()    {}
+func (*QueryFollowersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5bb36fa4271d1d5, []int{19}
+}
+func (m *QueryFollowersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFollowersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFollowersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if len(m.Post) > 0 {
-		for _, e := range m.Post {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryFollowersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFollowersResponse.Merge(m, src)
+}
+func (m *QueryFollowersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFollowersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFollowersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFollowersResponse proto.InternalMessageInfo
+
+func (m *QueryFollowersResponse) GetFollowers() []string {
+	if m != nil {
+		return m.Followers
+	}
+	return nil
+}
+
+func (m *QueryFollowersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryFollowingRequest struct {
+	Address    string             `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFollowingRequest) Reset()         { *m = QueryFollowingRequest{} }
+func (m *QueryFollowingRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFollowingRequest) ProtoMessage()    {}
+func (*QueryFollowingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5bb36fa4271d1d5, []int{20}
+}
+func (m *QueryFollowingRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFollowingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFollowingRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFollowingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFollowingRequest.Merge(m, src)
+}
+func (m *QueryFollowingRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFollowingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFollowingRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFollowingRequest proto.InternalMessageInfo
+
+func (m *QueryFollowingRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryFollowingRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryFollowingResponse struct {
+	Following  []string            `protobuf:"bytes,1,rep,name=following,proto3" json:"following,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFollowingResponse) Reset()         { *m = QueryFollowingResponse{} }
+func (m *QueryFollowingResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFollowingResponse) ProtoMessage()    {}
+func (*QueryFollowingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5bb36fa4271d1d5, []int{21}
+}
+func (m *QueryFollowingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFollowingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFollowingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFollowingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFollowingResponse.Merge(m, src)
+}
+func (m *QueryFollowingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFollowingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFollowingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFollowingResponse proto.InternalMessageInfo
+
+func (m *QueryFollowingResponse) GetFollowing() []string {
+	if m != nil {
+		return m.Following
+	}
+	return nil
+}
+
+func (m *QueryFollowingResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryFeedRequest pages through the feed with the key of the previous
+// response. Offsets, reverse order and totals are not supported.
+type QueryFeedRequest struct {
+	Address    string             `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFeedRequest) Reset()         { *m = QueryFeedRequest{} }
+func (m *QueryFeedRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeedRequest) ProtoMessage()    {}
+func (*QueryFeedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5bb36fa4271d1d5, []int{22}
+}
+func (m *QueryFeedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeedRequest.Merge(m, src)
+}
+func (m *QueryFeedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeedRequest proto.InternalMessageInfo
+
+func (m *QueryFeedRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryFeedRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryFeedResponse struct {
+	Posts      []Post              `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFeedResponse) Reset()         { *m = QueryFeedResponse{} }
+func (m *QueryFeedResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeedResponse) ProtoMessage()    {}
+func (*QueryFeedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5bb36fa4271d1d5, []int{23}
+}
+func (m *QueryFeedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeedResponse.Merge(m, src)
+}
+func (m *QueryFeedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeedResponse proto.InternalMessageInfo
+
+func (m *QueryFeedResponse) GetPosts() []Post {
+	if m != nil {
+		return m.Posts
+	}
+	return nil
+}
+
+func (m *QueryFeedResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryShowSeriesRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryShowSeriesRequest) Reset()         { *m = QueryShowSeriesRequest{} }
+func (m *QueryShowSeriesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryShowSeriesRequest) ProtoMessage()    {}
+func (*QueryShowSeriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5bb36fa4271d1d5, []int{24}
+}
+func (m *QueryShowSeriesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryShowSeriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryShowSeriesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryShowSeriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryShowSeriesRequest.Merge(m, src)
+}
+func (m *QueryShowSeriesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryShowSeriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryShowSeriesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryShowSeriesRequest proto.InternalMessageInfo
+
+func (m *QueryShowSeriesRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type QueryShowSeriesResponse struct {
+	Series Series `protobuf:"bytes,1,opt,name=series,proto3" json:"series"`
+}
+
+func (m *QueryShowSeriesResponse) Reset()         { *m = QueryShowSeriesResponse{} }
+func (m *QueryShowSeriesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryShowSeriesResponse) ProtoMessage()    {}
+func (*QueryShowSeriesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5bb36fa4271d1d5, []int{25}
+}
+func (m *QueryShowSeriesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryShowSeriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryShowSeriesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryShowSeriesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryShowSeriesResponse.Merge(m, src)
+}
+func (m *QueryShowSeriesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryShowSeriesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryShowSeriesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryShowSeriesResponse proto.InternalMessageInfo
+
+func (m *QueryShowSeriesResponse) GetSeries() Series {
+	if m != nil {
+		return m.Series
+	}
+	return Series{}
+}
+
+type QueryPostChangeProposalsRequest struct {
+	PostId uint64 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+}
+
+func (m *QueryPostChangeProposalsRequest) Reset()         { *m = QueryPostChangeProposalsRequest{} }
+func (m *QueryPostChangeProposalsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPostChangeProposalsRequest) ProtoMessage()    {}
+func (*QueryPostChangeProposalsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5bb36fa4271d1d5, []int{26}
+}
+func (m *QueryPostChangeProposalsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPostChangeProposalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPostChangeProposalsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPostChangeProposalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPostChangeProposalsRequest.Merge(m, src)
+}
+func (m *QueryPostChangeProposalsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPostChangeProposalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPostChangeProposalsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPostChangeProposalsRequest proto.InternalMessageInfo
+
+func (m *QueryPostChangeProposalsRequest) GetPostId() uint64 {
+	if m != nil {
+		return m.PostId
+	}
+	return 0
+}
+
+type QueryPostChangeProposalsResponse struct {
+	Proposals []PostChangeProposal `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals"`
+}
+
+func (m *QueryPostChangeProposalsResponse) Reset()         { *m = QueryPostChangeProposalsResponse{} }
+func (m *QueryPostChangeProposalsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPostChangeProposalsResponse) ProtoMessage()    {}
+func (*QueryPostChangeProposalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5bb36fa4271d1d5, []int{27}
+}
+func (m *QueryPostChangeProposalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPostChangeProposalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPostChangeProposalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPostChangeProposalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPostChangeProposalsResponse.Merge(m, src)
+}
+func (m *QueryPostChangeProposalsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPostChangeProposalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPostChangeProposalsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPostChangeProposalsResponse proto.InternalMessageInfo
+
+func (m *QueryPostChangeProposalsResponse) GetProposals() []PostChangeProposal {
+	if m != nil {
+		return m.Proposals
+	}
+	return nil
+}
+
+type QueryDeletedPostsRequest struct {
+	Creator    string             `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDeletedPostsRequest) Reset()         { *m = QueryDeletedPostsRequest{} }
+func (m *QueryDeletedPostsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDeletedPostsRequest) ProtoMessage()    {}
+func (*QueryDeletedPostsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5bb36fa4271d1d5, []int{28}
+}
+func (m *QueryDeletedPostsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeletedPostsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeletedPostsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDeletedPostsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeletedPostsRequest.Merge(m, src)
+}
+func (m *QueryDeletedPostsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeletedPostsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeletedPostsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDeletedPostsRequest proto.InternalMessageInfo
+
+func (m *QueryDeletedPostsRequest) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *QueryDeletedPostsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryDeletedPostsResponse struct {
+	Posts      []DeletedPost       `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDeletedPostsResponse) Reset()         { *m = QueryDeletedPostsResponse{} }
+func (m *QueryDeletedPostsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDeletedPostsResponse) ProtoMessage()    {}
+func (*QueryDeletedPostsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5bb36fa4271d1d5, []int{29}
+}
+func (m *QueryDeletedPostsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeletedPostsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeletedPostsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDeletedPostsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeletedPostsResponse.Merge(m, src)
+}
+func (m *QueryDeletedPostsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeletedPostsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeletedPostsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDeletedPostsResponse proto.InternalMessageInfo
+
+func (m *QueryDeletedPostsResponse) GetPosts() []DeletedPost {
+	if m != nil {
+		return m.Posts
+	}
+	return nil
+}
+
+func (m *QueryDeletedPostsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryRenderPostRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryRenderPostRequest) Reset()         { *m = QueryRenderPostRequest{} }
+func (m *QueryRenderPostRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRenderPostRequest) ProtoMessage()    {}
+func (*QueryRenderPostRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5bb36fa4271d1d5, []int{30}
+}
+func (m *QueryRenderPostRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRenderPostRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRenderPostRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRenderPostRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRenderPostRequest.Merge(m, src)
+}
+func (m *QueryRenderPostRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRenderPostRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRenderPostRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRenderPostRequest proto.InternalMessageInfo
+
+func (m *QueryRenderPostRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type QueryRenderPostResponse struct {
+	// html is the sanitized HTML rendering of the body. The same body always
+	// renders to the same HTML.
+	Html       string     `protobuf:"bytes,1,opt,name=html,proto3" json:"html,omitempty"`
+	BodyFormat BodyFormat `protobuf:"varint,2,opt,name=body_format,json=bodyFormat,proto3,enum=blog.blog.BodyFormat" json:"body_format,omitempty"`
+	// version is the version of the post that was rendered.
+	Version uint64 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (m *QueryRenderPostResponse) Reset()         { *m = QueryRenderPostResponse{} }
+func (m *QueryRenderPostResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRenderPostResponse) ProtoMessage()    {}
+func (*QueryRenderPostResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5bb36fa4271d1d5, []int{31}
+}
+func (m *QueryRenderPostResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRenderPostResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRenderPostResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRenderPostResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRenderPostResponse.Merge(m, src)
+}
+func (m *QueryRenderPostResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRenderPostResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRenderPostResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRenderPostResponse proto.InternalMessageInfo
+
+func (m *QueryRenderPostResponse) GetHtml() string {
+	if m != nil {
+		return m.Html
+	}
+	return ""
+}
+
+func (m *QueryRenderPostResponse) GetBodyFormat() BodyFormat {
+	if m != nil {
+		return m.BodyFormat
+	}
+	return BodyFormat_BODY_FORMAT_PLAIN
+}
+
+func (m *QueryRenderPostResponse) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+type QueryPostAtHeightRequest struct {
+	Id     uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Height int64  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueryPostAtHeightRequest) Reset()         { *m = QueryPostAtHeightRequest{} }
+func (m *QueryPostAtHeightRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPostAtHeightRequest) ProtoMessage()    {}
+func (*QueryPostAtHeightRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5bb36fa4271d1d5, []int{32}
+}
+func (m *QueryPostAtHeightRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPostAtHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPostAtHeightRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPostAtHeightRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPostAtHeightRequest.Merge(m, src)
+}
+func (m *QueryPostAtHeightRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPostAtHeightRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPostAtHeightRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPostAtHeightRequest proto.InternalMessageInfo
+
+func (m *QueryPostAtHeightRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *QueryPostAtHeightRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type QueryPostAtHeightResponse struct {
+	Post Post `protobuf:"bytes,1,opt,name=post,proto3" json:"post"`
+}
+
+func (m *QueryPostAtHeightResponse) Reset()         { *m = QueryPostAtHeightResponse{} }
+func (m *QueryPostAtHeightResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPostAtHeightResponse) ProtoMessage()    {}
+func (*QueryPostAtHeightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5bb36fa4271d1d5, []int{33}
+}
+func (m *QueryPostAtHeightResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPostAtHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPostAtHeightResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPostAtHeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPostAtHeightResponse.Merge(m, src)
+}
+func (m *QueryPostAtHeightResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPostAtHeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPostAtHeightResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPostAtHeightResponse proto.InternalMessageInfo
+
+func (m *QueryPostAtHeightResponse) GetPost() Post {
+	if m != nil {
+		return m.Post
+	}
+	return Post{}
+}
+
+type QueryPostTimelineRequest struct {
+	Id         uint64             `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPostTimelineRequest) Reset()         { *m = QueryPostTimelineRequest{} }
+func (m *QueryPostTimelineRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPostTimelineRequest) ProtoMessage()    {}
+func (*QueryPostTimelineRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5bb36fa4271d1d5, []int{34}
+}
+func (m *QueryPostTimelineRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPostTimelineRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPostTimelineRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPostTimelineRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPostTimelineRequest.Merge(m, src)
+}
+func (m *QueryPostTimelineRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPostTimelineRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPostTimelineRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPostTimelineRequest proto.InternalMessageInfo
+
+func (m *QueryPostTimelineRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *QueryPostTimelineRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryPostTimelineResponse struct {
+	Entries    []PostLogEntry      `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPostTimelineResponse) Reset()         { *m = QueryPostTimelineResponse{} }
+func (m *QueryPostTimelineResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPostTimelineResponse) ProtoMessage()    {}
+func (*QueryPostTimelineResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5bb36fa4271d1d5, []int{35}
+}
+func (m *QueryPostTimelineResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPostTimelineResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPostTimelineResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPostTimelineResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPostTimelineResponse.Merge(m, src)
+}
+func (m *QueryPostTimelineResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPostTimelineResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPostTimelineResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPostTimelineResponse proto.InternalMessageInfo
+
+func (m *QueryPostTimelineResponse) GetEntries() []PostLogEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+func (m *QueryPostTimelineResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryHasAccessRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Id      uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryHasAccessRequest) Reset()         { *m = QueryHasAccessRequest{} }
+func (m *QueryHasAccessRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHasAccessRequest) ProtoMessage()    {}
+func (*QueryHasAccessRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5bb36fa4271d1d5, []int{36}
+}
+func (m *QueryHasAccessRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHasAccessRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHasAccessRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHasAccessRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHasAccessRequest.Merge(m, src)
+}
+func (m *QueryHasAccessRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHasAccessRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHasAccessRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHasAccessRequest proto.InternalMessageInfo
+
+func (m *QueryHasAccessRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryHasAccessRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type QueryHasAccessResponse struct {
+	HasAccess bool `protobuf:"varint,1,opt,name=has_access,json=hasAccess,proto3" json:"has_access,omitempty"`
+	// purchased is set when the address bought access to the post.
+	Purchased bool `protobuf:"varint,2,opt,name=purchased,proto3" json:"purchased,omitempty"`
+	// has_envelope is set when the post holds a key envelope for the address.
+	// Purchasers without one wait for the creator to grant it.
+	HasEnvelope bool `protobuf:"varint,3,opt,name=has_envelope,json=hasEnvelope,proto3" json:"has_envelope,omitempty"`
+}
+
+func (m *QueryHasAccessResponse) Reset()         { *m = QueryHasAccessResponse{} }
+func (m *QueryHasAccessResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHasAccessResponse) ProtoMessage()    {}
+func (*QueryHasAccessResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5bb36fa4271d1d5, []int{37}
+}
+func (m *QueryHasAccessResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHasAccessResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHasAccessResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHasAccessResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHasAccessResponse.Merge(m, src)
+}
+func (m *QueryHasAccessResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHasAccessResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHasAccessResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHasAccessResponse proto.InternalMessageInfo
+
+func (m *QueryHasAccessResponse) GetHasAccess() bool {
+	if m != nil {
+		return m.HasAccess
+	}
+	return false
+}
+
+func (m *QueryHasAccessResponse) GetPurchased() bool {
+	if m != nil {
+		return m.Purchased
+	}
+	return false
+}
+
+func (m *QueryHasAccessResponse) GetHasEnvelope() bool {
+	if m != nil {
+		return m.HasEnvelope
+	}
+	return false
+}
+
+type QueryPurchasedPostsRequest struct {
+	Address    string             `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPurchasedPostsRequest) Reset()         { *m = QueryPurchasedPostsRequest{} }
+func (m *QueryPurchasedPostsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPurchasedPostsRequest) ProtoMessage()    {}
+func (*QueryPurchasedPostsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5bb36fa4271d1d5, []int{38}
+}
+func (m *QueryPurchasedPostsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPurchasedPostsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPurchasedPostsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPurchasedPostsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPurchasedPostsRequest.Merge(m, src)
+}
+func (m *QueryPurchasedPostsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPurchasedPostsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPurchasedPostsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPurchasedPostsRequest proto.InternalMessageInfo
+
+func (m *QueryPurchasedPostsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryPurchasedPostsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryPurchasedPostsResponse struct {
+	Entitlements []Entitlement       `protobuf:"bytes,1,rep,name=entitlements,proto3" json:"entitlements"`
+	Pagination   *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPurchasedPostsResponse) Reset()         { *m = QueryPurchasedPostsResponse{} }
+func (m *QueryPurchasedPostsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPurchasedPostsResponse) ProtoMessage()    {}
+func (*QueryPurchasedPostsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5bb36fa4271d1d5, []int{39}
+}
+func (m *QueryPurchasedPostsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPurchasedPostsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPurchasedPostsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPurchasedPostsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPurchasedPostsResponse.Merge(m, src)
+}
+func (m *QueryPurchasedPostsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPurchasedPostsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPurchasedPostsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPurchasedPostsResponse proto.InternalMessageInfo
+
+func (m *QueryPurchasedPostsResponse) GetEntitlements() []Entitlement {
+	if m != nil {
+		return m.Entitlements
+	}
+	return nil
+}
+
+func (m *QueryPurchasedPostsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryPostPurchasersRequest struct {
+	Id         uint64             `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// escrowed only lists the purchasers whose payment is still held in
+	// escrow, waiting for a key envelope.
+	Escrowed bool `protobuf:"varint,3,opt,name=escrowed,proto3" json:"escrowed,omitempty"`
+}
+
+func (m *QueryPostPurchasersRequest) Reset()         { *m = QueryPostPurchasersRequest{} }
+func (m *QueryPostPurchasersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPostPurchasersRequest) ProtoMessage()    {}
+func (*QueryPostPurchasersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5bb36fa4271d1d5, []int{40}
+}
+func (m *QueryPostPurchasersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPostPurchasersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPostPurchasersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPostPurchasersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPostPurchasersRequest.Merge(m, src)
+}
+func (m *QueryPostPurchasersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPostPurchasersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPostPurchasersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPostPurchasersRequest proto.InternalMessageInfo
+
+func (m *QueryPostPurchasersRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *QueryPostPurchasersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *QueryPostPurchasersRequest) GetEscrowed() bool {
+	if m != nil {
+		return m.Escrowed
+	}
+	return false
+}
+
+type QueryPostPurchasersResponse struct {
+	Purchasers []string            `protobuf:"bytes,1,rep,name=purchasers,proto3" json:"purchasers,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPostPurchasersResponse) Reset()         { *m = QueryPostPurchasersResponse{} }
+func (m *QueryPostPurchasersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPostPurchasersResponse) ProtoMessage()    {}
+func (*QueryPostPurchasersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5bb36fa4271d1d5, []int{41}
+}
+func (m *QueryPostPurchasersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPostPurchasersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPostPurchasersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPostPurchasersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPostPurchasersResponse.Merge(m, src)
+}
+func (m *QueryPostPurchasersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPostPurchasersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPostPurchasersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPostPurchasersResponse proto.InternalMessageInfo
+
+func (m *QueryPostPurchasersResponse) GetPurchasers() []string {
+	if m != nil {
+		return m.Purchasers
+	}
+	return nil
+}
+
+func (m *QueryPostPurchasersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("blog.blog.ListPostOrder", ListPostOrder_name, ListPostOrder_value)
+	proto.RegisterType((*QueryParamsRequest)(nil), "blog.blog.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "blog.blog.QueryParamsResponse")
+	proto.RegisterType((*QueryShowPostRequest)(nil), "blog.blog.QueryShowPostRequest")
+	proto.RegisterType((*QueryShowPostResponse)(nil), "blog.blog.QueryShowPostResponse")
+	proto.RegisterType((*QueryShowPostBySlugRequest)(nil), "blog.blog.QueryShowPostBySlugRequest")
+	proto.RegisterType((*QueryShowPostBySlugResponse)(nil), "blog.blog.QueryShowPostBySlugResponse")
+	proto.RegisterType((*QueryListPostRequest)(nil), "blog.blog.QueryListPostRequest")
+	proto.RegisterType((*QueryListPostResponse)(nil), "blog.blog.QueryListPostResponse")
+	proto.RegisterType((*QuerySearchPostsRequest)(nil), "blog.blog.QuerySearchPostsRequest")
+	proto.RegisterType((*QuerySearchPostsResponse)(nil), "blog.blog.QuerySearchPostsResponse")
+	proto.RegisterType((*QueryBlogStatsRequest)(nil), "blog.blog.QueryBlogStatsRequest")
+	proto.RegisterType((*QueryBlogStatsResponse)(nil), "blog.blog.QueryBlogStatsResponse")
+	proto.RegisterType((*QueryCreatorStatsRequest)(nil), "blog.blog.QueryCreatorStatsRequest")
+	proto.RegisterType((*QueryCreatorStatsResponse)(nil), "blog.blog.QueryCreatorStatsResponse")
+	proto.RegisterType((*QueryProfileRequest)(nil), "blog.blog.QueryProfileRequest")
+	proto.RegisterType((*QueryProfileResponse)(nil), "blog.blog.QueryProfileResponse")
+	proto.RegisterType((*QueryProfileByNameRequest)(nil), "blog.blog.QueryProfileByNameRequest")
+	proto.RegisterType((*QueryProfileByNameResponse)(nil), "blog.blog.QueryProfileByNameResponse")
+	proto.RegisterType((*QueryFollowersRequest)(nil), "blog.blog.QueryFollowersRequest")
+	proto.RegisterType((*QueryFollowersResponse)(nil), "blog.blog.QueryFollowersResponse")
+	proto.RegisterType((*QueryFollowingRequest)(nil), "blog.blog.QueryFollowingRequest")
+	proto.RegisterType((*QueryFollowingResponse)(nil), "blog.blog.QueryFollowingResponse")
+	proto.RegisterType((*QueryFeedRequest)(nil), "blog.blog.QueryFeedRequest")
+	proto.RegisterType((*QueryFeedResponse)(nil), "blog.blog.QueryFeedResponse")
+	proto.RegisterType((*QueryShowSeriesRequest)(nil), "blog.blog.QueryShowSeriesRequest")
+	proto.RegisterType((*QueryShowSeriesResponse)(nil), "blog.blog.QueryShowSeriesResponse")
+	proto.RegisterType((*QueryPostChangeProposalsRequest)(nil), "blog.blog.QueryPostChangeProposalsRequest")
+	proto.RegisterType((*QueryPostChangeProposalsResponse)(nil), "blog.blog.QueryPostChangeProposalsResponse")
+	proto.RegisterType((*QueryDeletedPostsRequest)(nil), "blog.blog.QueryDeletedPostsRequest")
+	proto.RegisterType((*QueryDeletedPostsResponse)(nil), "blog.blog.QueryDeletedPostsResponse")
+	proto.RegisterType((*QueryRenderPostRequest)(nil), "blog.blog.QueryRenderPostRequest")
+	proto.RegisterType((*QueryRenderPostResponse)(nil), "blog.blog.QueryRenderPostResponse")
+	proto.RegisterType((*QueryPostAtHeightRequest)(nil), "blog.blog.QueryPostAtHeightRequest")
+	proto.RegisterType((*QueryPostAtHeightResponse)(nil), "blog.blog.QueryPostAtHeightResponse")
+	proto.RegisterType((*QueryPostTimelineRequest)(nil), "blog.blog.QueryPostTimelineRequest")
+	proto.RegisterType((*QueryPostTimelineResponse)(nil), "blog.blog.QueryPostTimelineResponse")
+	proto.RegisterType((*QueryHasAccessRequest)(nil), "blog.blog.QueryHasAccessRequest")
+	proto.RegisterType((*QueryHasAccessResponse)(nil), "blog.blog.QueryHasAccessResponse")
+	proto.RegisterType((*QueryPurchasedPostsRequest)(nil), "blog.blog.QueryPurchasedPostsRequest")
+	proto.RegisterType((*QueryPurchasedPostsResponse)(nil), "blog.blog.QueryPurchasedPostsResponse")
+	proto.RegisterType((*QueryPostPurchasersRequest)(nil), "blog.blog.QueryPostPurchasersRequest")
+	proto.RegisterType((*QueryPostPurchasersResponse)(nil), "blog.blog.QueryPostPurchasersResponse")
+}
+
+func init() { proto.RegisterFile("blog/blog/query.proto", fileDescriptor_a5bb36fa4271d1d5) }
+
+var fileDescriptor_a5bb36fa4271d1d5 = []byte{
+	// 2221 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0xcd, 0x6f, 0xe4, 0x48,
+	0x15, 0x8f, 0x93, 0xce, 0x47, 0xbf, 0x7c, 0x6c, 0x52, 0x93, 0x49, 0x3a, 0x4e, 0xd2, 0x1f, 0x9e,
+	0x24, 0x93, 0x49, 0x56, 0x6d, 0x26, 0x8c, 0x40, 0x70, 0x40, 0x24, 0x93, 0x64, 0x77, 0x96, 0xd1,
+	0x4e, 0x70, 0xc2, 0x01, 0x2e, 0x96, 0xbb, 0x5d, 0xe9, 0x36, 0x74, 0xdb, 0xbd, 0xb6, 0x7b, 0x32,
+	0xad, 0x90, 0x5d, 0x84, 0x04, 0x02, 0x04, 0x62, 0x24, 0x2e, 0x5c, 0xb8, 0xc0, 0x65, 0x8e, 0xfc,
+	0x0b, 0xdc, 0xf6, 0xb8, 0x12, 0x07, 0x38, 0x01, 0x9a, 0x41, 0xe2, 0xca, 0x9f, 0x80, 0xaa, 0xea,
+	0xd9, 0xed, 0xaf, 0xee, 0x64, 0x21, 0xc3, 0x5e, 0x5a, 0xf6, 0xf3, 0xab, 0xf7, 0xfb, 0xbd, 0x57,
+	0xef, 0x55, 0xd5, 0xab, 0x86, 0xbb, 0xb5, 0x96, 0xd3, 0x50, 0xf9, 0xcf, 0x47, 0x5d, 0xea, 0xf6,
+	0xaa, 0x1d, 0xd7, 0xf1, 0x1d, 0x92, 0x67, 0x92, 0x2a, 0xfb, 0x91, 0x17, 0x8c, 0xb6, 0x65, 0x3b,
+	0x2a, 0xff, 0x15, 0x5f, 0xe5, 0xc5, 0x86, 0xd3, 0x70, 0xf8, 0xa3, 0xca, 0x9e, 0x50, 0xba, 0xd6,
+	0x70, 0x9c, 0x46, 0x8b, 0xaa, 0x46, 0xc7, 0x52, 0x0d, 0xdb, 0x76, 0x7c, 0xc3, 0xb7, 0x1c, 0xdb,
+	0xc3, 0xaf, 0x25, 0xfc, 0xca, 0xdf, 0x6a, 0xdd, 0x73, 0xd5, 0xb7, 0xda, 0xd4, 0xf3, 0x8d, 0x76,
+	0x07, 0x15, 0x76, 0xea, 0x8e, 0xd7, 0x76, 0x3c, 0xb5, 0x66, 0x78, 0x54, 0x70, 0x51, 0x9f, 0x3f,
+	0xac, 0x51, 0xdf, 0x78, 0xa8, 0x76, 0x8c, 0x86, 0x65, 0x73, 0x6b, 0xa8, 0xbb, 0xd4, 0x67, 0xdd,
+	0x31, 0x5c, 0xa3, 0x1d, 0x80, 0x2c, 0x46, 0xe4, 0x8e, 0xe7, 0xa3, 0x74, 0x39, 0x22, 0x75, 0x9d,
+	0x73, 0xab, 0x45, 0xd3, 0x66, 0x3c, 0xea, 0x5a, 0x34, 0x30, 0xb3, 0x1a, 0x37, 0xa3, 0xd7, 0x9b,
+	0x86, 0xdd, 0x08, 0x06, 0x45, 0x22, 0xe6, 0xbb, 0x86, 0xd7, 0x44, 0x71, 0x21, 0x31, 0x86, 0xc5,
+	0x2f, 0x65, 0x8d, 0xda, 0xbe, 0xe5, 0xb7, 0x68, 0x9b, 0xda, 0xc8, 0x4d, 0x59, 0x04, 0xf2, 0x6d,
+	0xe6, 0xeb, 0x09, 0x77, 0x43, 0xa3, 0x1f, 0x75, 0xa9, 0xe7, 0x2b, 0xdf, 0x82, 0x3b, 0x31, 0xa9,
+	0xd7, 0x71, 0x6c, 0x8f, 0x92, 0x47, 0x30, 0x21, 0xdc, 0x2d, 0x48, 0x65, 0x69, 0x7b, 0x7a, 0x6f,
+	0xa1, 0x1a, 0x4e, 0x53, 0x55, 0xa8, 0x1e, 0xe4, 0x3f, 0xfd, 0x5b, 0x69, 0xe4, 0xd5, 0xbf, 0xfe,
+	0xb8, 0x23, 0x69, 0xa8, 0xab, 0x3c, 0x83, 0x45, 0x6e, 0xec, 0xb4, 0xe9, 0x5c, 0x9c, 0x38, 0x9e,
+	0x8f, 0x20, 0x64, 0x0e, 0x46, 0x2d, 0x93, 0x5b, 0xca, 0x69, 0xa3, 0x96, 0x49, 0xee, 0xc3, 0x3b,
+	0x96, 0x5d, 0x6f, 0x75, 0x4d, 0xaa, 0x63, 0x98, 0x0a, 0xa3, 0x65, 0x69, 0x7b, 0x4a, 0x9b, 0x43,
+	0xf1, 0x89, 0x90, 0x2a, 0x7f, 0x91, 0xe0, 0x6e, 0xc2, 0x22, 0x12, 0x7c, 0x00, 0x39, 0xe6, 0x3c,
+	0xd2, 0x7b, 0x27, 0x4a, 0xcf, 0xf1, 0xfc, 0x83, 0x1c, 0x23, 0xa7, 0x71, 0x15, 0xb2, 0x0e, 0x40,
+	0x4d, 0xcb, 0xd7, 0xeb, 0x4e, 0xd7, 0xf6, 0x39, 0x50, 0x4e, 0xcb, 0x33, 0xc9, 0x63, 0x26, 0x20,
+	0x5f, 0x83, 0x39, 0xa3, 0xeb, 0x37, 0x1d, 0x37, 0xe4, 0x32, 0xc6, 0x6d, 0x92, 0xa8, 0x4d, 0xf1,
+	0x45, 0x9b, 0x15, 0x9a, 0xf8, 0xca, 0x2c, 0x8b, 0xd9, 0xd4, 0x2d, 0xd3, 0x2b, 0xe4, 0xca, 0x63,
+	0xcc, 0xb2, 0x90, 0x3c, 0x31, 0x3d, 0x22, 0xc3, 0x94, 0x4b, 0x9f, 0x5b, 0x9e, 0xe5, 0xd8, 0x85,
+	0xf1, 0xb2, 0xb4, 0x9d, 0xd7, 0xc2, 0x77, 0xe5, 0x4b, 0x20, 0xc7, 0x1c, 0x3b, 0xe8, 0x9d, 0xb6,
+	0xba, 0x8d, 0x20, 0x60, 0x04, 0x72, 0x5e, 0xab, 0xdb, 0xe0, 0xde, 0xe5, 0x35, 0xfe, 0xac, 0x98,
+	0xb0, 0x9a, 0x39, 0xe2, 0xf3, 0x07, 0x84, 0xf3, 0x32, 0x2d, 0x97, 0xd6, 0x7d, 0x8c, 0x7b, 0xf8,
+	0xae, 0xbc, 0xca, 0xe1, 0x1c, 0x3e, 0xb5, 0x3c, 0x3f, 0x3a, 0x87, 0xc7, 0x00, 0xfd, 0xe2, 0x40,
+	0x94, 0xad, 0xaa, 0xa8, 0xa4, 0x2a, 0xab, 0xa4, 0xaa, 0xa8, 0x6a, 0xac, 0xa4, 0xea, 0x89, 0xd1,
+	0xa0, 0x38, 0x56, 0x8b, 0x8c, 0x24, 0x05, 0x98, 0xac, 0xbb, 0xd4, 0xf0, 0x1d, 0x97, 0x63, 0xe7,
+	0xb5, 0xe0, 0x95, 0x1c, 0xc1, 0x2c, 0x7f, 0xa4, 0xa6, 0x6e, 0x9c, 0xfb, 0xd4, 0xc5, 0x79, 0x90,
+	0xab, 0xa2, 0x9e, 0xab, 0x41, 0x3d, 0x57, 0xcf, 0x82, 0x7a, 0x3e, 0xc8, 0xbd, 0xfc, 0x7b, 0x49,
+	0xd2, 0x66, 0x70, 0xd8, 0x3e, 0x1b, 0x45, 0xde, 0x83, 0xb9, 0xc0, 0x4c, 0x8d, 0x9e, 0x3b, 0x2e,
+	0x2d, 0xe4, 0x6e, 0x68, 0x27, 0x80, 0x3f, 0xe0, 0xc3, 0x18, 0x9f, 0x6e, 0xc7, 0x8c, 0xf0, 0x19,
+	0xbf, 0x29, 0x1f, 0x1c, 0x16, 0xf2, 0x09, 0xcc, 0x20, 0x9f, 0x89, 0x9b, 0xf2, 0xc1, 0x71, 0xc8,
+	0xa7, 0x02, 0x33, 0xbc, 0xa6, 0xf5, 0x8e, 0x4b, 0xcf, 0xad, 0x17, 0x85, 0x49, 0x1e, 0xbe, 0x69,
+	0x2e, 0x3b, 0xe1, 0x22, 0x52, 0x85, 0x71, 0xc7, 0x35, 0xa9, 0x5b, 0x98, 0x2a, 0x4b, 0xdb, 0x73,
+	0x7b, 0x85, 0x48, 0x16, 0x04, 0xf3, 0xf9, 0x8c, 0x7d, 0xd7, 0x84, 0x1a, 0x79, 0x00, 0xf3, 0x89,
+	0x42, 0xf4, 0x0a, 0x79, 0x9e, 0x11, 0xef, 0xc4, 0x2b, 0xd1, 0x23, 0xf3, 0x30, 0xe6, 0x1b, 0x8d,
+	0x02, 0x70, 0x50, 0xf6, 0xa8, 0xfc, 0x29, 0x28, 0xce, 0x7e, 0xaa, 0xa4, 0x72, 0x71, 0xec, 0xba,
+	0x5c, 0x7c, 0x2f, 0x96, 0x56, 0xa3, 0x3c, 0x32, 0xf7, 0xaf, 0x4d, 0x2b, 0x81, 0x13, 0xcb, 0xab,
+	0x47, 0x30, 0x15, 0xba, 0x30, 0x56, 0x1e, 0xcb, 0x2e, 0x60, 0x84, 0x0e, 0x35, 0x95, 0x0b, 0x58,
+	0x16, 0x45, 0x45, 0x0d, 0xb7, 0xde, 0x64, 0xec, 0x82, 0x95, 0x91, 0x2c, 0xc2, 0x38, 0x87, 0xc6,
+	0x22, 0x14, 0x2f, 0xe4, 0x38, 0x83, 0xef, 0x7f, 0x51, 0x06, 0xca, 0x4b, 0x09, 0x0a, 0x69, 0x64,
+	0x8c, 0xdf, 0x2e, 0x8c, 0xb3, 0xe0, 0x78, 0xc3, 0x03, 0x28, 0x74, 0x6e, 0x2d, 0x82, 0xca, 0x32,
+	0x4e, 0xe7, 0x41, 0xcb, 0x69, 0x9c, 0xfa, 0x46, 0x18, 0x09, 0xe5, 0x0f, 0x12, 0x2c, 0x25, 0xbf,
+	0x20, 0xd3, 0x12, 0x4c, 0xfb, 0x8e, 0x6f, 0xb4, 0xf4, 0x80, 0x2f, 0x5b, 0x5c, 0x81, 0x8b, 0xb8,
+	0x4b, 0x64, 0x13, 0xe6, 0x84, 0x02, 0x56, 0xb9, 0x87, 0x0b, 0xf0, 0x2c, 0x97, 0x3e, 0x46, 0x61,
+	0xdf, 0x0e, 0x5b, 0x97, 0xbd, 0xc2, 0x58, 0xc4, 0xce, 0x11, 0x93, 0x90, 0x32, 0xcc, 0xb4, 0x0c,
+	0xcf, 0xe7, 0x38, 0xba, 0x65, 0xf2, 0x9a, 0xce, 0x69, 0xc0, 0x64, 0x0c, 0xe8, 0x89, 0xa9, 0x3c,
+	0xc2, 0x80, 0xa2, 0xcd, 0xa8, 0x07, 0x6c, 0xd1, 0x31, 0x4c, 0xd3, 0xa5, 0x9e, 0x87, 0xb3, 0x19,
+	0xbc, 0x2a, 0xdf, 0x85, 0x95, 0x8c, 0x51, 0xe8, 0xdd, 0x3a, 0x80, 0xd8, 0x95, 0xf9, 0xce, 0x21,
+	0x9c, 0xcb, 0x33, 0x89, 0xd8, 0x39, 0x86, 0x6f, 0x2c, 0x8a, 0x1a, 0x6c, 0xad, 0xb8, 0x79, 0x5c,
+	0xcb, 0xe5, 0x03, 0x58, 0x8c, 0x0f, 0x40, 0x1a, 0x7b, 0x30, 0x19, 0x6c, 0x4d, 0xd2, 0xa0, 0xad,
+	0x09, 0x73, 0x22, 0x50, 0x54, 0xbe, 0x81, 0x7e, 0x05, 0x9f, 0x7b, 0x1f, 0x1a, 0xed, 0x90, 0x42,
+	0x05, 0x66, 0x4c, 0xcb, 0xeb, 0xb4, 0x8c, 0x9e, 0x6e, 0x1b, 0x6d, 0x8a, 0x3c, 0xa6, 0x51, 0xc6,
+	0x34, 0x95, 0x13, 0x90, 0xb3, 0xc6, 0xff, 0x0f, 0x8c, 0x7a, 0x98, 0x5e, 0xc7, 0x4e, 0xab, 0xe5,
+	0x5c, 0x50, 0xf7, 0xfa, 0xc9, 0xb9, 0xb5, 0x62, 0xfb, 0x04, 0x96, 0x92, 0xd0, 0xe8, 0xc8, 0x1a,
+	0xe4, 0xcf, 0x03, 0x21, 0xaf, 0xb6, 0xbc, 0xd6, 0x17, 0xdc, 0x5e, 0x69, 0xc5, 0x7d, 0xb7, 0xec,
+	0xc6, 0x17, 0xe5, 0x3b, 0x87, 0x4e, 0xfa, 0x6e, 0xd9, 0x8d, 0xb8, 0xef, 0x96, 0xdd, 0xb8, 0x3d,
+	0xdf, 0x7d, 0x98, 0x17, 0x04, 0x28, 0x35, 0xff, 0x7f, 0x6e, 0xff, 0x5c, 0x82, 0x85, 0x08, 0xec,
+	0x17, 0xba, 0xb0, 0x6e, 0xc3, 0x52, 0x78, 0x72, 0x3b, 0xe5, 0xa7, 0xc3, 0x01, 0x07, 0x63, 0xe5,
+	0x03, 0x58, 0x4e, 0x69, 0x22, 0x75, 0x15, 0x26, 0xc4, 0xc9, 0x32, 0xe3, 0x44, 0x2e, 0x54, 0x91,
+	0x3d, 0xaa, 0x29, 0x5f, 0x87, 0x92, 0xa8, 0x60, 0xb6, 0x5e, 0xf1, 0xb6, 0xe2, 0xc4, 0x75, 0x3a,
+	0x8e, 0x67, 0xb4, 0x42, 0xf8, 0x65, 0x98, 0x0c, 0xd6, 0x53, 0xc1, 0x61, 0xa2, 0x23, 0xd6, 0x52,
+	0x0a, 0xe5, 0xc1, 0x63, 0x91, 0xd0, 0x3e, 0xe4, 0x3b, 0x81, 0x10, 0xe3, 0xb9, 0x9e, 0x88, 0x67,
+	0x7c, 0x28, 0xf2, 0xeb, 0x8f, 0x52, 0x7e, 0x88, 0x4b, 0xf6, 0x21, 0x6d, 0x51, 0x9f, 0x9a, 0xb1,
+	0xed, 0x37, 0x72, 0x4e, 0x94, 0xe2, 0xe7, 0xc4, 0xdb, 0x4a, 0x91, 0xdf, 0x4a, 0xb0, 0x92, 0x01,
+	0x1f, 0x2e, 0x71, 0xb1, 0x54, 0x59, 0x8a, 0xb8, 0x16, 0xd1, 0x7f, 0xcb, 0x19, 0xa3, 0x51, 0xdb,
+	0xa4, 0xee, 0x90, 0x56, 0x4a, 0xf9, 0x04, 0x96, 0x53, 0x9a, 0xe8, 0x01, 0x81, 0x5c, 0xd3, 0x6f,
+	0xb7, 0x82, 0x26, 0x82, 0x3d, 0x93, 0xaf, 0xc0, 0x74, 0xcd, 0x31, 0x7b, 0xfa, 0xb9, 0xe3, 0xb6,
+	0x0d, 0xb1, 0x67, 0xcd, 0xed, 0xdd, 0x8d, 0xf8, 0x76, 0xe0, 0x98, 0xbd, 0x63, 0xfe, 0x51, 0x83,
+	0x5a, 0xf8, 0xcc, 0x66, 0xe3, 0x39, 0x75, 0x79, 0x27, 0x23, 0xf6, 0xe6, 0xe0, 0x55, 0x39, 0xc0,
+	0x39, 0x64, 0xd0, 0xfb, 0xfe, 0xfb, 0xd4, 0x6a, 0x34, 0x07, 0xf6, 0x7d, 0x4b, 0x30, 0xd1, 0xe4,
+	0x0a, 0x1c, 0x78, 0x4c, 0xc3, 0x37, 0xe5, 0x18, 0x56, 0x32, 0x6c, 0x7c, 0xee, 0xc6, 0x46, 0x71,
+	0x23, 0x5c, 0xd8, 0x61, 0xba, 0x65, 0xd9, 0x74, 0x10, 0x97, 0xdb, 0xca, 0xa2, 0xdf, 0x49, 0xb0,
+	0x92, 0x01, 0x8a, 0xe4, 0xbf, 0x0a, 0x93, 0xd4, 0xf6, 0xb1, 0x6c, 0x59, 0x1e, 0x2d, 0x27, 0xf8,
+	0x3f, 0x75, 0x1a, 0x47, 0xb6, 0xef, 0xf6, 0x82, 0xdd, 0x12, 0xb5, 0x6f, 0x2f, 0x95, 0xf6, 0x71,
+	0xeb, 0x79, 0xdf, 0xf0, 0xf6, 0xeb, 0x75, 0xea, 0xdd, 0x60, 0xdb, 0x15, 0xa1, 0x1a, 0x0d, 0x73,
+	0xec, 0x05, 0x2c, 0x25, 0x4d, 0xf4, 0x0f, 0x48, 0x4d, 0xc3, 0xd3, 0x8d, 0x7a, 0x3d, 0x30, 0x33,
+	0xa5, 0xe5, 0x9b, 0x81, 0x1a, 0xdb, 0x61, 0x3a, 0x5d, 0xb7, 0xde, 0x34, 0x3c, 0x6a, 0x62, 0xa7,
+	0xd9, 0x17, 0xb0, 0x53, 0x08, 0x1b, 0x4c, 0xed, 0xe7, 0xb4, 0xe5, 0x74, 0x44, 0xdb, 0x3d, 0xa5,
+	0x4d, 0x37, 0x0d, 0xef, 0x08, 0x45, 0xca, 0xc7, 0xc1, 0x29, 0x24, 0x18, 0x94, 0x5c, 0x22, 0xde,
+	0xf2, 0x2e, 0xf2, 0x4a, 0x82, 0xd5, 0x4c, 0x02, 0xe8, 0xff, 0x37, 0x61, 0x26, 0x72, 0xd1, 0x92,
+	0xb5, 0x56, 0x1c, 0xf5, 0x3f, 0xe3, 0x14, 0xc7, 0x46, 0xdc, 0xde, 0x3c, 0xbf, 0x94, 0x82, 0x58,
+	0x39, 0x9e, 0x1f, 0xd0, 0x75, 0xbd, 0xb7, 0x9c, 0xfe, 0xec, 0x2e, 0x81, 0x7a, 0x75, 0xd7, 0xb9,
+	0xa0, 0x26, 0x4e, 0x60, 0xf8, 0xae, 0xfc, 0x34, 0x8c, 0x5e, 0x82, 0x12, 0x46, 0xaf, 0x08, 0xd0,
+	0x09, 0xa5, 0x78, 0x02, 0x89, 0x48, 0x6e, 0x2d, 0x36, 0x3b, 0x1f, 0xc2, 0x6c, 0xac, 0xfd, 0x25,
+	0x4b, 0x40, 0x9e, 0x3e, 0x39, 0x3d, 0xd3, 0x4f, 0x9e, 0x9d, 0x9e, 0xe9, 0xcf, 0xb4, 0xc3, 0x23,
+	0x4d, 0x7f, 0x72, 0x38, 0x3f, 0x42, 0x36, 0xa1, 0x92, 0x94, 0x3f, 0xdd, 0x3f, 0x3d, 0xd3, 0xbf,
+	0x73, 0x72, 0xb8, 0x7f, 0x76, 0x74, 0xa8, 0x1f, 0x1e, 0x9d, 0x3e, 0x9e, 0x97, 0xf6, 0xfe, 0xbd,
+	0x08, 0xe3, 0xdc, 0x31, 0x52, 0x83, 0x09, 0x71, 0x1d, 0x46, 0xa2, 0x7b, 0x5f, 0xfa, 0x9e, 0x4d,
+	0x2e, 0x0e, 0xfa, 0x2c, 0xe8, 0x2a, 0x2b, 0x3f, 0xfe, 0xf3, 0x3f, 0x7f, 0x33, 0x7a, 0x87, 0x2c,
+	0xa8, 0xc9, 0x0b, 0x47, 0xd2, 0x81, 0xa9, 0xe0, 0xce, 0x87, 0x94, 0x92, 0x66, 0x12, 0x57, 0x6d,
+	0x72, 0x79, 0xb0, 0x02, 0x22, 0x55, 0x38, 0xd2, 0x2a, 0x59, 0x89, 0x20, 0x79, 0x4d, 0xe7, 0x82,
+	0xb7, 0x56, 0xea, 0xa5, 0x65, 0x5e, 0x91, 0x1f, 0xc0, 0x54, 0x10, 0xaf, 0x34, 0x62, 0xe2, 0x62,
+	0x48, 0x2e, 0x0f, 0x56, 0x40, 0xc4, 0x35, 0x8e, 0xb8, 0x44, 0x16, 0x23, 0x88, 0x2d, 0x0b, 0x9b,
+	0x39, 0x72, 0x01, 0xd3, 0x91, 0x1e, 0x98, 0x28, 0x29, 0x07, 0x52, 0xad, 0xb9, 0x7c, 0x6f, 0xa8,
+	0x0e, 0xa2, 0x96, 0x38, 0xea, 0x0a, 0x59, 0x8e, 0xfa, 0xc9, 0xf5, 0x74, 0xb1, 0x5b, 0xff, 0x52,
+	0x82, 0xb9, 0xf8, 0x65, 0x1a, 0xd9, 0x1c, 0x14, 0xbd, 0xd8, 0xf5, 0x9c, 0xbc, 0x75, 0x9d, 0x1a,
+	0x52, 0xd8, 0xe5, 0x14, 0x36, 0xc9, 0xbd, 0xac, 0x50, 0xeb, 0xb5, 0x9e, 0xce, 0x2e, 0xf6, 0xd4,
+	0x4b, 0xf6, 0x7b, 0x45, 0x3a, 0x30, 0x19, 0xdc, 0x2b, 0xa6, 0x93, 0x25, 0xd6, 0x42, 0xca, 0xa5,
+	0x81, 0xdf, 0x11, 0x78, 0x83, 0x03, 0x17, 0xc9, 0x9a, 0x9a, 0xba, 0x90, 0x56, 0x2f, 0x71, 0x91,
+	0xbc, 0x22, 0xbf, 0x96, 0x60, 0x36, 0xd6, 0xdf, 0x91, 0x8d, 0x01, 0x86, 0x63, 0xed, 0xa3, 0xbc,
+	0x79, 0x8d, 0x16, 0x92, 0x78, 0xc8, 0x49, 0xec, 0x92, 0x07, 0x69, 0x12, 0x7a, 0x4d, 0x74, 0x9e,
+	0xea, 0x65, 0xb4, 0x0f, 0xbd, 0x22, 0xdf, 0x87, 0x7c, 0x78, 0xc7, 0x40, 0x52, 0x89, 0x95, 0xbc,
+	0x98, 0x90, 0x2b, 0x43, 0x34, 0x90, 0x44, 0x81, 0x93, 0x20, 0x64, 0x3e, 0x3a, 0x05, 0xdc, 0xfc,
+	0x4f, 0x24, 0x98, 0x89, 0x76, 0xfd, 0x24, 0x95, 0x55, 0x19, 0x37, 0x09, 0xf2, 0xc6, 0x70, 0x25,
+	0x44, 0xdd, 0xe1, 0xa8, 0x1b, 0x44, 0x89, 0xa0, 0xe2, 0xf1, 0x55, 0xe7, 0xe8, 0x91, 0x59, 0x78,
+	0x01, 0xd0, 0x3f, 0xee, 0x93, 0x4a, 0x56, 0x6a, 0xc5, 0x9a, 0x06, 0x59, 0x19, 0xa6, 0x82, 0x04,
+	0xee, 0x71, 0x02, 0xeb, 0x64, 0x35, 0x99, 0x79, 0xa2, 0x39, 0x10, 0x65, 0xfe, 0x7b, 0x09, 0xee,
+	0x64, 0x9c, 0xf0, 0xc9, 0x4e, 0x6a, 0x7e, 0x07, 0xb6, 0x10, 0xf2, 0xee, 0x8d, 0x74, 0x91, 0xd5,
+	0x1e, 0x67, 0xf5, 0x2e, 0xd9, 0x51, 0x33, 0xff, 0xf6, 0xd0, 0xc3, 0xce, 0x40, 0xbd, 0xc4, 0xbe,
+	0xe4, 0x8a, 0x4f, 0x53, 0xf4, 0x80, 0x9e, 0x9e, 0xa6, 0x8c, 0xee, 0x41, 0xde, 0x18, 0xae, 0x34,
+	0x64, 0x9a, 0x4c, 0xa1, 0x28, 0xd6, 0x08, 0xf5, 0x12, 0x67, 0x8d, 0x4d, 0x53, 0x3e, 0xbc, 0x3e,
+	0x48, 0xa7, 0x66, 0xf2, 0x52, 0x43, 0xae, 0x0c, 0xd1, 0x40, 0xf4, 0x2d, 0x8e, 0x5e, 0x26, 0xc5,
+	0x08, 0x7a, 0x78, 0xf7, 0x10, 0x4b, 0x90, 0x7c, 0xd8, 0xbc, 0x0f, 0x42, 0xee, 0x5f, 0x29, 0xc8,
+	0x95, 0x21, 0x1a, 0xd7, 0x22, 0x5b, 0x76, 0x23, 0x82, 0x6c, 0x42, 0x8e, 0xb5, 0xcf, 0x64, 0x35,
+	0x65, 0xb2, 0xdf, 0xcb, 0xcb, 0x6b, 0xd9, 0x1f, 0x87, 0xec, 0x36, 0xe7, 0x94, 0x9a, 0xf1, 0x02,
+	0xe8, 0x77, 0x2f, 0xe9, 0x02, 0x48, 0xf5, 0x40, 0xb2, 0x32, 0x4c, 0x65, 0x48, 0x01, 0xb8, 0x5c,
+	0x2d, 0xb2, 0xcf, 0xfd, 0x42, 0x82, 0x99, 0x68, 0xcf, 0x91, 0xce, 0xad, 0x8c, 0xae, 0x46, 0xde,
+	0x18, 0xae, 0x84, 0x04, 0x54, 0x4e, 0xe0, 0x01, 0xb9, 0x9f, 0xcc, 0x75, 0xc3, 0xd7, 0x45, 0xdb,
+	0xc3, 0x39, 0xa8, 0x97, 0xe2, 0xe5, 0x8a, 0xfc, 0x08, 0xc9, 0x04, 0x3d, 0x44, 0x36, 0x99, 0x44,
+	0x5b, 0x23, 0x6f, 0x0c, 0x57, 0x42, 0x32, 0x9b, 0x9c, 0x4c, 0x89, 0xac, 0x27, 0xc9, 0xf8, 0xa8,
+	0x29, 0xe2, 0xf1, 0x31, 0xe4, 0xc3, 0x33, 0x7e, 0x3a, 0xd3, 0x92, 0x1d, 0x84, 0x5c, 0x19, 0xa2,
+	0x81, 0xc0, 0xef, 0x72, 0xe0, 0x2d, 0xb2, 0x11, 0x01, 0xee, 0x77, 0x0c, 0xfd, 0x24, 0x10, 0xf8,
+	0xbf, 0x92, 0x60, 0x2e, 0x7e, 0xd2, 0x4e, 0xef, 0xc8, 0x99, 0xad, 0x80, 0xbc, 0x75, 0x9d, 0xda,
+	0x10, 0x3e, 0x61, 0x47, 0x12, 0xd4, 0x7c, 0x98, 0x99, 0x3f, 0x63, 0x7c, 0x62, 0x67, 0xd7, 0x0c,
+	0x3e, 0x59, 0xc7, 0x6d, 0x79, 0xeb, 0x3a, 0x35, 0xe4, 0x73, 0x9f, 0xf3, 0xa9, 0x90, 0x52, 0x72,
+	0x62, 0xfa, 0xc7, 0x60, 0x1e, 0x9a, 0x83, 0xdd, 0x4f, 0x5f, 0x17, 0xa5, 0xcf, 0x5e, 0x17, 0xa5,
+	0x7f, 0xbc, 0x2e, 0x4a, 0x2f, 0xdf, 0x14, 0x47, 0x3e, 0x7b, 0x53, 0x1c, 0xf9, 0xeb, 0x9b, 0xe2,
+	0xc8, 0xf7, 0x16, 0xf8, 0xa0, 0x17, 0x62, 0xac, 0xdf, 0xeb, 0x50, 0xaf, 0x36, 0xc1, 0xff, 0x52,
+	0xfa, 0xf2, 0x7f, 0x06, 0x00, 0xb3, 0x9f, 0x59, 0xf5, 0x73, 0x1f, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries a list of ShowPost items.
+	ShowPost(ctx context.Context, in *QueryShowPostRequest, opts ...grpc.CallOption) (*QueryShowPostResponse, error)
+	// Queries a list of ListPost items.
+	ListPost(ctx context.Context, in *QueryListPostRequest, opts ...grpc.CallOption) (*QueryListPostResponse, error)
+	// SearchPosts returns the posts whose title or body contain every term of
+	// the query. A term ending in '*' matches any word starting with it.
+	SearchPosts(ctx context.Context, in *QuerySearchPostsRequest, opts ...grpc.CallOption) (*QuerySearchPostsResponse, error)
+	// ShowPostBySlug returns the post a current or previous slug points to.
+	ShowPostBySlug(ctx context.Context, in *QueryShowPostBySlugRequest, opts ...grpc.CallOption) (*QueryShowPostBySlugResponse, error)
+	// Profile returns the profile of an address.
+	Profile(ctx context.Context, in *QueryProfileRequest, opts ...grpc.CallOption) (*QueryProfileResponse, error)
+	// ProfileByName returns the profile using a display name.
+	ProfileByName(ctx context.Context, in *QueryProfileByNameRequest, opts ...grpc.CallOption) (*QueryProfileByNameResponse, error)
+	// BlogStats returns chain-wide post counters.
+	BlogStats(ctx context.Context, in *QueryBlogStatsRequest, opts ...grpc.CallOption) (*QueryBlogStatsResponse, error)
+	// CreatorStats returns the post counters of a single creator.
+	CreatorStats(ctx context.Context, in *QueryCreatorStatsRequest, opts ...grpc.CallOption) (*QueryCreatorStatsResponse, error)
+	// ShowSeries returns a series.
+	ShowSeries(ctx context.Context, in *QueryShowSeriesRequest, opts ...grpc.CallOption) (*QueryShowSeriesResponse, error)
+	// PostChangeProposals returns the pending change proposals of a post.
+	PostChangeProposals(ctx context.Context, in *QueryPostChangeProposalsRequest, opts ...grpc.CallOption) (*QueryPostChangeProposalsResponse, error)
+	// DeletedPosts returns the posts of a creator that are in the trash.
+	DeletedPosts(ctx context.Context, in *QueryDeletedPostsRequest, opts ...grpc.CallOption) (*QueryDeletedPostsResponse, error)
+	// Followers returns the accounts following an address.
+	Followers(ctx context.Context, in *QueryFollowersRequest, opts ...grpc.CallOption) (*QueryFollowersResponse, error)
+	// Following returns the accounts an address follows.
+	Following(ctx context.Context, in *QueryFollowingRequest, opts ...grpc.CallOption) (*QueryFollowingResponse, error)
+	// Feed returns the posts of the accounts an address follows, newest first.
+	Feed(ctx context.Context, in *QueryFeedRequest, opts ...grpc.CallOption) (*QueryFeedResponse, error)
+	// RenderPost returns the body of a post rendered as sanitized HTML.
+	RenderPost(ctx context.Context, in *QueryRenderPostRequest, opts ...grpc.CallOption) (*QueryRenderPostResponse, error)
+	// PostAtHeight returns a post as it was at the end of a block. The query
+	// must be sent with the x-cosmos-block-height header set to the height, so
+	// that it reads the store versioned at that height; the REST route and the
+	// blogd blog post-at-height command set it. Heights pruned by the node
+	// cannot be queried.
+	PostAtHeight(ctx context.Context, in *QueryPostAtHeightRequest, opts ...grpc.CallOption) (*QueryPostAtHeightResponse, error)
+	// PostTimeline lists the changes of a post in the order they were made,
+	// including its deletion, restoration and purge. Posts that never existed
+	// are not found.
+	PostTimeline(ctx context.Context, in *QueryPostTimelineRequest, opts ...grpc.CallOption) (*QueryPostTimelineResponse, error)
+	// HasAccess reports whether an address may read an encrypted post, either
+	// as a holder of a key envelope or as a purchaser.
+	HasAccess(ctx context.Context, in *QueryHasAccessRequest, opts ...grpc.CallOption) (*QueryHasAccessResponse, error)
+	// PurchasedPosts returns the entitlements an address purchased.
+	PurchasedPosts(ctx context.Context, in *QueryPurchasedPostsRequest, opts ...grpc.CallOption) (*QueryPurchasedPostsResponse, error)
+	// PostPurchasers returns the addresses that purchased access to a post, so
+	// that the creator can grant them key envelopes. Purchases still in escrow
+	// are those waiting for an envelope.
+	PostPurchasers(ctx context.Context, in *QueryPostPurchasersRequest, opts ...grpc.CallOption) (*QueryPostPurchasersResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/blog.blog.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ShowPost(ctx context.Context, in *QueryShowPostRequest, opts ...grpc.CallOption) (*QueryShowPostResponse, error) {
+	out := new(QueryShowPostResponse)
+	err := c.cc.Invoke(ctx, "/blog.blog.Query/ShowPost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListPost(ctx context.Context, in *QueryListPostRequest, opts ...grpc.CallOption) (*QueryListPostResponse, error) {
+	out := new(QueryListPostResponse)
+	err := c.cc.Invoke(ctx, "/blog.blog.Query/ListPost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SearchPosts(ctx context.Context, in *QuerySearchPostsRequest, opts ...grpc.CallOption) (*QuerySearchPostsResponse, error) {
+	out := new(QuerySearchPostsResponse)
+	err := c.cc.Invoke(ctx, "/blog.blog.Query/SearchPosts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ShowPostBySlug(ctx context.Context, in *QueryShowPostBySlugRequest, opts ...grpc.CallOption) (*QueryShowPostBySlugResponse, error) {
+	out := new(QueryShowPostBySlugResponse)
+	err := c.cc.Invoke(ctx, "/blog.blog.Query/ShowPostBySlug", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Profile(ctx context.Context, in *QueryProfileRequest, opts ...grpc.CallOption) (*QueryProfileResponse, error) {
+	out := new(QueryProfileResponse)
+	err := c.cc.Invoke(ctx, "/blog.blog.Query/Profile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProfileByName(ctx context.Context, in *QueryProfileByNameRequest, opts ...grpc.CallOption) (*QueryProfileByNameResponse, error) {
+	out := new(QueryProfileByNameResponse)
+	err := c.cc.Invoke(ctx, "/blog.blog.Query/ProfileByName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BlogStats(ctx context.Context, in *QueryBlogStatsRequest, opts ...grpc.CallOption) (*QueryBlogStatsResponse, error) {
+	out := new(QueryBlogStatsResponse)
+	err := c.cc.Invoke(ctx, "/blog.blog.Query/BlogStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CreatorStats(ctx context.Context, in *QueryCreatorStatsRequest, opts ...grpc.CallOption) (*QueryCreatorStatsResponse, error) {
+	out := new(QueryCreatorStatsResponse)
+	err := c.cc.Invoke(ctx, "/blog.blog.Query/CreatorStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ShowSeries(ctx context.Context, in *QueryShowSeriesRequest, opts ...grpc.CallOption) (*QueryShowSeriesResponse, error) {
+	out := new(QueryShowSeriesResponse)
+	err := c.cc.Invoke(ctx, "/blog.blog.Query/ShowSeries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PostChangeProposals(ctx context.Context, in *QueryPostChangeProposalsRequest, opts ...grpc.CallOption) (*QueryPostChangeProposalsResponse, error) {
+	out := new(QueryPostChangeProposalsResponse)
+	err := c.cc.Invoke(ctx, "/blog.blog.Query/PostChangeProposals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DeletedPosts(ctx context.Context, in *QueryDeletedPostsRequest, opts ...grpc.CallOption) (*QueryDeletedPostsResponse, error) {
+	out := new(QueryDeletedPostsResponse)
+	err := c.cc.Invoke(ctx, "/blog.blog.Query/DeletedPosts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Followers(ctx context.Context, in *QueryFollowersRequest, opts ...grpc.CallOption) (*QueryFollowersResponse, error) {
+	out := new(QueryFollowersResponse)
+	err := c.cc.Invoke(ctx, "/blog.blog.Query/Followers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Following(ctx context.Context, in *QueryFollowingRequest, opts ...grpc.CallOption) (*QueryFollowingResponse, error) {
+	out := new(QueryFollowingResponse)
+	err := c.cc.Invoke(ctx, "/blog.blog.Query/Following", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Feed(ctx context.Context, in *QueryFeedRequest, opts ...grpc.CallOption) (*QueryFeedResponse, error) {
+	out := new(QueryFeedResponse)
+	err := c.cc.Invoke(ctx, "/blog.blog.Query/Feed", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RenderPost(ctx context.Context, in *QueryRenderPostRequest, opts ...grpc.CallOption) (*QueryRenderPostResponse, error) {
+	out := new(QueryRenderPostResponse)
+	err := c.cc.Invoke(ctx, "/blog.blog.Query/RenderPost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PostAtHeight(ctx context.Context, in *QueryPostAtHeightRequest, opts ...grpc.CallOption) (*QueryPostAtHeightResponse, error) {
+	out := new(QueryPostAtHeightResponse)
+	err := c.cc.Invoke(ctx, "/blog.blog.Query/PostAtHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PostTimeline(ctx context.Context, in *QueryPostTimelineRequest, opts ...grpc.CallOption) (*QueryPostTimelineResponse, error) {
+	out := new(QueryPostTimelineResponse)
+	err := c.cc.Invoke(ctx, "/blog.blog.Query/PostTimeline", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) HasAccess(ctx context.Context, in *QueryHasAccessRequest, opts ...grpc.CallOption) (*QueryHasAccessResponse, error) {
+	out := new(QueryHasAccessResponse)
+	err := c.cc.Invoke(ctx, "/blog.blog.Query/HasAccess", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PurchasedPosts(ctx context.Context, in *QueryPurchasedPostsRequest, opts ...grpc.CallOption) (*QueryPurchasedPostsResponse, error) {
+	out := new(QueryPurchasedPostsResponse)
+	err := c.cc.Invoke(ctx, "/blog.blog.Query/PurchasedPosts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PostPurchasers(ctx context.Context, in *QueryPostPurchasersRequest, opts ...grpc.CallOption) (*QueryPostPurchasersResponse, error) {
+	out := new(QueryPostPurchasersResponse)
+	err := c.cc.Invoke(ctx, "/blog.blog.Query/PostPurchasers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries a list of ShowPost items.
+	ShowPost(context.Context, *QueryShowPostRequest) (*QueryShowPostResponse, error)
+	// Queries a list of ListPost items.
+	ListPost(context.Context, *QueryListPostRequest) (*QueryListPostResponse, error)
+	// SearchPosts returns the posts whose title or body contain every term of
+	// the query. A term ending in '*' matches any word starting with it.
+	SearchPosts(context.Context, *QuerySearchPostsRequest) (*QuerySearchPostsResponse, error)
+	// ShowPostBySlug returns the post a current or previous slug points to.
+	ShowPostBySlug(context.Context, *QueryShowPostBySlugRequest) (*QueryShowPostBySlugResponse, error)
+	// Profile returns the profile of an address.
+	Profile(context.Context, *QueryProfileRequest) (*QueryProfileResponse, error)
+	// ProfileByName returns the profile using a display name.
+	ProfileByName(context.Context, *QueryProfileByNameRequest) (*QueryProfileByNameResponse, error)
+	// BlogStats returns chain-wide post counters.
+	BlogStats(context.Context, *QueryBlogStatsRequest) (*QueryBlogStatsResponse, error)
+	// CreatorStats returns the post counters of a single creator.
+	CreatorStats(context.Context, *QueryCreatorStatsRequest) (*QueryCreatorStatsResponse, error)
+	// ShowSeries returns a series.
+	ShowSeries(context.Context, *QueryShowSeriesRequest) (*QueryShowSeriesResponse, error)
+	// PostChangeProposals returns the pending change proposals of a post.
+	PostChangeProposals(context.Context, *QueryPostChangeProposalsRequest) (*QueryPostChangeProposalsResponse, error)
+	// DeletedPosts returns the posts of a creator that are in the trash.
+	DeletedPosts(context.Context, *QueryDeletedPostsRequest) (*QueryDeletedPostsResponse, error)
+	// Followers returns the accounts following an address.
+	Followers(context.Context, *QueryFollowersRequest) (*QueryFollowersResponse, error)
+	// Following returns the accounts an address follows.
+	Following(context.Context, *QueryFollowingRequest) (*QueryFollowingResponse, error)
+	// Feed returns the posts of the accounts an address follows, newest first.
+	Feed(context.Context, *QueryFeedRequest) (*QueryFeedResponse, error)
+	// RenderPost returns the body of a post rendered as sanitized HTML.
+	RenderPost(context.Context, *QueryRenderPostRequest) (*QueryRenderPostResponse, error)
+	// PostAtHeight returns a post as it was at the end of a block. The query
+	// must be sent with the x-cosmos-block-height header set to the height, so
+	// that it reads the store versioned at that height; the REST route and the
+	// blogd blog post-at-height command set it. Heights pruned by the node
+	// cannot be queried.
+	PostAtHeight(context.Context, *QueryPostAtHeightRequest) (*QueryPostAtHeightResponse, error)
+	// PostTimeline lists the changes of a post in the order they were made,
+	// including its deletion, restoration and purge. Posts that never existed
+	// are not found.
+	PostTimeline(context.Context, *QueryPostTimelineRequest) (*QueryPostTimelineResponse, error)
+	// HasAccess reports whether an address may read an encrypted post, either
+	// as a holder of a key envelope or as a purchaser.
+	HasAccess(context.Context, *QueryHasAccessRequest) (*QueryHasAccessResponse, error)
+	// PurchasedPosts returns the entitlements an address purchased.
+	PurchasedPosts(context.Context, *QueryPurchasedPostsRequest) (*QueryPurchasedPostsResponse, error)
+	// PostPurchasers returns the addresses that purchased access to a post, so
+	// that the creator can grant them key envelopes. Purchases still in escrow
+	// are those waiting for an envelope.
+	PostPurchasers(context.Context, *QueryPostPurchasersRequest) (*QueryPostPurchasersResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) ShowPost(ctx context.Context, req *QueryShowPostRequest) (*QueryShowPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShowPost not implemented")
+}
+func (*UnimplementedQueryServer) ListPost(ctx context.Context, req *QueryListPostRequest) (*QueryListPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPost not implemented")
+}
+func (*UnimplementedQueryServer) SearchPosts(ctx context.Context, req *QuerySearchPostsRequest) (*QuerySearchPostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchPosts not implemented")
+}
+func (*UnimplementedQueryServer) ShowPostBySlug(ctx context.Context, req *QueryShowPostBySlugRequest) (*QueryShowPostBySlugResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShowPostBySlug not implemented")
+}
+func (*UnimplementedQueryServer) Profile(ctx context.Context, req *QueryProfileRequest) (*QueryProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Profile not implemented")
+}
+func (*UnimplementedQueryServer) ProfileByName(ctx context.Context, req *QueryProfileByNameRequest) (*QueryProfileByNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProfileByName not implemented")
+}
+func (*UnimplementedQueryServer) BlogStats(ctx context.Context, req *QueryBlogStatsRequest) (*QueryBlogStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlogStats not implemented")
+}
+func (*UnimplementedQueryServer) CreatorStats(ctx context.Context, req *QueryCreatorStatsRequest) (*QueryCreatorStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatorStats not implemented")
+}
+func (*UnimplementedQueryServer) ShowSeries(ctx context.Context, req *QueryShowSeriesRequest) (*QueryShowSeriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShowSeries not implemented")
+}
+func (*UnimplementedQueryServer) PostChangeProposals(ctx context.Context, req *QueryPostChangeProposalsRequest) (*QueryPostChangeProposalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostChangeProposals not implemented")
+}
+func (*UnimplementedQueryServer) DeletedPosts(ctx context.Context, req *QueryDeletedPostsRequest) (*QueryDeletedPostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletedPosts not implemented")
+}
+func (*UnimplementedQueryServer) Followers(ctx context.Context, req *QueryFollowersRequest) (*QueryFollowersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Followers not implemented")
+}
+func (*UnimplementedQueryServer) Following(ctx context.Context, req *QueryFollowingRequest) (*QueryFollowingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Following not implemented")
+}
+func (*UnimplementedQueryServer) Feed(ctx context.Context, req *QueryFeedRequest) (*QueryFeedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Feed not implemented")
+}
+func (*UnimplementedQueryServer) RenderPost(ctx context.Context, req *QueryRenderPostRequest) (*QueryRenderPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenderPost not implemented")
+}
+func (*UnimplementedQueryServer) PostAtHeight(ctx context.Context, req *QueryPostAtHeightRequest) (*QueryPostAtHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostAtHeight not implemented")
+}
+func (*UnimplementedQueryServer) PostTimeline(ctx context.Context, req *QueryPostTimelineRequest) (*QueryPostTimelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostTimeline not implemented")
+}
+func (*UnimplementedQueryServer) HasAccess(ctx context.Context, req *QueryHasAccessRequest) (*QueryHasAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HasAccess not implemented")
+}
+func (*UnimplementedQueryServer) PurchasedPosts(ctx context.Context, req *QueryPurchasedPostsRequest) (*QueryPurchasedPostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurchasedPosts not implemented")
+}
+func (*UnimplementedQueryServer) PostPurchasers(ctx context.Context, req *QueryPostPurchasersRequest) (*QueryPostPurchasersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostPurchasers not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.blog.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ShowPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryShowPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ShowPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.blog.Query/ShowPost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ShowPost(ctx, req.(*QueryShowPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.blog.Query/ListPost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListPost(ctx, req.(*QueryListPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SearchPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySearchPostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SearchPosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.blog.Query/SearchPosts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SearchPosts(ctx, req.(*QuerySearchPostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ShowPostBySlug_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryShowPostBySlugRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ShowPostBySlug(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.blog.Query/ShowPostBySlug",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ShowPostBySlug(ctx, req.(*QueryShowPostBySlugRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Profile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Profile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.blog.Query/Profile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Profile(ctx, req.(*QueryProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProfileByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProfileByNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProfileByName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.blog.Query/ProfileByName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProfileByName(ctx, req.(*QueryProfileByNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BlogStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlogStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlogStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.blog.Query/BlogStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlogStats(ctx, req.(*QueryBlogStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CreatorStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCreatorStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CreatorStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.blog.Query/CreatorStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CreatorStats(ctx, req.(*QueryCreatorStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ShowSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryShowSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ShowSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.blog.Query/ShowSeries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ShowSeries(ctx, req.(*QueryShowSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PostChangeProposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPostChangeProposalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PostChangeProposals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.blog.Query/PostChangeProposals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PostChangeProposals(ctx, req.(*QueryPostChangeProposalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DeletedPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDeletedPostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DeletedPosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.blog.Query/DeletedPosts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DeletedPosts(ctx, req.(*QueryDeletedPostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Followers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFollowersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Followers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.blog.Query/Followers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Followers(ctx, req.(*QueryFollowersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Following_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFollowingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Following(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.blog.Query/Following",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Following(ctx, req.(*QueryFollowingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Feed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Feed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.blog.Query/Feed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Feed(ctx, req.(*QueryFeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RenderPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRenderPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RenderPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.blog.Query/RenderPost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RenderPost(ctx, req.(*QueryRenderPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PostAtHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPostAtHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PostAtHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.blog.Query/PostAtHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PostAtHeight(ctx, req.(*QueryPostAtHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PostTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPostTimelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PostTimeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.blog.Query/PostTimeline",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PostTimeline(ctx, req.(*QueryPostTimelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HasAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHasAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HasAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.blog.Query/HasAccess",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HasAccess(ctx, req.(*QueryHasAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PurchasedPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPurchasedPostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PurchasedPosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.blog.Query/PurchasedPosts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PurchasedPosts(ctx, req.(*QueryPurchasedPostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PostPurchasers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPostPurchasersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PostPurchasers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blog.blog.Query/PostPurchasers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PostPurchasers(ctx, req.(*QueryPostPurchasersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "blog.blog.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "ShowPost",
+			Handler:    _Query_ShowPost_Handler,
+		},
+		{
+			MethodName: "ListPost",
+			Handler:    _Query_ListPost_Handler,
+		},
+		{
+			MethodName: "SearchPosts",
+			Handler:    _Query_SearchPosts_Handler,
+		},
+		{
+			MethodName: "ShowPostBySlug",
+			Handler:    _Query_ShowPostBySlug_Handler,
+		},
+		{
+			MethodName: "Profile",
+			Handler:    _Query_Profile_Handler,
+		},
+		{
+			MethodName: "ProfileByName",
+			Handler:    _Query_ProfileByName_Handler,
+		},
+		{
+			MethodName: "BlogStats",
+			Handler:    _Query_BlogStats_Handler,
+		},
+		{
+			MethodName: "CreatorStats",
+			Handler:    _Query_CreatorStats_Handler,
+		},
+		{
+			MethodName: "ShowSeries",
+			Handler:    _Query_ShowSeries_Handler,
+		},
+		{
+			MethodName: "PostChangeProposals",
+			Handler:    _Query_PostChangeProposals_Handler,
+		},
+		{
+			MethodName: "DeletedPosts",
+			Handler:    _Query_DeletedPosts_Handler,
+		},
+		{
+			MethodName: "Followers",
+			Handler:    _Query_Followers_Handler,
+		},
+		{
+			MethodName: "Following",
+			Handler:    _Query_Following_Handler,
+		},
+		{
+			MethodName: "Feed",
+			Handler:    _Query_Feed_Handler,
+		},
+		{
+			MethodName: "RenderPost",
+			Handler:    _Query_RenderPost_Handler,
+		},
+		{
+			MethodName: "PostAtHeight",
+			Handler:    _Query_PostAtHeight_Handler,
+		},
+		{
+			MethodName: "PostTimeline",
+			Handler:    _Query_PostTimeline_Handler,
+		},
+		{
+			MethodName: "HasAccess",
+			Handler:    _Query_HasAccess_Handler,
+		},
+		{
+			MethodName: "PurchasedPosts",
+			Handler:    _Query_PurchasedPosts_Handler,
+		},
+		{
+			MethodName: "PostPurchasers",
+			Handler:    _Query_PostPurchasers_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "blog/blog/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryShowPostRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryShowPostRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryShowPostRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IncludeProfile {
+		i--
+		if m.IncludeProfile {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryShowPostResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryShowPostResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryShowPostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Revision) > 0 {
+		i -= len(m.Revision)
+		copy(dAtA[i:], m.Revision)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Revision)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.SeriesIds) > 0 {
+		dAtA3 := make([]byte, len(m.SeriesIds)*10)
+		var j2 int
+		for _, num := range m.SeriesIds {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintQuery(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.AuthorProfile != nil {
+		{
+			size, err := m.AuthorProfile.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EditCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EditCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Post.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryShowPostBySlugRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryShowPostBySlugRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryShowPostBySlugRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Slug) > 0 {
+		i -= len(m.Slug)
+		copy(dAtA[i:], m.Slug)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Slug)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryShowPostBySlugResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryShowPostBySlugResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryShowPostBySlugResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Redirect {
+		i--
+		if m.Redirect {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Post.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListPostRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListPostRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListPostRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tag) > 0 {
+		i -= len(m.Tag)
+		copy(dAtA[i:], m.Tag)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Tag)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.IncludeProfiles {
+		i--
+		if m.IncludeProfiles {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Order != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Order))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.TitlePrefix) > 0 {
+		i -= len(m.TitlePrefix)
+		copy(dAtA[i:], m.TitlePrefix)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TitlePrefix)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.UpdatedBefore != nil {
+		n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.UpdatedBefore, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.UpdatedBefore):])
+		if err7 != nil {
+			return 0, err7
+		}
+		i -= n7
+		i = encodeVarintQuery(dAtA, i, uint64(n7))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.UpdatedAfter != nil {
+		n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.UpdatedAfter, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.UpdatedAfter):])
+		if err8 != nil {
+			return 0, err8
+		}
+		i -= n8
+		i = encodeVarintQuery(dAtA, i, uint64(n8))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.CreatedBefore != nil {
+		n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.CreatedBefore, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.CreatedBefore):])
+		if err9 != nil {
+			return 0, err9
+		}
+		i -= n9
+		i = encodeVarintQuery(dAtA, i, uint64(n9))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.CreatedAfter != nil {
+		n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.CreatedAfter, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.CreatedAfter):])
+		if err10 != nil {
+			return 0, err10
+		}
+		i -= n10
+		i = encodeVarintQuery(dAtA, i, uint64(n10))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListPostResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListPostResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListPostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Profiles) > 0 {
+		for iNdEx := len(m.Profiles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Profiles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Post) > 0 {
+		for iNdEx := len(m.Post) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Post[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySearchPostsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySearchPostsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySearchPostsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Query) > 0 {
+		i -= len(m.Query)
+		copy(dAtA[i:], m.Query)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Query)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySearchPostsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySearchPostsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySearchPostsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Posts) > 0 {
+		for iNdEx := len(m.Posts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Posts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlogStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlogStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlogStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlogStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlogStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlogStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastPostId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastPostId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TotalEdits != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalEdits))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TotalCreators != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalCreators))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TotalPosts != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalPosts))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCreatorStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCreatorStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCreatorStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCreatorStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCreatorStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCreatorStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EditCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EditCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PostCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PostCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProfileRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProfileRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProfileRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProfileResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProfileResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProfileResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Profile.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProfileByNameRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProfileByNameRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProfileByNameRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DisplayName) > 0 {
+		i -= len(m.DisplayName)
+		copy(dAtA[i:], m.DisplayName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DisplayName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProfileByNameResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProfileByNameResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProfileByNameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Profile.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFollowersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFollowersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFollowersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFollowersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFollowersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFollowersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Followers) > 0 {
+		for iNdEx := len(m.Followers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Followers[iNdEx])
+			copy(dAtA[i:], m.Followers[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Followers[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFollowingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFollowingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFollowingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFollowingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFollowingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFollowingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Following) > 0 {
+		for iNdEx := len(m.Following) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Following[iNdEx])
+			copy(dAtA[i:], m.Following[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Following[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Posts) > 0 {
+		for iNdEx := len(m.Posts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Posts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryShowSeriesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryShowSeriesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryShowSeriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryShowSeriesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryShowSeriesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryShowSeriesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Series.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPostChangeProposalsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPostChangeProposalsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPostChangeProposalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PostId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PostId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPostChangeProposalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPostChangeProposalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPostChangeProposalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proposals) > 0 {
+		for iNdEx := len(m.Proposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDeletedPostsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeletedPostsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeletedPostsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDeletedPostsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeletedPostsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeletedPostsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Posts) > 0 {
+		for iNdEx := len(m.Posts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Posts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRenderPostRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRenderPostRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRenderPostRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRenderPostResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRenderPostResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRenderPostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BodyFormat != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BodyFormat))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Html) > 0 {
+		i -= len(m.Html)
+		copy(dAtA[i:], m.Html)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Html)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPostAtHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPostAtHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPostAtHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPostAtHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPostAtHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPostAtHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Post.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPostTimelineRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPostTimelineRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPostTimelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPostTimelineResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPostTimelineResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPostTimelineResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHasAccessRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHasAccessRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHasAccessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHasAccessResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHasAccessResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHasAccessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HasEnvelope {
+		i--
+		if m.HasEnvelope {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Purchased {
+		i--
+		if m.Purchased {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.HasAccess {
+		i--
+		if m.HasAccess {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPurchasedPostsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPurchasedPostsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPurchasedPostsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPurchasedPostsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPurchasedPostsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPurchasedPostsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Entitlements) > 0 {
+		for iNdEx := len(m.Entitlements) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entitlements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPostPurchasersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPostPurchasersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPostPurchasersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Escrowed {
+		i--
+		if m.Escrowed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPostPurchasersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPostPurchasersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPostPurchasersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Purchasers) > 0 {
+		for iNdEx := len(m.Purchasers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Purchasers[iNdEx])
+			copy(dAtA[i:], m.Purchasers[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Purchasers[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryShowPostRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	if m.IncludeProfile {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryShowPostResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Post.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.EditCount != 0 {
+		n += 1 + sovQuery(uint64(m.EditCount))
+	}
+	if m.AuthorProfile != nil {
+		l = m.AuthorProfile.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.SeriesIds) > 0 {
+		l = 0
+		for _, e := range m.SeriesIds {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	l = len(m.Revision)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryShowPostBySlugRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Slug)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryShowPostBySlugResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Post.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Redirect {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryListPostRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.CreatedAfter != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.CreatedAfter)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.CreatedBefore != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.CreatedBefore)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.UpdatedAfter != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.UpdatedAfter)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.UpdatedBefore != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.UpdatedBefore)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TitlePrefix)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Order != 0 {
+		n += 1 + sovQuery(uint64(m.Order))
+	}
+	if m.IncludeProfiles {
+		n += 2
+	}
+	l = len(m.Tag)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListPostResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Post) > 0 {
+		for _, e := range m.Post {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Profiles) > 0 {
+		for _, e := range m.Profiles {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySearchPostsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Query)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySearchPostsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Posts) > 0 {
+		for _, e := range m.Posts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlogStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBlogStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TotalPosts != 0 {
+		n += 1 + sovQuery(uint64(m.TotalPosts))
+	}
+	if m.TotalCreators != 0 {
+		n += 1 + sovQuery(uint64(m.TotalCreators))
+	}
+	if m.TotalEdits != 0 {
+		n += 1 + sovQuery(uint64(m.TotalEdits))
+	}
+	if m.LastPostId != 0 {
+		n += 1 + sovQuery(uint64(m.LastPostId))
+	}
+	return n
+}
+
+func (m *QueryCreatorStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCreatorStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PostCount != 0 {
+		n += 1 + sovQuery(uint64(m.PostCount))
+	}
+	if m.EditCount != 0 {
+		n += 1 + sovQuery(uint64(m.EditCount))
+	}
+	return n
+}
+
+func (m *QueryProfileRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProfileResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Profile.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryProfileByNameRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DisplayName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProfileByNameResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Profile.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFollowersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFollowersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Followers) > 0 {
+		for _, s := range m.Followers {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFollowingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFollowingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Following) > 0 {
+		for _, s := range m.Following {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Posts) > 0 {
+		for _, e := range m.Posts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryShowSeriesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryShowSeriesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Series.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPostChangeProposalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PostId != 0 {
+		n += 1 + sovQuery(uint64(m.PostId))
+	}
+	return n
+}
+
+func (m *QueryPostChangeProposalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Proposals) > 0 {
+		for _, e := range m.Proposals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDeletedPostsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDeletedPostsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Posts) > 0 {
+		for _, e := range m.Posts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRenderPostRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryRenderPostResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Html)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BodyFormat != 0 {
+		n += 1 + sovQuery(uint64(m.BodyFormat))
+	}
+	if m.Version != 0 {
+		n += 1 + sovQuery(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *QueryPostAtHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryPostAtHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Post.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPostTimelineRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPostTimelineResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHasAccessRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryHasAccessResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HasAccess {
+		n += 2
+	}
+	if m.Purchased {
+		n += 2
+	}
+	if m.HasEnvelope {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryPurchasedPostsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPurchasedPostsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entitlements) > 0 {
+		for _, e := range m.Entitlements {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPostPurchasersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Escrowed {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryPostPurchasersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Purchasers) > 0 {
+		for _, s := range m.Purchasers {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryShowPostRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryShowPostRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryShowPostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeProfile", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeProfile = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryShowPostResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryShowPostResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryShowPostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Post", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Post.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EditCount", wireType)
+			}
+			m.EditCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EditCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorProfile", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AuthorProfile == nil {
+				m.AuthorProfile = &Profile{}
+			}
+			if err := m.AuthorProfile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SeriesIds = append(m.SeriesIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.SeriesIds) == 0 {
+					m.SeriesIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.SeriesIds = append(m.SeriesIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeriesIds", wireType)
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryShowPostBySlugRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryShowPostBySlugRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryShowPostBySlugRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slug", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Slug = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryShowPostBySlugResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryShowPostBySlugResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryShowPostBySlugResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Post", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Post.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redirect", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Redirect = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListPostRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListPostRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListPostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAfter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreatedAfter == nil {
+				m.CreatedAfter = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.CreatedAfter, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedBefore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreatedBefore == nil {
+				m.CreatedBefore = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.CreatedBefore, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAfter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UpdatedAfter == nil {
+				m.UpdatedAfter = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.UpdatedAfter, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBefore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UpdatedBefore == nil {
+				m.UpdatedBefore = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.UpdatedBefore, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TitlePrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TitlePrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			}
+			m.Order = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Order |= ListPostOrder(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeProfiles", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeProfiles = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListPostResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListPostResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListPostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Post", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Post = append(m.Post, Post{})
+			if err := m.Post[len(m.Post)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Profiles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Profiles = append(m.Profiles, Profile{})
+			if err := m.Profiles[len(m.Profiles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySearchPostsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySearchPostsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySearchPostsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySearchPostsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySearchPostsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySearchPostsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Posts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Posts = append(m.Posts, Post{})
+			if err := m.Posts[len(m.Posts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlogStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlogStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlogStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlogStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlogStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlogStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPosts", wireType)
+			}
+			m.TotalPosts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalPosts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalCreators", wireType)
+			}
+			m.TotalCreators = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalCreators |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalEdits", wireType)
+			}
+			m.TotalEdits = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalEdits |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPostId", wireType)
+			}
+			m.LastPostId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastPostId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCreatorStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCreatorStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCreatorStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCreatorStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCreatorStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCreatorStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostCount", wireType)
+			}
+			m.PostCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PostCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EditCount", wireType)
+			}
+			m.EditCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EditCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProfileRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProfileRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProfileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProfileResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProfileResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProfileResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Profile", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Profile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProfileByNameRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProfileByNameRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProfileByNameRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisplayName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProfileByNameResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProfileByNameResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProfileByNameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Profile", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Profile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFollowersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFollowersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFollowersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFollowersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFollowersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFollowersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Followers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Followers = append(m.Followers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFollowingRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFollowingRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFollowingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFollowingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFollowingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFollowingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Following", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Following = append(m.Following, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Posts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Posts = append(m.Posts, Post{})
+			if err := m.Posts[len(m.Posts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryShowSeriesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryShowSeriesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryShowSeriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryShowSeriesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryShowSeriesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryShowSeriesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Series", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Series.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPostChangeProposalsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPostChangeProposalsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPostChangeProposalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostId", wireType)
+			}
+			m.PostId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PostId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPostChangeProposalsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPostChangeProposalsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPostChangeProposalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposals = append(m.Proposals, PostChangeProposal{})
+			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDeletedPostsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDeletedPostsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDeletedPostsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDeletedPostsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDeletedPostsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDeletedPostsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Posts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Posts = append(m.Posts, DeletedPost{})
+			if err := m.Posts[len(m.Posts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRenderPostRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRenderPostRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRenderPostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRenderPostResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRenderPostResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRenderPostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Html", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Html = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BodyFormat", wireType)
+			}
+			m.BodyFormat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BodyFormat |= BodyFormat(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPostAtHeightRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPostAtHeightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPostAtHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPostAtHeightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPostAtHeightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPostAtHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Post", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Post.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPostTimelineRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPostTimelineRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPostTimelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPostTimelineResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPostTimelineResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPostTimelineResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, PostLogEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryHasAccessRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -819,12 +9738,63 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHasAccessRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHasAccessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -846,7 +9816,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryHasAccessResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -869,17 +9839,17 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHasAccessResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHasAccessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasAccess", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -889,25 +9859,52 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+			m.HasAccess = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Purchased", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Purchased = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasEnvelope", wireType)
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.HasEnvelope = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -929,7 +9926,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryShowPostRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPurchasedPostsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -952,17 +9949,17 @@ func (m *QueryShowPostRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryShowPostRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPurchasedPostsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryShowPostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPurchasedPostsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			m.Id = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -972,11 +9969,60 @@ func (m *QueryShowPostRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -998,7 +10044,7 @@ func (m *QueryShowPostRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryShowPostResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPurchasedPostsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1021,15 +10067,15 @@ func (m *QueryShowPostResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryShowPostResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPurchasedPostsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryShowPostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPurchasedPostsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Post", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entitlements", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1056,7 +10102,44 @@ func (m *QueryShowPostResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Post.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Entitlements = append(m.Entitlements, Entitlement{})
+			if err := m.Entitlements[len(m.Entitlements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1081,7 +10164,7 @@ func (m *QueryShowPostResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListPostRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPostPurchasersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1104,13 +10187,32 @@ func (m *QueryListPostRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListPostRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPostPurchasersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListPostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPostPurchasersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -1146,6 +10248,26 @@ func (m *QueryListPostRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrowed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Escrowed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1167,7 +10289,7 @@ func (m *QueryListPostRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListPostResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPostPurchasersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1190,17 +10312,17 @@ func (m *QueryListPostResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListPostResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPostPurchasersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListPostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPostPurchasersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Post", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Purchasers", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1210,25 +10332,23 @@ func (m *QueryListPostResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Post = append(m.Post, Post{})
-			if err := m.Post[len(m.Post)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Purchasers = append(m.Purchasers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -0,0 +1,97 @@
+package types
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// MinSearchTermLength is the length below which words are not indexed.
+	MinSearchTermLength = 2
+	// MaxSearchTermLength is the length at which indexed words are truncated.
+	MaxSearchTermLength = 64
+	// MaxSearchQueryTerms is the maximum number of terms a search query may contain.
+	MaxSearchQueryTerms = 8
+
+	searchPrefixWildcard = "*"
+)
+
+// SearchTerm is a single term of a search query.
+type SearchTerm struct {
+	Term   string
+	Prefix bool
+}
+
+// Tokenize splits text into the sorted set of lower-cased words stored in the
+// search index. Words are runs of letters and digits.
+func Tokenize(text string) []string {
+	seen := make(map[string]struct{})
+	for _, word := range strings.FieldsFunc(text, isSeparator) {
+		if term, ok := normalizeTerm(word); ok {
+			seen[term] = struct{}{}
+		}
+	}
+
+	terms := make([]string, 0, len(seen))
+	for term := range seen {
+		terms = append(terms, term)
+	}
+	sort.Strings(terms)
+	return terms
+}
+
+// PostTerms returns the indexed terms of a post's title, summary and body. The
+// body of encrypted posts is not indexed.
+func PostTerms(post Post) []string {
+	if post.BodyFormat == BodyFormat_BODY_FORMAT_ENCRYPTED {
+		return Tokenize(post.Title + " " + post.Summary)
+	}
+	return Tokenize(post.Title + " " + post.Summary + " " + post.Body)
+}
+
+// ParseSearchQuery splits a search query into terms that must all match.
+func ParseSearchQuery(query string) ([]SearchTerm, error) {
+	var terms []SearchTerm
+	for _, word := range strings.Fields(query) {
+		prefix := strings.HasSuffix(word, searchPrefixWildcard)
+		word = strings.TrimSuffix(word, searchPrefixWildcard)
+		if strings.IndexFunc(word, isSeparator) >= 0 {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid search term %q", word)
+		}
+
+		term, ok := normalizeTerm(word)
+		if !ok {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "search term %q is shorter than %d characters", word, MinSearchTermLength)
+		}
+		terms = append(terms, SearchTerm{Term: term, Prefix: prefix})
+	}
+
+	if len(terms) == 0 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty search query")
+	}
+	if len(terms) > MaxSearchQueryTerms {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "search query has more than %d terms", MaxSearchQueryTerms)
+	}
+	return terms, nil
+}
+
+func isSeparator(r rune) bool {
+	return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+}
+
+func normalizeTerm(word string) (string, bool) {
+	if utf8.RuneCountInString(word) < MinSearchTermLength {
+		return "", false
+	}
+
+	term := []rune(strings.ToLower(word))
+	if len(term) > MaxSearchTermLength {
+		term = term[:MaxSearchTermLength]
+	}
+	return string(term), true
+}
@@ -0,0 +1,31 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"blog/x/blog/types"
+)
+
+func TestTokenize(t *testing.T) {
+	require.Equal(t,
+		[]string{"cosmos", "hello", "héllo", "sdk", "v050"},
+		types.Tokenize("Hello, Cosmos-SDK! hello héllo v050 a"),
+	)
+	require.Empty(t, types.Tokenize(" - ! "))
+}
+
+func TestParseSearchQuery(t *testing.T) {
+	terms, err := types.ParseSearchQuery("Cosmos blo*")
+	require.NoError(t, err)
+	require.Equal(t, []types.SearchTerm{
+		{Term: "cosmos"},
+		{Term: "blo", Prefix: true},
+	}, terms)
+
+	for _, query := range []string{"", "   ", "a", "b*", "cosmos-sdk", "aa bb cc dd ee ff gg hh ii"} {
+		_, err := types.ParseSearchQuery(query)
+		require.Error(t, err, query)
+	}
+}
@@ -0,0 +1,71 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// MaxSlugLength is the maximum length of a post slug.
+	MaxSlugLength = 96
+
+	// defaultSlug is used when a title yields no slug characters.
+	defaultSlug = "post"
+)
+
+var (
+	slugRegexp         = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+	numericSlugRegexp  = regexp.MustCompile(`^[0-9]+$`)
+	slugSeparatorRegex = regexp.MustCompile(`[^a-z0-9]+`)
+)
+
+// ValidateSlug checks that a slug is made of lower-case alphanumeric words
+// separated by single dashes. Purely numeric slugs are rejected so that they
+// cannot be mistaken for post IDs.
+func ValidateSlug(slug string) error {
+	if len(slug) > MaxSlugLength {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "slug is longer than %d characters", MaxSlugLength)
+	}
+	if !slugRegexp.MatchString(slug) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid slug %q", slug)
+	}
+	if numericSlugRegexp.MatchString(slug) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "slug %q must not be numeric", slug)
+	}
+	return nil
+}
+
+// SlugFromTitle derives a valid slug from a post title.
+func SlugFromTitle(title string) string {
+	slug := strings.Trim(slugSeparatorRegex.ReplaceAllString(strings.ToLower(title), "-"), "-")
+	if len(slug) > MaxSlugLength {
+		slug = strings.TrimRight(slug[:MaxSlugLength], "-")
+	}
+	if slug == "" || numericSlugRegexp.MatchString(slug) {
+		slug = strings.Trim(defaultSlug+"-"+slug, "-")
+	}
+	return slug
+}
+
+// AvailableSlug returns base if taken reports it free, otherwise the first
+// free variant of base suffixed with a counter
+func AvailableSlug(base string, taken func(slug string) bool) string {
+	if !taken(base) {
+		return base
+	}
+	for n := 2; ; n++ {
+		suffix := fmt.Sprintf("-%d", n)
+		candidate := base
+		if len(candidate)+len(suffix) > MaxSlugLength {
+			candidate = strings.TrimRight(candidate[:MaxSlugLength-len(suffix)], "-")
+		}
+		candidate += suffix
+		if !taken(candidate) {
+			return candidate
+		}
+	}
+}
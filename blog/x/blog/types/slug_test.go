@@ -0,0 +1,32 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"blog/x/blog/types"
+)
+
+func TestValidateSlug(t *testing.T) {
+	for _, slug := range []string{"hello", "hello-world", "cosmos-sdk-0-50"} {
+		require.NoError(t, types.ValidateSlug(slug), slug)
+	}
+	for _, slug := range []string{"", "Hello", "hello--world", "-hello", "hello-", "hello world", "42", strings.Repeat("a", types.MaxSlugLength+1)} {
+		require.Error(t, types.ValidateSlug(slug), slug)
+	}
+}
+
+func TestSlugFromTitle(t *testing.T) {
+	for title, slug := range map[string]string{
+		"Hello, World!":              "hello-world",
+		"  Cosmos SDK v0.50 -- out ": "cosmos-sdk-v0-50-out",
+		"!!!":                        "post",
+		"2024":                       "post-2024",
+		strings.Repeat("ab ", 60):    strings.TrimRight(strings.Repeat("ab-", 32), "-"),
+	} {
+		require.Equal(t, slug, types.SlugFromTitle(title), title)
+		require.NoError(t, types.ValidateSlug(types.SlugFromTitle(title)), title)
+	}
+}
@@ -0,0 +1,1149 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: blog/blog/stream.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// StoreChangeKind is the kind of a blog store change.
+type StoreChangeKind int32
+
+const (
+	StoreChangeKind_STORE_CHANGE_KIND_UNSPECIFIED StoreChangeKind = 0
+	// STORE_CHANGE_KIND_POST_SET is a post being created or updated.
+	StoreChangeKind_STORE_CHANGE_KIND_POST_SET StoreChangeKind = 1
+	// STORE_CHANGE_KIND_POST_DELETE is a post being removed, to the trash or
+	// for good.
+	StoreChangeKind_STORE_CHANGE_KIND_POST_DELETE StoreChangeKind = 2
+	// STORE_CHANGE_KIND_COUNTER_SET is a counter being set. Counters dropping
+	// to zero are set to zero.
+	StoreChangeKind_STORE_CHANGE_KIND_COUNTER_SET StoreChangeKind = 3
+)
+
+var StoreChangeKind_name = map[int32]string{
+	0: "STORE_CHANGE_KIND_UNSPECIFIED",
+	1: "STORE_CHANGE_KIND_POST_SET",
+	2: "STORE_CHANGE_KIND_POST_DELETE",
+	3: "STORE_CHANGE_KIND_COUNTER_SET",
+}
+
+var StoreChangeKind_value = map[string]int32{
+	"STORE_CHANGE_KIND_UNSPECIFIED": 0,
+	"STORE_CHANGE_KIND_POST_SET":    1,
+	"STORE_CHANGE_KIND_POST_DELETE": 2,
+	"STORE_CHANGE_KIND_COUNTER_SET": 3,
+}
+
+func (x StoreChangeKind) String() string {
+	return proto.EnumName(StoreChangeKind_name, int32(x))
+}
+
+func (StoreChangeKind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b4ed9ecd6009abe3, []int{0}
+}
+
+type SubscribePostChangesRequest struct {
+	// from_height is the first height to send the changes of. Zero only sends
+	// the changes of blocks committed after subscribing. Heights older than the
+	// changes retained by the node are rejected with OUT_OF_RANGE.
+	FromHeight int64 `protobuf:"varint,1,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+}
+
+func (m *SubscribePostChangesRequest) Reset()         { *m = SubscribePostChangesRequest{} }
+func (m *SubscribePostChangesRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribePostChangesRequest) ProtoMessage()    {}
+func (*SubscribePostChangesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b4ed9ecd6009abe3, []int{0}
+}
+func (m *SubscribePostChangesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubscribePostChangesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubscribePostChangesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubscribePostChangesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribePostChangesRequest.Merge(m, src)
+}
+func (m *SubscribePostChangesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubscribePostChangesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribePostChangesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribePostChangesRequest proto.InternalMessageInfo
+
+func (m *SubscribePostChangesRequest) GetFromHeight() int64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+// SubscribePostChangesResponse holds the blog store changes of one block, in
+// store key order as they are committed.
+type SubscribePostChangesResponse struct {
+	Height    int64         `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	BlockTime time.Time     `protobuf:"bytes,2,opt,name=block_time,json=blockTime,proto3,stdtime" json:"block_time"`
+	Changes   []StoreChange `protobuf:"bytes,3,rep,name=changes,proto3" json:"changes"`
+}
+
+func (m *SubscribePostChangesResponse) Reset()         { *m = SubscribePostChangesResponse{} }
+func (m *SubscribePostChangesResponse) String() string { return proto.CompactTextString(m) }
+func (*SubscribePostChangesResponse) ProtoMessage()    {}
+func (*SubscribePostChangesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b4ed9ecd6009abe3, []int{1}
+}
+func (m *SubscribePostChangesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubscribePostChangesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubscribePostChangesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubscribePostChangesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribePostChangesResponse.Merge(m, src)
+}
+func (m *SubscribePostChangesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubscribePostChangesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribePostChangesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribePostChangesResponse proto.InternalMessageInfo
+
+func (m *SubscribePostChangesResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *SubscribePostChangesResponse) GetBlockTime() time.Time {
+	if m != nil {
+		return m.BlockTime
+	}
+	return time.Time{}
+}
+
+func (m *SubscribePostChangesResponse) GetChanges() []StoreChange {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
+// StoreChange is a decoded write to the blog store.
+type StoreChange struct {
+	Kind StoreChangeKind `protobuf:"varint,1,opt,name=kind,proto3,enum=blog.blog.StoreChangeKind" json:"kind,omitempty"`
+	// post_id is the ID of the post set or deleted, or of the post a per-post
+	// counter belongs to.
+	PostId uint64 `protobuf:"varint,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	// post is the post as stored, for STORE_CHANGE_KIND_POST_SET.
+	Post *Post `protobuf:"bytes,3,opt,name=post,proto3" json:"post,omitempty"`
+	// counter names the counter set: post_count, total_posts, total_creators,
+	// total_edits, creator_posts, creator_edits or post_edits.
+	Counter string `protobuf:"bytes,4,opt,name=counter,proto3" json:"counter,omitempty"`
+	// creator is the address a per-creator counter belongs to.
+	Creator string `protobuf:"bytes,5,opt,name=creator,proto3" json:"creator,omitempty"`
+	Value   uint64 `protobuf:"varint,6,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *StoreChange) Reset()         { *m = StoreChange{} }
+func (m *StoreChange) String() string { return proto.CompactTextString(m) }
+func (*StoreChange) ProtoMessage()    {}
+func (*StoreChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b4ed9ecd6009abe3, []int{2}
+}
+func (m *StoreChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StoreChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StoreChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StoreChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoreChange.Merge(m, src)
+}
+func (m *StoreChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *StoreChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoreChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StoreChange proto.InternalMessageInfo
+
+func (m *StoreChange) GetKind() StoreChangeKind {
+	if m != nil {
+		return m.Kind
+	}
+	return StoreChangeKind_STORE_CHANGE_KIND_UNSPECIFIED
+}
+
+func (m *StoreChange) GetPostId() uint64 {
+	if m != nil {
+		return m.PostId
+	}
+	return 0
+}
+
+func (m *StoreChange) GetPost() *Post {
+	if m != nil {
+		return m.Post
+	}
+	return nil
+}
+
+func (m *StoreChange) GetCounter() string {
+	if m != nil {
+		return m.Counter
+	}
+	return ""
+}
+
+func (m *StoreChange) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *StoreChange) GetValue() uint64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("blog.blog.StoreChangeKind", StoreChangeKind_name, StoreChangeKind_value)
+	proto.RegisterType((*SubscribePostChangesRequest)(nil), "blog.blog.SubscribePostChangesRequest")
+	proto.RegisterType((*SubscribePostChangesResponse)(nil), "blog.blog.SubscribePostChangesResponse")
+	proto.RegisterType((*StoreChange)(nil), "blog.blog.StoreChange")
+}
+
+func init() { proto.RegisterFile("blog/blog/stream.proto", fileDescriptor_b4ed9ecd6009abe3) }
+
+var fileDescriptor_b4ed9ecd6009abe3 = []byte{
+	// 508 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0xd1, 0x8e, 0xd2, 0x40,
+	0x14, 0x65, 0x16, 0x16, 0xe4, 0x92, 0xb8, 0x38, 0x21, 0xd8, 0x54, 0x2d, 0x88, 0x89, 0x12, 0x4d,
+	0x8a, 0xc1, 0xc4, 0x47, 0x13, 0x81, 0xea, 0x92, 0x35, 0x40, 0xa6, 0xdd, 0x17, 0x5f, 0x1a, 0x0a,
+	0xb3, 0xa5, 0x59, 0xe8, 0xd4, 0xce, 0xd4, 0xe8, 0x5f, 0xec, 0xb3, 0xbf, 0xe1, 0x37, 0x98, 0xec,
+	0xe3, 0x3e, 0xfa, 0xa4, 0x06, 0x7e, 0xc4, 0xcc, 0x14, 0x14, 0xb5, 0x66, 0x5f, 0x9a, 0x39, 0xf7,
+	0x9c, 0x39, 0x73, 0xef, 0xcd, 0x29, 0xd4, 0xbd, 0x25, 0xf3, 0x3b, 0xea, 0xc3, 0x45, 0x4c, 0xa7,
+	0x2b, 0x33, 0x8a, 0x99, 0x60, 0xb8, 0x2c, 0x4b, 0xa6, 0xfc, 0xe8, 0x35, 0x9f, 0xf9, 0x4c, 0x55,
+	0x3b, 0xf2, 0x94, 0x0a, 0xf4, 0x86, 0xcf, 0x98, 0xbf, 0xa4, 0x1d, 0x85, 0xbc, 0xe4, 0xac, 0x23,
+	0x82, 0x15, 0xe5, 0x62, 0xba, 0x8a, 0xb6, 0x82, 0xda, 0x6f, 0xe7, 0x88, 0x71, 0x91, 0x56, 0x5b,
+	0x2f, 0xe0, 0x8e, 0x9d, 0x78, 0x7c, 0x16, 0x07, 0x1e, 0x9d, 0x30, 0x2e, 0xfa, 0x8b, 0x69, 0xe8,
+	0x53, 0x4e, 0xe8, 0xbb, 0x84, 0x72, 0x81, 0x1b, 0x50, 0x39, 0x8b, 0xd9, 0xca, 0x5d, 0xd0, 0xc0,
+	0x5f, 0x08, 0x0d, 0x35, 0x51, 0x3b, 0x4f, 0x40, 0x96, 0x8e, 0x55, 0xa5, 0xf5, 0x19, 0xc1, 0xdd,
+	0x6c, 0x03, 0x1e, 0xb1, 0x90, 0x53, 0x5c, 0x87, 0xe2, 0x1f, 0x97, 0xb7, 0x08, 0xf7, 0x01, 0xbc,
+	0x25, 0x9b, 0x9d, 0xbb, 0xb2, 0x4f, 0xed, 0xa0, 0x89, 0xda, 0x95, 0xae, 0x6e, 0xa6, 0x43, 0x98,
+	0xbb, 0x21, 0x4c, 0x67, 0x37, 0x44, 0xef, 0xc6, 0xe5, 0xb7, 0x46, 0xee, 0xe2, 0x7b, 0x03, 0x91,
+	0xb2, 0xba, 0x27, 0x19, 0xfc, 0x1c, 0x4a, 0xb3, 0xf4, 0x3d, 0x2d, 0xdf, 0xcc, 0xb7, 0x2b, 0xdd,
+	0xba, 0xf9, 0x6b, 0x4f, 0xa6, 0x2d, 0x58, 0x4c, 0xd3, 0x76, 0x7a, 0x05, 0x79, 0x9b, 0xec, 0xc4,
+	0xad, 0x2f, 0x08, 0x2a, 0x7b, 0x34, 0x36, 0xa1, 0x70, 0x1e, 0x84, 0x73, 0xd5, 0xe2, 0xcd, 0xae,
+	0x9e, 0x6d, 0x72, 0x12, 0x84, 0x73, 0xa2, 0x74, 0xf8, 0x36, 0x94, 0xe4, 0x0e, 0xdd, 0x60, 0xae,
+	0x3a, 0x2f, 0x90, 0xa2, 0x84, 0xc3, 0x39, 0x7e, 0x00, 0x05, 0x79, 0xd2, 0xf2, 0x6a, 0x9e, 0xa3,
+	0x3d, 0x23, 0xb9, 0x1b, 0xa2, 0x48, 0xac, 0x41, 0x69, 0xc6, 0x92, 0x50, 0xd0, 0x58, 0x2b, 0x34,
+	0x51, 0xbb, 0x4c, 0x76, 0x50, 0x31, 0x31, 0x9d, 0x0a, 0x16, 0x6b, 0x87, 0x5b, 0x26, 0x85, 0xb8,
+	0x06, 0x87, 0xef, 0xa7, 0xcb, 0x84, 0x6a, 0x45, 0xf5, 0x5e, 0x0a, 0x1e, 0x7f, 0x42, 0x70, 0xf4,
+	0x57, 0x87, 0xf8, 0x3e, 0xdc, 0xb3, 0x9d, 0x31, 0xb1, 0xdc, 0xfe, 0xf1, 0xcb, 0xd1, 0x6b, 0xcb,
+	0x3d, 0x19, 0x8e, 0x06, 0xee, 0xe9, 0xc8, 0x9e, 0x58, 0xfd, 0xe1, 0xab, 0xa1, 0x35, 0xa8, 0xe6,
+	0xb0, 0x01, 0xfa, 0xbf, 0x92, 0xc9, 0xd8, 0x76, 0x5c, 0xdb, 0x72, 0xaa, 0x28, 0xdb, 0x42, 0xf1,
+	0x03, 0xeb, 0x8d, 0xe5, 0x58, 0xd5, 0x83, 0x6c, 0x49, 0x7f, 0x7c, 0x3a, 0x72, 0x2c, 0xa2, 0x5c,
+	0xf2, 0x5d, 0x0e, 0x45, 0x5b, 0x45, 0x18, 0x07, 0x50, 0xcb, 0xca, 0x08, 0x7e, 0xb8, 0xbf, 0xe8,
+	0xff, 0xa7, 0x50, 0x7f, 0x74, 0xad, 0x2e, 0x0d, 0xdb, 0x53, 0xd4, 0x7b, 0x72, 0xb9, 0x36, 0xd0,
+	0xd5, 0xda, 0x40, 0x3f, 0xd6, 0x06, 0xba, 0xd8, 0x18, 0xb9, 0xab, 0x8d, 0x91, 0xfb, 0xba, 0x31,
+	0x72, 0x6f, 0x6f, 0xa9, 0xe8, 0x7f, 0x48, 0xff, 0x00, 0xf1, 0x31, 0xa2, 0xdc, 0x2b, 0xaa, 0x9c,
+	0x3d, 0xfb, 0x39, 0x00, 0x11, 0x0c, 0x54, 0x7e, 0x75, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// StreamClient is the client API for Stream service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type StreamClient interface {
+	// SubscribePostChanges sends the blog store changes of every committed block
+	// at or after from_height that changed the blog store, then keeps sending
+	// the changes of new blocks. Clients resume after a disconnection by
+	// subscribing from the height following the last block they received.
+	SubscribePostChanges(ctx context.Context, in *SubscribePostChangesRequest, opts ...grpc.CallOption) (Stream_SubscribePostChangesClient, error)
+}
+
+type streamClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewStreamClient(cc grpc1.ClientConn) StreamClient {
+	return &streamClient{cc}
+}
+
+func (c *streamClient) SubscribePostChanges(ctx context.Context, in *SubscribePostChangesRequest, opts ...grpc.CallOption) (Stream_SubscribePostChangesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Stream_serviceDesc.Streams[0], "/blog.blog.Stream/SubscribePostChanges", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &streamSubscribePostChangesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Stream_SubscribePostChangesClient interface {
+	Recv() (*SubscribePostChangesResponse, error)
+	grpc.ClientStream
+}
+
+type streamSubscribePostChangesClient struct {
+	grpc.ClientStream
+}
+
+func (x *streamSubscribePostChangesClient) Recv() (*SubscribePostChangesResponse, error) {
+	m := new(SubscribePostChangesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// StreamServer is the server API for Stream service.
+type StreamServer interface {
+	// SubscribePostChanges sends the blog store changes of every committed block
+	// at or after from_height that changed the blog store, then keeps sending
+	// the changes of new blocks. Clients resume after a disconnection by
+	// subscribing from the height following the last block they received.
+	SubscribePostChanges(*SubscribePostChangesRequest, Stream_SubscribePostChangesServer) error
+}
+
+// UnimplementedStreamServer can be embedded to have forward compatible implementations.
+type UnimplementedStreamServer struct {
+}
+
+func (*UnimplementedStreamServer) SubscribePostChanges(req *SubscribePostChangesRequest, srv Stream_SubscribePostChangesServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribePostChanges not implemented")
+}
+
+func RegisterStreamServer(s grpc1.Server, srv StreamServer) {
+	s.RegisterService(&_Stream_serviceDesc, srv)
+}
+
+func _Stream_SubscribePostChanges_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribePostChangesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StreamServer).SubscribePostChanges(m, &streamSubscribePostChangesServer{stream})
+}
+
+type Stream_SubscribePostChangesServer interface {
+	Send(*SubscribePostChangesResponse) error
+	grpc.ServerStream
+}
+
+type streamSubscribePostChangesServer struct {
+	grpc.ServerStream
+}
+
+func (x *streamSubscribePostChangesServer) Send(m *SubscribePostChangesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var Stream_serviceDesc = _Stream_serviceDesc
+var _Stream_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "blog.blog.Stream",
+	HandlerType: (*StreamServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribePostChanges",
+			Handler:       _Stream_SubscribePostChanges_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "blog/blog/stream.proto",
+}
+
+func (m *SubscribePostChangesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscribePostChangesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscribePostChangesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FromHeight != 0 {
+		i = encodeVarintStream(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubscribePostChangesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscribePostChangesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscribePostChangesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStream(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintStream(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintStream(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StoreChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StoreChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StoreChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Value != 0 {
+		i = encodeVarintStream(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintStream(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Counter) > 0 {
+		i -= len(m.Counter)
+		copy(dAtA[i:], m.Counter)
+		i = encodeVarintStream(dAtA, i, uint64(len(m.Counter)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Post != nil {
+		{
+			size, err := m.Post.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStream(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PostId != 0 {
+		i = encodeVarintStream(dAtA, i, uint64(m.PostId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Kind != 0 {
+		i = encodeVarintStream(dAtA, i, uint64(m.Kind))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStream(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStream(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SubscribePostChangesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromHeight != 0 {
+		n += 1 + sovStream(uint64(m.FromHeight))
+	}
+	return n
+}
+
+func (m *SubscribePostChangesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovStream(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime)
+	n += 1 + l + sovStream(uint64(l))
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovStream(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *StoreChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Kind != 0 {
+		n += 1 + sovStream(uint64(m.Kind))
+	}
+	if m.PostId != 0 {
+		n += 1 + sovStream(uint64(m.PostId))
+	}
+	if m.Post != nil {
+		l = m.Post.Size()
+		n += 1 + l + sovStream(uint64(l))
+	}
+	l = len(m.Counter)
+	if l > 0 {
+		n += 1 + l + sovStream(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovStream(uint64(l))
+	}
+	if m.Value != 0 {
+		n += 1 + sovStream(uint64(m.Value))
+	}
+	return n
+}
+
+func sovStream(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStream(x uint64) (n int) {
+	return sovStream(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SubscribePostChangesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStream
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscribePostChangesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscribePostChangesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			}
+			m.FromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStream(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStream
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubscribePostChangesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStream
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscribePostChangesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscribePostChangesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Changes = append(m.Changes, StoreChange{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStream(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStream
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StoreChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStream
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StoreChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StoreChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= StoreChangeKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostId", wireType)
+			}
+			m.PostId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PostId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Post", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Post == nil {
+				m.Post = &Post{}
+			}
+			if err := m.Post.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Counter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStream(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStream
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipStream(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowStream
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthStream
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupStream
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthStream
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthStream        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowStream          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupStream = fmt.Errorf("proto: unexpected end of group")
+)
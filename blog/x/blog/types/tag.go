@@ -0,0 +1,48 @@
+package types
+
+import (
+	"regexp"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// MaxTagLength is the maximum length of a post tag.
+	MaxTagLength = 32
+
+	// MaxPostTags is the maximum number of tags of a post.
+	MaxPostTags = 10
+)
+
+var tagRegexp = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// ValidateTag checks that a tag is made of lower-case alphanumeric words
+// separated by single dashes, like a slug.
+func ValidateTag(tag string) error {
+	if len(tag) > MaxTagLength {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "tag is longer than %d characters", MaxTagLength)
+	}
+	if !tagRegexp.MatchString(tag) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid tag %q", tag)
+	}
+	return nil
+}
+
+// ValidateTags checks the tags of a post, which must be valid and distinct.
+func ValidateTags(tags []string) error {
+	if len(tags) > MaxPostTags {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "a post has at most %d tags", MaxPostTags)
+	}
+	seen := make(map[string]struct{}, len(tags))
+	for _, tag := range tags {
+		if err := ValidateTag(tag); err != nil {
+			return err
+		}
+		if _, ok := seen[tag]; ok {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate tag %q", tag)
+		}
+		seen[tag] = struct{}{}
+	}
+	return nil
+}
@@ -0,0 +1,726 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: blog/blog/trash.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DeletedPost is a post moved to the trash by MsgDeletePost. It can be
+// restored until the trash retention period of the params has elapsed.
+type DeletedPost struct {
+	Post      Post      `protobuf:"bytes,1,opt,name=post,proto3" json:"post"`
+	DeletedBy string    `protobuf:"bytes,2,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
+	DeletedAt time.Time `protobuf:"bytes,3,opt,name=deleted_at,json=deletedAt,proto3,stdtime" json:"deleted_at"`
+	// series lists the series the post was removed from, so that a restore
+	// puts it back in place.
+	Series []SeriesPosition `protobuf:"bytes,4,rep,name=series,proto3" json:"series"`
+	// edits is the number of updates of the post when it was deleted.
+	Edits uint64 `protobuf:"varint,5,opt,name=edits,proto3" json:"edits,omitempty"`
+}
+
+func (m *DeletedPost) Reset()         { *m = DeletedPost{} }
+func (m *DeletedPost) String() string { return proto.CompactTextString(m) }
+func (*DeletedPost) ProtoMessage()    {}
+func (*DeletedPost) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e79cc8169b6deaf3, []int{0}
+}
+func (m *DeletedPost) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeletedPost) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeletedPost.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeletedPost) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeletedPost.Merge(m, src)
+}
+func (m *DeletedPost) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeletedPost) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeletedPost.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeletedPost proto.InternalMessageInfo
+
+func (m *DeletedPost) GetPost() Post {
+	if m != nil {
+		return m.Post
+	}
+	return Post{}
+}
+
+func (m *DeletedPost) GetDeletedBy() string {
+	if m != nil {
+		return m.DeletedBy
+	}
+	return ""
+}
+
+func (m *DeletedPost) GetDeletedAt() time.Time {
+	if m != nil {
+		return m.DeletedAt
+	}
+	return time.Time{}
+}
+
+func (m *DeletedPost) GetSeries() []SeriesPosition {
+	if m != nil {
+		return m.Series
+	}
+	return nil
+}
+
+func (m *DeletedPost) GetEdits() uint64 {
+	if m != nil {
+		return m.Edits
+	}
+	return 0
+}
+
+// SeriesPosition is the index of a post in the post IDs of a series.
+type SeriesPosition struct {
+	SeriesId uint64 `protobuf:"varint,1,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`
+	Position uint32 `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"`
+}
+
+func (m *SeriesPosition) Reset()         { *m = SeriesPosition{} }
+func (m *SeriesPosition) String() string { return proto.CompactTextString(m) }
+func (*SeriesPosition) ProtoMessage()    {}
+func (*SeriesPosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e79cc8169b6deaf3, []int{1}
+}
+func (m *SeriesPosition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SeriesPosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SeriesPosition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SeriesPosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SeriesPosition.Merge(m, src)
+}
+func (m *SeriesPosition) XXX_Size() int {
+	return m.Size()
+}
+func (m *SeriesPosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_SeriesPosition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SeriesPosition proto.InternalMessageInfo
+
+func (m *SeriesPosition) GetSeriesId() uint64 {
+	if m != nil {
+		return m.SeriesId
+	}
+	return 0
+}
+
+func (m *SeriesPosition) GetPosition() uint32 {
+	if m != nil {
+		return m.Position
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*DeletedPost)(nil), "blog.blog.DeletedPost")
+	proto.RegisterType((*SeriesPosition)(nil), "blog.blog.SeriesPosition")
+}
+
+func init() { proto.RegisterFile("blog/blog/trash.proto", fileDescriptor_e79cc8169b6deaf3) }
+
+var fileDescriptor_e79cc8169b6deaf3 = []byte{
+	// 322 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x51, 0xb1, 0x4e, 0xc3, 0x30,
+	0x10, 0x8d, 0x69, 0x5a, 0x35, 0xae, 0x00, 0x61, 0x15, 0x29, 0x04, 0xe1, 0x46, 0x9d, 0x82, 0x90,
+	0x1c, 0xa9, 0x0c, 0xcc, 0x04, 0x96, 0x6e, 0x55, 0x60, 0x62, 0xa9, 0x1a, 0xc5, 0x04, 0x4b, 0x2d,
+	0x8e, 0x6a, 0x23, 0x91, 0xbf, 0xe8, 0x67, 0x75, 0xec, 0xc8, 0x04, 0x28, 0x19, 0xf9, 0x09, 0x64,
+	0x3b, 0x49, 0x61, 0x39, 0xdd, 0xbd, 0xf7, 0xee, 0xe5, 0x5d, 0x0c, 0x4f, 0x93, 0x25, 0xcf, 0x42,
+	0x5d, 0xe4, 0x7a, 0x21, 0x5e, 0x48, 0xbe, 0xe6, 0x92, 0x23, 0x47, 0x21, 0x44, 0x15, 0x6f, 0x94,
+	0x71, 0x9e, 0x2d, 0x69, 0xa8, 0x89, 0xe4, 0xed, 0x39, 0x94, 0x6c, 0x45, 0x85, 0x5c, 0xac, 0x72,
+	0xa3, 0xf5, 0x86, 0x19, 0xcf, 0xb8, 0x6e, 0x43, 0xd5, 0x35, 0xe8, 0xde, 0x38, 0xe7, 0x42, 0x1a,
+	0x74, 0xfc, 0x03, 0xe0, 0xe0, 0x9e, 0x2e, 0xa9, 0xa4, 0xe9, 0x8c, 0x0b, 0x89, 0x2e, 0xa1, 0xad,
+	0x58, 0x17, 0xf8, 0x20, 0x18, 0x4c, 0x8e, 0x49, 0xfb, 0x59, 0xa2, 0xe8, 0xc8, 0xde, 0x7e, 0x8e,
+	0xac, 0x58, 0x4b, 0xd0, 0x05, 0x84, 0xa9, 0xd9, 0x9c, 0x27, 0x85, 0x7b, 0xe0, 0x83, 0xc0, 0x89,
+	0x9d, 0x1a, 0x89, 0x0a, 0x74, 0xb7, 0xa7, 0x17, 0xd2, 0xed, 0x68, 0x3f, 0x8f, 0x98, 0xec, 0xa4,
+	0xc9, 0x4e, 0x1e, 0x9b, 0xec, 0x51, 0x5f, 0x59, 0x6f, 0xbe, 0x46, 0xa0, 0x35, 0xb9, 0x95, 0xe8,
+	0x06, 0xf6, 0x04, 0x5d, 0x33, 0x2a, 0x5c, 0xdb, 0xef, 0x04, 0x83, 0xc9, 0xd9, 0x9f, 0x40, 0x0f,
+	0x9a, 0x98, 0x71, 0xc1, 0x24, 0xe3, 0xaf, 0x75, 0xb4, 0x5a, 0x8e, 0x86, 0xb0, 0x4b, 0x53, 0x26,
+	0x85, 0xdb, 0xf5, 0x41, 0x60, 0xc7, 0x66, 0x18, 0x4f, 0xe1, 0xd1, 0xff, 0x2d, 0x74, 0x0e, 0x1d,
+	0xb3, 0x31, 0x67, 0xa9, 0x3e, 0xda, 0x8e, 0xfb, 0x06, 0x98, 0xa6, 0xc8, 0x83, 0xfd, 0xbc, 0x16,
+	0xea, 0xfb, 0x0e, 0xe3, 0x76, 0x8e, 0xae, 0xb6, 0x25, 0x06, 0xbb, 0x12, 0x83, 0xef, 0x12, 0x83,
+	0x4d, 0x85, 0xad, 0x5d, 0x85, 0xad, 0x8f, 0x0a, 0x5b, 0x4f, 0x27, 0xfa, 0x1f, 0xbf, 0xd7, 0x6f,
+	0x58, 0xe4, 0x54, 0x24, 0x3d, 0x7d, 0xef, 0xf5, 0xef, 0x00, 0xcf, 0x1f, 0xb3, 0xfa, 0xdd, 0x01,
+	0x00, 0x00,
+}
+
+func (m *DeletedPost) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeletedPost) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeletedPost) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Edits != 0 {
+		i = encodeVarintTrash(dAtA, i, uint64(m.Edits))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Series) > 0 {
+		for iNdEx := len(m.Series) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Series[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTrash(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.DeletedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.DeletedAt):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTrash(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.DeletedBy) > 0 {
+		i -= len(m.DeletedBy)
+		copy(dAtA[i:], m.DeletedBy)
+		i = encodeVarintTrash(dAtA, i, uint64(len(m.DeletedBy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Post.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTrash(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SeriesPosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SeriesPosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SeriesPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Position != 0 {
+		i = encodeVarintTrash(dAtA, i, uint64(m.Position))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SeriesId != 0 {
+		i = encodeVarintTrash(dAtA, i, uint64(m.SeriesId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTrash(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTrash(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DeletedPost) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Post.Size()
+	n += 1 + l + sovTrash(uint64(l))
+	l = len(m.DeletedBy)
+	if l > 0 {
+		n += 1 + l + sovTrash(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.DeletedAt)
+	n += 1 + l + sovTrash(uint64(l))
+	if len(m.Series) > 0 {
+		for _, e := range m.Series {
+			l = e.Size()
+			n += 1 + l + sovTrash(uint64(l))
+		}
+	}
+	if m.Edits != 0 {
+		n += 1 + sovTrash(uint64(m.Edits))
+	}
+	return n
+}
+
+func (m *SeriesPosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SeriesId != 0 {
+		n += 1 + sovTrash(uint64(m.SeriesId))
+	}
+	if m.Position != 0 {
+		n += 1 + sovTrash(uint64(m.Position))
+	}
+	return n
+}
+
+func sovTrash(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTrash(x uint64) (n int) {
+	return sovTrash(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DeletedPost) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTrash
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeletedPost: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeletedPost: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Post", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrash
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTrash
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrash
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Post.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrash
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrash
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrash
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeletedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrash
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTrash
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrash
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.DeletedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Series", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrash
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTrash
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrash
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Series = append(m.Series, SeriesPosition{})
+			if err := m.Series[len(m.Series)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Edits", wireType)
+			}
+			m.Edits = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrash
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Edits |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTrash(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTrash
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SeriesPosition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTrash
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SeriesPosition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SeriesPosition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeriesId", wireType)
+			}
+			m.SeriesId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrash
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SeriesId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+			}
+			m.Position = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrash
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Position |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTrash(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTrash
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTrash(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTrash
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTrash
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTrash
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTrash
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTrash
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTrash
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTrash        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTrash          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTrash = fmt.Errorf("proto: unexpected end of group")
+)
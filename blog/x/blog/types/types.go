@@ -2,12 +2,31 @@ package types
 
 // Event types and attribute keys for blog module
 const (
-	EventTypeCreatePost   = "create_post"
-	EventTypeDeletePost   = "delete_post"
-	EventTypeAddEditor    = "add_editor"
-	EventTypeDeleteEditor = "delete_editor"
-	EventTypeUpdatePost   = "update_post"
-	EventTypeUpdateParams = "update_params"
+	EventTypeCreatePost        = "create_post"
+	EventTypeDeletePost        = "delete_post"
+	EventTypeAddEditor         = "add_editor"
+	EventTypeDeleteEditor      = "delete_editor"
+	EventTypeUpdatePost        = "update_post"
+	EventTypeUpdateParams      = "update_params"
+	EventTypeBatchPostOps      = "batch_post_ops"
+	EventTypeSetProfile        = "set_profile"
+	EventTypeFollow            = "follow"
+	EventTypeUnfollow          = "unfollow"
+	EventTypeCreateSeries      = "create_series"
+	EventTypeUpdateSeries      = "update_series"
+	EventTypeSetCoAuthors      = "set_co_authors"
+	EventTypeProposePostChange = "propose_post_change"
+	EventTypeApprovePostChange = "approve_post_change"
+	EventTypeExpirePostChange  = "expire_post_change"
+	EventTypeRestorePost       = "restore_post"
+	EventTypePurgePost         = "purge_post"
+	EventTypeGrantReadAccess   = "grant_read_access"
+	EventTypeRevokeReadAccess  = "revoke_read_access"
+	EventTypeSetPostPrice      = "set_post_price"
+	EventTypePurchaseAccess    = "purchase_access"
+	EventTypeSetPostTags       = "set_post_tags"
+	EventTypeReleasePurchase   = "release_purchase"
+	EventTypeRefundPurchase    = "refund_purchase"
 
 	AttributeKeyAuthority  = "authority"
 	AttributeKeyParams     = "params"
@@ -17,4 +36,25 @@ const (
 	AttributeKeyPostID     = "post_id"
 	AttributeKeyCreator    = "creator"
 	AttributeKeyTitle      = "title"
+	AttributeKeyOpCount    = "op_count"
+	AttributeKeySlug       = "slug"
+	AttributeKeyAddress    = "address"
+	AttributeKeyName       = "display_name"
+	AttributeKeyFollower   = "follower"
+	AttributeKeyFollowee   = "followee"
+	AttributeKeySeriesID   = "series_id"
+	AttributeKeyPostIDs    = "post_ids"
+	AttributeKeyProposalID = "proposal_id"
+	AttributeKeyApprover   = "approver"
+	AttributeKeyApprovals  = "approvals"
+	AttributeKeyCoAuthors  = "co_authors"
+	AttributeKeyThreshold  = "approval_threshold"
+	AttributeKeyBody       = "body"
+	AttributeKeyRevision   = "revision"
+	AttributeKeyReader     = "reader"
+	AttributeKeyRotated    = "rotated"
+	AttributeKeyPrice      = "price"
+	AttributeKeyBuyer      = "buyer"
+	AttributeKeyTags       = "tags"
+	AttributeKeyEscrowed   = "escrowed"
 )